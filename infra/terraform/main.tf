@@ -236,6 +236,18 @@ resource "aws_sqs_queue" "transactions" {
   tags = local.tags
 }
 
+# --- Gatilho SQS da Lambda de orçamentos ---
+# A função é publicada fora do Terraform (ver README); com ReportBatchItemFailures só as mensagens que falharam
+# voltam para a fila, em vez de o lote inteiro ser reprocessado ou descartado
+resource "aws_lambda_event_source_mapping" "transactions" {
+  count = var.transaction_processor_function_name == "" ? 0 : 1
+
+  event_source_arn        = aws_sqs_queue.transactions.arn
+  function_name           = var.transaction_processor_function_name
+  batch_size              = 10
+  function_response_types = ["ReportBatchItemFailures"]
+}
+
 # --- Cognito User Pool ---
 resource "aws_cognito_user_pool" "this" {
   name     = "${local.name_prefix}-users"
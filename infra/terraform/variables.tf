@@ -50,3 +50,8 @@ variable "api_domain" {
   default     = ""
 }
 
+variable "transaction_processor_function_name" {
+  description = "Nome da Lambda transaction_processor ligada à fila de transações (vazio para não criar o gatilho)"
+  type        = string
+  default     = ""
+}
@@ -34,12 +34,14 @@ import (
 	"github.com/vasconcellos/financial-control/src/internal/adapters/http/handler"
 	"github.com/vasconcellos/financial-control/src/internal/adapters/http/middleware"
 	"github.com/vasconcellos/financial-control/src/internal/config"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
 	"github.com/vasconcellos/financial-control/src/internal/domain/port"
 	"github.com/vasconcellos/financial-control/src/internal/infrastructure/auth"
 	awsS3 "github.com/vasconcellos/financial-control/src/internal/infrastructure/aws/s3"
 	awsSQS "github.com/vasconcellos/financial-control/src/internal/infrastructure/aws/sqs"
 	"github.com/vasconcellos/financial-control/src/internal/infrastructure/logger"
 	"github.com/vasconcellos/financial-control/src/internal/infrastructure/mongodb"
+	"github.com/vasconcellos/financial-control/src/internal/infrastructure/rates"
 	"github.com/vasconcellos/financial-control/src/internal/infrastructure/security"
 	"github.com/vasconcellos/financial-control/src/internal/usecase"
 )
@@ -59,6 +61,10 @@ func main() {
 	}
 	defer logr.Sync()
 
+	if err := entity.SetEnabledCurrencies(cfg.Currencies.Enabled); err != nil {
+		logr.Fatal("invalid currencies.enabled", zap.Error(err))
+	}
+
 	mongoClient, err := mongodb.NewClient(ctx, cfg.Mongo.URI, cfg.Mongo.Database)
 	if err != nil {
 		logr.Fatal("failed to connect mongo", zap.Error(err))
@@ -81,6 +87,54 @@ func main() {
 	budgetRepo := mongodb.NewBudgetRepository(mongoClient)
 	goalRepo := mongodb.NewGoalRepository(mongoClient)
 	reportRepo := mongodb.NewReportRepository(mongoClient)
+	outboxRepo, err := mongodb.NewOutboxRepository(mongoClient)
+	if err != nil {
+		logr.Fatal("failed to init outbox repo", zap.Error(err))
+	}
+	exchangeRateRepo, err := mongodb.NewExchangeRateRepository(mongoClient)
+	if err != nil {
+		logr.Fatal("failed to init exchange rate repo", zap.Error(err))
+	}
+	recurringRepo, err := mongodb.NewRecurringTransactionRepository(mongoClient)
+	if err != nil {
+		logr.Fatal("failed to init recurring transaction repo", zap.Error(err))
+	}
+	importProfileRepo, err := mongodb.NewImportProfileRepository(mongoClient)
+	if err != nil {
+		logr.Fatal("failed to init import profile repo", zap.Error(err))
+	}
+	importBatchRepo, err := mongodb.NewImportBatchRepository(mongoClient)
+	if err != nil {
+		logr.Fatal("failed to init import batch repo", zap.Error(err))
+	}
+	ruleRepo, err := mongodb.NewCategorizationRuleRepository(mongoClient)
+	if err != nil {
+		logr.Fatal("failed to init categorization rule repo", zap.Error(err))
+	}
+	reconciliationRepo, err := mongodb.NewReconciliationRepository(mongoClient)
+	if err != nil {
+		logr.Fatal("failed to init reconciliation repo", zap.Error(err))
+	}
+	snapshotRepo, err := mongodb.NewBalanceSnapshotRepository(mongoClient)
+	if err != nil {
+		logr.Fatal("failed to init balance snapshot repo", zap.Error(err))
+	}
+	securityRepo, err := mongodb.NewSecurityRepository(mongoClient)
+	if err != nil {
+		logr.Fatal("failed to init security repo", zap.Error(err))
+	}
+	securityPriceRepo, err := mongodb.NewSecurityPriceRepository(mongoClient)
+	if err != nil {
+		logr.Fatal("failed to init security price repo", zap.Error(err))
+	}
+	tradeRepo, err := mongodb.NewInvestmentTradeRepository(mongoClient)
+	if err != nil {
+		logr.Fatal("failed to init investment trade repo", zap.Error(err))
+	}
+	unitOfWork := mongodb.NewUnitOfWork(ctx, mongoClient)
+	if !unitOfWork.Transactional() {
+		logr.Warn("mongo transactions unavailable (standalone server); multi-collection writes are not atomic")
+	}
 
 	awsCfg, err := buildAWSConfig(ctx, cfg)
 	if err != nil {
@@ -104,43 +158,90 @@ func main() {
 
 	authUseCase := usecase.NewAuthUseCase(authProvider)
 	userUseCase := usecase.NewUserUseCase(userRepo)
-	accountUseCase := usecase.NewAccountUseCase(accountRepo)
 	categoryUseCase := usecase.NewCategoryUseCase(categoryRepo)
+	currencyUseCase := usecase.NewCurrencyUseCase()
 	encryptionKey, keyErr := security.DecodeKeyBase64(cfg.Security.EncryptionKey)
 	if keyErr != nil {
 		logr.Fatal("invalid encryption key", zap.Error(keyErr))
 	}
 
-	transactionUseCase := usecase.NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, queuePublisher, storage, cfg.Queue.TransactionQueue, encryptionKey)
-	budgetUseCase := usecase.NewBudgetUseCase(budgetRepo)
-	goalUseCase := usecase.NewGoalUseCase(goalRepo)
-	reportUseCase := usecase.NewReportUseCase(reportRepo)
+	transactionUseCase := usecase.NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, ruleRepo, unitOfWork, outboxRepo, snapshotRepo, storage, cfg.Queue.TransactionQueue, encryptionKey)
+	accountUseCase := usecase.NewAccountUseCase(accountRepo, transactionRepo, snapshotRepo, tradeRepo, recurringRepo, reconciliationRepo, importBatchRepo, unitOfWork, transactionUseCase)
+	creditCardUseCase := usecase.NewCreditCardUseCase(accountRepo, transactionRepo, transactionUseCase)
+	loanUseCase := usecase.NewLoanUseCase(accountRepo, categoryRepo, transactionUseCase)
+	savingsUseCase := usecase.NewSavingsUseCase(accountRepo, transactionRepo, categoryRepo, transactionUseCase, cfg.Interest.BatchSize)
+	reconciliationUseCase := usecase.NewReconciliationUseCase(reconciliationRepo, accountRepo, transactionRepo, categoryRepo, unitOfWork)
+	investmentUseCase := usecase.NewInvestmentUseCase(accountRepo, securityRepo, securityPriceRepo, tradeRepo, categoryRepo, transactionUseCase)
+	balanceHistoryUseCase := usecase.NewBalanceHistoryUseCase(accountRepo, transactionRepo, categoryRepo, snapshotRepo, cfg.Snapshots.BatchSize)
+	recurringUseCase := usecase.NewRecurringTransactionUseCase(recurringRepo, categoryRepo, transactionUseCase, cfg.Recurring.BatchSize)
+	importUseCase := usecase.NewImportUseCase(importProfileRepo, importBatchRepo, accountRepo, categoryRepo, transactionRepo, transactionUseCase)
+	ruleUseCase := usecase.NewCategorizationRuleUseCase(ruleRepo, categoryRepo, transactionRepo)
+	exchangeRateUseCase := usecase.NewExchangeRateUseCase(exchangeRateRepo, buildRateProvider(cfg))
+	budgetUseCase := usecase.NewBudgetUseCase(budgetRepo, exchangeRateUseCase)
+	goalUseCase := usecase.NewGoalUseCase(goalRepo, exchangeRateUseCase)
+	reportUseCase := usecase.NewReportUseCase(reportRepo, exchangeRateUseCase)
+	netWorthUseCase := usecase.NewNetWorthUseCase(accountRepo, balanceHistoryUseCase, exchangeRateUseCase, investmentUseCase)
+
+	if queuePublisher != nil {
+		outboxRelay := usecase.NewOutboxRelay(outboxRepo, queuePublisher, cfg.Outbox.BatchSize, cfg.Outbox.MaxAttempts)
+		go runOutboxRelay(ctx, outboxRelay, cfg.Outbox.RelayInterval, logr)
+	} else {
+		logr.Warn("queue publisher disabled; outbox events will stay pending until SQS is configured")
+	}
+
+	go runExchangeRateSync(ctx, exchangeRateUseCase, cfg.ExchangeRates.SyncInterval, logr)
+	go runRecurringScheduler(ctx, recurringUseCase, cfg.Recurring.SchedulerInterval, logr)
+	go runBalanceSnapshots(ctx, balanceHistoryUseCase, cfg.Snapshots.Interval, logr)
+	go runSavingsInterest(ctx, savingsUseCase, cfg.Interest.Interval, logr)
 
 	authHandler := handler.NewAuthHandler(authUseCase)
 	accountHandler := handler.NewAccountHandler(accountUseCase)
+	creditCardHandler := handler.NewCreditCardHandler(creditCardUseCase)
+	reconciliationHandler := handler.NewReconciliationHandler(reconciliationUseCase)
+	balanceHistoryHandler := handler.NewBalanceHistoryHandler(balanceHistoryUseCase)
+	investmentHandler := handler.NewInvestmentHandler(investmentUseCase)
+	loanHandler := handler.NewLoanHandler(loanUseCase)
+	savingsHandler := handler.NewSavingsHandler(savingsUseCase)
 	categoryHandler := handler.NewCategoryHandler(categoryUseCase)
+	ruleHandler := handler.NewCategorizationRuleHandler(ruleUseCase)
+	currencyHandler := handler.NewCurrencyHandler(currencyUseCase)
+	importHandler := handler.NewImportHandler(importUseCase)
+	recurringHandler := handler.NewRecurringTransactionHandler(recurringUseCase)
 	transactionHandler := handler.NewTransactionHandler(transactionUseCase)
 	budgetHandler := handler.NewBudgetHandler(budgetUseCase)
 	goalHandler := handler.NewGoalHandler(goalUseCase)
-	reportHandler := handler.NewReportHandler(reportUseCase)
+	reportHandler := handler.NewReportHandler(reportUseCase, netWorthUseCase)
 	healthHandler := handler.NewHealthHandler()
 
 	authMiddleware := middleware.NewAuthMiddleware(authUseCase, userUseCase)
-	router := http.NewRouter(http.RouterParams{
-		AuthHandler:        authHandler,
-		AccountHandler:     accountHandler,
-		CategoryHandler:    categoryHandler,
-		TransactionHandler: transactionHandler,
-		BudgetHandler:      budgetHandler,
-		GoalHandler:        goalHandler,
-		ReportHandler:      reportHandler,
-		HealthHandler:      healthHandler,
-		AuthMiddleware:     authMiddleware,
-		AllowedOrigins:     cfg.Security.AllowedOrigins,
-		Logger:             logr,
-		ForceHTTPS:         cfg.App.HTTPS.Redirect,
-		Environment:        cfg.App.Environment,
+	router, err := http.NewRouter(http.RouterParams{
+		AuthHandler:           authHandler,
+		AccountHandler:        accountHandler,
+		CreditCardHandler:     creditCardHandler,
+		ReconciliationHandler: reconciliationHandler,
+		BalanceHistoryHandler: balanceHistoryHandler,
+		InvestmentHandler:     investmentHandler,
+		LoanHandler:           loanHandler,
+		SavingsHandler:        savingsHandler,
+		CategoryHandler:       categoryHandler,
+		RuleHandler:           ruleHandler,
+		CurrencyHandler:       currencyHandler,
+		ImportHandler:         importHandler,
+		RecurringHandler:      recurringHandler,
+		TransactionHandler:    transactionHandler,
+		BudgetHandler:         budgetHandler,
+		GoalHandler:           goalHandler,
+		ReportHandler:         reportHandler,
+		HealthHandler:         healthHandler,
+		AuthMiddleware:        authMiddleware,
+		AllowedOrigins:        cfg.Security.AllowedOrigins,
+		Logger:                logr,
+		ForceHTTPS:            cfg.App.HTTPS.Redirect,
+		Environment:           cfg.App.Environment,
 	})
+	if err != nil {
+		logr.Fatal("failed to build router", zap.Error(err))
+	}
 
 	server := &stdhttp.Server{
 		Addr:              fmt.Sprintf(":%d", cfg.App.Port),
@@ -174,6 +275,7 @@ func main() {
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 	<-sigCh
 	logr.Info("shutdown signal received")
+	cancel()
 
 	ctxShutdown, cancelShutdown := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancelShutdown()
@@ -184,6 +286,155 @@ func main() {
 	logr.Info("server stopped")
 }
 
+// runOutboxRelay publica os eventos pendentes do outbox até o contexto ser cancelado
+func runOutboxRelay(ctx context.Context, relay *usecase.OutboxRelay, interval time.Duration, logr *zap.Logger) {
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent, err := relay.RelayPending(ctx)
+			if err != nil && ctx.Err() == nil {
+				logr.Error("outbox relay failure", zap.Error(err))
+			}
+			if sent > 0 {
+				logr.Debug("outbox events published", zap.Int("count", sent))
+			}
+		}
+	}
+}
+
+// runExchangeRateSync carrega as cotações do provider na inicialização e depois a cada intervalo
+func runExchangeRateSync(ctx context.Context, exchangeRates *usecase.ExchangeRateUseCase, interval time.Duration, logr *zap.Logger) {
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+	sync := func() {
+		count, err := exchangeRates.SyncRates(ctx, time.Time{}, time.Time{})
+		if err != nil && ctx.Err() == nil {
+			logr.Error("exchange rate sync failure", zap.Error(err))
+			return
+		}
+		if count > 0 {
+			logr.Info("exchange rates synchronized", zap.Int("count", count))
+		}
+	}
+
+	sync()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sync()
+		}
+	}
+}
+
+// runBalanceSnapshots grava o saldo de fechamento do dia anterior de todas as contas na inicialização e a cada intervalo.
+// Rodar mais de uma vez para o mesmo dia só substitui os snapshots.
+func runBalanceSnapshots(ctx context.Context, balanceHistory *usecase.BalanceHistoryUseCase, interval time.Duration, logr *zap.Logger) {
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+	snapshot := func() {
+		count, err := balanceHistory.SnapshotBalances(ctx, time.Now().UTC().AddDate(0, 0, -1))
+		if err != nil && ctx.Err() == nil {
+			logr.Error("balance snapshot failure", zap.Error(err))
+			return
+		}
+		if count > 0 {
+			logr.Info("balance snapshots recorded", zap.Int("count", count))
+		}
+	}
+
+	snapshot()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			snapshot()
+		}
+	}
+}
+
+// runSavingsInterest lança os juros dos meses fechados das contas poupança na inicialização e a cada intervalo.
+// Rodar de novo não duplica juros: cada lançamento tem ExternalRef única.
+func runSavingsInterest(ctx context.Context, savings *usecase.SavingsUseCase, interval time.Duration, logr *zap.Logger) {
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+	accrue := func() {
+		posted, err := savings.AccrueInterest(ctx, time.Now().UTC())
+		if err != nil && ctx.Err() == nil {
+			logr.Error("savings interest failure", zap.Error(err))
+		}
+		if posted > 0 {
+			logr.Info("savings interest posted", zap.Int("count", posted))
+		}
+	}
+
+	accrue()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			accrue()
+		}
+	}
+}
+
+// runRecurringScheduler grava as ocorrências vencidas das transações recorrentes a cada intervalo.
+// Várias instâncias podem rodar ao mesmo tempo: cada ocorrência tem ExternalRef única.
+func runRecurringScheduler(ctx context.Context, recurring *usecase.RecurringTransactionUseCase, interval time.Duration, logr *zap.Logger) {
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			recorded, err := recurring.MaterializeDue(ctx, time.Now().UTC())
+			if err != nil && ctx.Err() == nil {
+				logr.Error("recurring scheduler failure", zap.Error(err))
+			}
+			if recorded > 0 {
+				logr.Info("recurring transactions recorded", zap.Int("count", recorded))
+			}
+		}
+	}
+}
+
+func buildRateProvider(cfg *config.Config) port.RateProvider {
+	switch cfg.ExchangeRates.Provider {
+	case "csv":
+		if cfg.ExchangeRates.File == "" {
+			return nil
+		}
+		return rates.NewCSVProvider(cfg.ExchangeRates.File)
+	default:
+		return nil
+	}
+}
+
 func buildAWSConfig(ctx context.Context, cfg *config.Config) (aws.Config, error) {
 	options := []func(*awsConfig.LoadOptions) error{
 		awsConfig.WithRegion(cfg.AWS.Region),
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"math"
 	"os"
 	"strings"
 	"time"
@@ -19,24 +18,47 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/vasconcellos/financial-control/src/internal/config"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
 	"github.com/vasconcellos/financial-control/src/internal/infrastructure/mongodb"
+	"github.com/vasconcellos/financial-control/src/internal/usecase"
 )
 
 type transactionEvent struct {
-	TransactionID string    `json:"transactionId"`
-	UserID        string    `json:"userId"`
-	AccountID     string    `json:"accountId"`
-	CategoryID    string    `json:"categoryId"`
-	Amount        float64   `json:"amount"`
-	Currency      string    `json:"currency"`
-	OccurredAt    time.Time `json:"occurredAt"`
-	Type          string    `json:"type"`
+	EventID       string       `json:"eventId"`
+	EventType     string       `json:"eventType"`
+	TransactionID string       `json:"transactionId"`
+	UserID        string       `json:"userId"`
+	AccountID     string       `json:"accountId"`
+	CategoryID    string       `json:"categoryId"`
+	Amount        entity.Money `json:"amount"`
+	Currency      string       `json:"currency"`
+	OccurredAt    time.Time    `json:"occurredAt"`
+	Type          string       `json:"type"`
+	Splits        []eventSplit `json:"splits,omitempty"`
+}
+
+// eventSplit é uma linha do rateio da transação; cada linha conta no orçamento da sua categoria
+type eventSplit struct {
+	CategoryID string       `json:"categoryId"`
+	Amount     entity.Money `json:"amount"`
+}
+
+// categoryAmounts devolve as linhas do rateio ou, sem rateio, o valor inteiro na categoria da transação
+func (e transactionEvent) categoryAmounts() []eventSplit {
+	if len(e.Splits) > 0 {
+		return e.Splits
+	}
+	return []eventSplit{{CategoryID: e.CategoryID, Amount: e.Amount}}
 }
 
 var (
 	mongoClient   *mongodb.Client
 	budgetRepo    *mongodb.BudgetRepository
+	categoryRepo  *mongodb.CategoryRepository
 	processedRepo *mongodb.ProcessedTransactionRepository
+	exchangeRates *usecase.ExchangeRateUseCase
+	unitOfWork    *mongodb.UnitOfWork
 	sqsClient     *sqs.Client
 	queueURL      string
 	awsCfg        aws.Config
@@ -44,6 +66,12 @@ var (
 	lambdaLogger  *zap.Logger
 )
 
+const (
+	eventTypeTransactionVoided = "TRANSACTION_VOIDED"
+)
+
+var errEventAlreadyProcessed = errors.New("event already processed")
+
 const (
 	localModeEnv          = "LAMBDA_LOCAL"
 	defaultWaitTimeSecond = 10
@@ -74,11 +102,14 @@ func main() {
 	lambda.Start(handler)
 }
 
-func handler(ctx context.Context, event events.SQSEvent) error {
+// handler devolve as mensagens que falharam como falhas parciais do lote, para que o SQS as entregue de novo e,
+// esgotadas as tentativas, as mova para a DLQ. Exige ReportBatchItemFailures no gatilho da fila
+func handler(ctx context.Context, event events.SQSEvent) (events.SQSEventResponse, error) {
+	var response events.SQSEventResponse
 	if mongoClient == nil {
 		if err := initDependencies(ctx); err != nil {
 			lambdaLogger.Error("failed to initialize dependencies", zap.Error(err))
-			return err
+			return response, err
 		}
 	}
 
@@ -92,12 +123,13 @@ func handler(ctx context.Context, event events.SQSEvent) error {
 
 		if err := processTransaction(ctx, payload); err != nil {
 			lambdaLogger.Error("failed to process transaction", zap.String("transaction_id", payload.TransactionID), zap.Error(err))
+			response.BatchItemFailures = append(response.BatchItemFailures, events.SQSBatchItemFailure{ItemIdentifier: record.MessageId})
 		} else {
 			lambdaLogger.Info("transaction processed", zap.String("transaction_id", payload.TransactionID))
 		}
 	}
 
-	return nil
+	return response, nil
 }
 
 func initDependencies(ctx context.Context) error {
@@ -118,7 +150,17 @@ func initDependencies(ctx context.Context) error {
 	}
 
 	budgetRepo = mongodb.NewBudgetRepository(mongoClient)
+	categoryRepo = mongodb.NewCategoryRepository(mongoClient)
 	processedRepo = mongodb.NewProcessedTransactionRepository(mongoClient)
+	exchangeRateRepo, err := mongodb.NewExchangeRateRepository(mongoClient)
+	if err != nil {
+		return err
+	}
+	exchangeRates = usecase.NewExchangeRateUseCase(exchangeRateRepo, nil)
+	unitOfWork = mongodb.NewUnitOfWork(ctx, mongoClient)
+	if !unitOfWork.Transactional() {
+		lambdaLogger.Warn("mongo transactions unavailable; budget updates are not atomic")
+	}
 
 	awsCfg, err = buildAWSConfig(ctx, cfg)
 	if err != nil {
@@ -158,56 +200,149 @@ func ensureSQSClient(ctx context.Context) error {
 	return nil
 }
 
-func processTransaction(ctx context.Context, payload transactionEvent) error {
-	inserted, err := processedRepo.MarkProcessed(ctx, payload.TransactionID, payload.UserID, payload.Type, time.Now().UTC())
+// resolveEventType devolve o tipo da transação a partir da categoria; sem categoria a transação conta como despesa
+func resolveEventType(ctx context.Context, payload transactionEvent) (entity.TransactionType, error) {
+	category, err := categoryRepo.GetByID(ctx, payload.CategoryID, payload.UserID)
 	if err != nil {
-		return err
+		return "", err
 	}
-	if !inserted {
-		lambdaLogger.Debug("transaction already processed", zap.String("transaction_id", payload.TransactionID))
-		return nil
+	if category == nil {
+		return entity.TransactionTypeExpense, nil
+	}
+	return entity.TransactionTypeFromCategory(category.Type), nil
+}
+
+func processTransaction(ctx context.Context, payload transactionEvent) error {
+	// Eventos antigos não possuem eventId; nesse caso a própria transação é a chave de idempotência
+	eventKey := payload.EventID
+	if eventKey == "" {
+		eventKey = payload.TransactionID
+	}
+
+	// Eventos antigos ainda na fila podem vir sem tipo; o tipo sai da categoria, como na API
+	if payload.Type == "" {
+		transactionType, err := resolveEventType(ctx, payload)
+		if err != nil {
+			return err
+		}
+		payload.Type = string(transactionType)
 	}
 
-	amount := math.Abs(payload.Amount)
-	var delta float64
+	var sign int64
 	switch payload.Type {
 	case "expense":
-		delta = amount
+		sign = 1
 	case "income":
-		delta = -amount
+		sign = -1
 	default:
 		lambdaLogger.Warn("unknown transaction type", zap.String("transaction_id", payload.TransactionID), zap.String("type", payload.Type))
 		return nil
 	}
 
-	if delta == 0 {
-		lambdaLogger.Debug("ignoring zero-impact transaction", zap.String("transaction_id", payload.TransactionID))
-		return nil
+	// Transações anuladas devolvem ao orçamento o valor contabilizado anteriormente
+	if payload.EventType == eventTypeTransactionVoided {
+		sign = -sign
 	}
 
-	lambdaLogger.Info("updating budget spending", zap.String("transaction_id", payload.TransactionID), zap.Float64("delta", delta))
-	budgets, err := budgetRepo.FindActiveByCategory(ctx, payload.UserID, payload.CategoryID, payload.OccurredAt)
+	// Marcador de idempotência e gastos dos orçamentos são gravados na mesma unidade de trabalho
+	var updatedBudgets int
+	err := unitOfWork.Do(ctx, func(txCtx context.Context) error {
+		inserted, err := processedRepo.MarkProcessed(txCtx, eventKey, payload.TransactionID, payload.UserID, payload.Type, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		if !inserted {
+			return errEventAlreadyProcessed
+		}
+
+		// Todas as conversões são feitas antes de gravar: sem cotação para algum orçamento nenhum é alterado e o
+		// evento volta para a fila, em vez de ficar marcado como processado com orçamentos de fora
+		var changes []budgetChange
+		for _, split := range payload.categoryAmounts() {
+			delta := split.Amount.Abs().MulInt(sign)
+			if delta.IsZero() {
+				lambdaLogger.Debug("ignoring zero-impact transaction", zap.String("transaction_id", payload.TransactionID), zap.String("category_id", split.CategoryID))
+				continue
+			}
+
+			lambdaLogger.Info("updating budget spending", zap.String("transaction_id", payload.TransactionID), zap.String("event_type", payload.EventType), zap.String("category_id", split.CategoryID), zap.Stringer("delta", delta))
+			changes, err = planBudgetDelta(txCtx, payload, split.CategoryID, delta, changes)
+			if err != nil {
+				return err
+			}
+		}
+
+		updatedBudgets = 0
+		for _, change := range changes {
+			newSpent := change.budget.Spent.Add(change.delta)
+			if newSpent.IsNegative() {
+				newSpent = entity.ZeroMoney
+			}
+			if err := budgetRepo.UpdateSpent(txCtx, change.budget.ID, change.budget.UserID, newSpent); err != nil {
+				return err
+			}
+			updatedBudgets++
+		}
+		return nil
+	})
+	if errors.Is(err, errEventAlreadyProcessed) {
+		lambdaLogger.Debug("event already processed", zap.String("event_id", eventKey), zap.String("transaction_id", payload.TransactionID))
+		return nil
+	}
 	if err != nil {
-		if removeErr := processedRepo.Remove(ctx, payload.TransactionID); removeErr != nil {
-			lambdaLogger.Warn("failed to rollback processed marker", zap.String("transaction_id", payload.TransactionID), zap.Error(removeErr))
+		// Sem suporte a transações o marcador precisa ser removido manualmente para permitir novo processamento
+		if !unitOfWork.Transactional() {
+			if removeErr := processedRepo.Remove(ctx, eventKey); removeErr != nil {
+				lambdaLogger.Warn("failed to rollback processed marker", zap.String("transaction_id", payload.TransactionID), zap.Error(removeErr))
+			}
 		}
 		return err
 	}
 
+	lambdaLogger.Info("budget spending updated", zap.Int("budgets", updatedBudgets))
+	return nil
+}
+
+// budgetChange é o valor, já na moeda do orçamento, a somar ao gasto dele
+type budgetChange struct {
+	budget *entity.Budget
+	delta  entity.Money
+}
+
+// planBudgetDelta acrescenta a changes o delta de cada orçamento ativo da categoria, convertido para a moeda do
+// orçamento. Orçamentos que já estão em changes (rateio com linhas na mesma categoria) acumulam o delta
+func planBudgetDelta(ctx context.Context, payload transactionEvent, categoryID string, delta entity.Money, changes []budgetChange) ([]budgetChange, error) {
+	budgets, err := budgetRepo.FindActiveByCategory(ctx, payload.UserID, categoryID, payload.OccurredAt)
+	if err != nil {
+		return changes, err
+	}
+
 	for _, budget := range budgets {
-		newSpent := budget.Spent + delta
-		if newSpent < 0 {
-			newSpent = 0
+		// Transações em outra moeda entram no orçamento pela cotação da data em que ocorreram
+		budgetDelta := delta
+		if payload.Currency != "" {
+			budgetDelta, err = exchangeRates.Convert(ctx, delta, entity.Currency(payload.Currency), budget.Currency, payload.OccurredAt)
+			if errors.Is(err, domainErrors.ErrRateNotFound) {
+				lambdaLogger.Warn("missing exchange rate for budget", zap.String("budget_id", budget.ID), zap.String("from", payload.Currency), zap.String("to", budget.Currency.String()))
+			}
+			if err != nil {
+				return changes, err
+			}
 		}
-		if err := budgetRepo.UpdateSpent(ctx, budget.ID, budget.UserID, newSpent); err != nil {
-			if removeErr := processedRepo.Remove(ctx, payload.TransactionID); removeErr != nil {
-				lambdaLogger.Warn("failed to rollback processed marker", zap.String("transaction_id", payload.TransactionID), zap.Error(removeErr))
+
+		merged := false
+		for i := range changes {
+			if changes[i].budget.ID == budget.ID {
+				changes[i].delta = changes[i].delta.Add(budgetDelta)
+				merged = true
+				break
 			}
-			return err
+		}
+		if !merged {
+			changes = append(changes, budgetChange{budget: budget, delta: budgetDelta})
 		}
 	}
-	lambdaLogger.Info("budget spending updated", zap.Int("budgets", len(budgets)))
-	return nil
+	return changes, nil
 }
 
 func startLocalWorker(ctx context.Context) {
@@ -0,0 +1,81 @@
+// migrate_money converte os campos monetários gravados como double/int para Decimal128,
+// arredondando para 4 casas (entity.MoneyScale) e eliminando resíduos como 99.99999999.
+// É idempotente: documentos já convertidos não são alterados.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/vasconcellos/financial-control/src/internal/config"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/infrastructure/mongodb"
+)
+
+// moneyFields lista, por coleção, os campos que passaram a ser entity.Money
+var moneyFields = map[string][]string{
+	"transactions": {"amount"},
+	"accounts":     {"balance"},
+	"budgets":      {"amount", "spent"},
+	"goals":        {"target_amount", "current_amount"},
+}
+
+var legacyNumericTypes = bson.A{"double", "int", "long"}
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "only count documents that would be converted")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Println("failed to load config:", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	client, err := mongodb.NewClient(ctx, cfg.Mongo.URI, cfg.Mongo.Database)
+	if err != nil {
+		fmt.Println("failed to connect mongo:", err)
+		os.Exit(1)
+	}
+	defer client.Close(context.Background())
+
+	for collection, fields := range moneyFields {
+		for _, field := range fields {
+			converted, err := migrateField(ctx, client.Collection(collection), field, *dryRun)
+			if err != nil {
+				fmt.Printf("failed to migrate %s.%s: %v\n", collection, field, err)
+				os.Exit(1)
+			}
+			fmt.Printf("%s.%s: %d documents\n", collection, field, converted)
+		}
+	}
+
+	fmt.Println("ok")
+}
+
+func migrateField(ctx context.Context, collection *mongo.Collection, field string, dryRun bool) (int64, error) {
+	filter := bson.M{field: bson.M{"$type": legacyNumericTypes}}
+	if dryRun {
+		return collection.CountDocuments(ctx, filter)
+	}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			field: bson.M{"$round": bson.A{bson.M{"$toDecimal": "$" + field}, entity.MoneyScale}},
+		}}},
+	}
+	result, err := collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
@@ -1,6 +1,7 @@
 import React, { useState, useEffect, useRef } from 'react';
 import { TextField, TextFieldProps } from '@mui/material';
 import { CurrencyCode } from '../constants/currencyOptions';
+import { useCurrencies } from '../hooks/useCurrencies';
 
 interface CurrencyInputProps extends Omit<TextFieldProps, 'onChange' | 'value'> {
   value: number;
@@ -9,7 +10,7 @@ interface CurrencyInputProps extends Omit<TextFieldProps, 'onChange' | 'value'>
 }
 
 // Configurações de formatação para cada moeda
-const currencyConfig = {
+const currencyConfig: Record<string, { symbol: string; decimals: number; separator: string; thousandSeparator: string }> = {
   USD: { symbol: '$', decimals: 2, separator: '.', thousandSeparator: ',' },
   EUR: { symbol: '€', decimals: 2, separator: '.', thousandSeparator: ',' },
   CHF: { symbol: 'CHF', decimals: 2, separator: '.', thousandSeparator: "'" },
@@ -27,7 +28,15 @@ export const CurrencyInput: React.FC<CurrencyInputProps> = ({
   const [isFocused, setIsFocused] = useState(false);
   const [cursorPosition, setCursorPosition] = useState(0);
   const inputRef = useRef<HTMLInputElement>(null);
-  const config = currencyConfig[currency];
+  const { findCurrency } = useCurrencies();
+  // Moedas sem configuração própria usam símbolo e casas decimais do catálogo ISO 4217
+  const metadata = findCurrency(currency);
+  const config = currencyConfig[currency] ?? {
+    symbol: metadata?.symbol ?? currency,
+    decimals: metadata?.minorUnits ?? 2,
+    separator: '.',
+    thousandSeparator: ','
+  };
 
   // Função para formatar número para exibição (com separadores de milhares)
   const formatForDisplay = (num: number): string => {
@@ -40,8 +49,11 @@ export const CurrencyInput: React.FC<CurrencyInputProps> = ({
     const parts = rounded.toString().split('.');
     const integerPart = parts[0].replace(/\B(?=(\d{3})+(?!\d))/g, config.thousandSeparator);
     
-    // Sempre inclui os decimais, mesmo que sejam .00
-    const decimalPart = parts[1] ? parts[1].padEnd(config.decimals, '0') : '00';
+    // Sempre inclui os decimais, mesmo que sejam .00 (moedas sem casas decimais, como JPY, não têm separador)
+    if (config.decimals === 0) {
+      return integerPart;
+    }
+    const decimalPart = (parts[1] ?? '').padEnd(config.decimals, '0');
     
     return `${integerPart}${config.separator}${decimalPart}`;
   };
@@ -78,25 +90,25 @@ export const CurrencyInput: React.FC<CurrencyInputProps> = ({
       // Remove pontos (separadores de milhares) mas mantém vírgula
       cleanStr = cleanStr.replace(/\./g, '');
       
-      // Limita a uma vírgula e máximo 2 dígitos após ela
+      // Limita a uma vírgula e ao número de casas decimais da moeda
       const parts = cleanStr.split(',');
       if (parts.length > 2) {
         cleanStr = parts[0] + ',' + parts.slice(1).join('');
       }
-      if (parts.length === 2 && parts[1].length > 2) {
-        cleanStr = parts[0] + ',' + parts[1].substring(0, 2);
+      if (parts.length === 2 && parts[1].length > config.decimals) {
+        cleanStr = parts[0] + ',' + parts[1].substring(0, config.decimals);
       }
     } else {
       // Para outras moedas (ponto como separador decimal)
       cleanStr = cleanStr.replace(/,/g, '');
       
-      // Limita a um ponto e máximo 2 dígitos após ele
+      // Limita a um ponto e ao número de casas decimais da moeda
       const parts = cleanStr.split('.');
       if (parts.length > 2) {
         cleanStr = parts[0] + '.' + parts.slice(1).join('');
       }
-      if (parts.length === 2 && parts[1].length > 2) {
-        cleanStr = parts[0] + '.' + parts[1].substring(0, 2);
+      if (parts.length === 2 && parts[1].length > config.decimals) {
+        cleanStr = parts[0] + '.' + parts[1].substring(0, config.decimals);
       }
     }
     
@@ -194,7 +206,7 @@ export const CurrencyInput: React.FC<CurrencyInputProps> = ({
           </span>
         ),
       }}
-      placeholder={`0${config.separator}00`}
+      placeholder={config.decimals > 0 ? `0${config.separator}${'0'.repeat(config.decimals)}` : '0'}
       inputProps={{
         ...textFieldProps.inputProps,
         inputMode: 'decimal',
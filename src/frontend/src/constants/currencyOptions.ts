@@ -6,6 +6,7 @@ export const currencyOptions = [
   { value: 'BRL', label: 'Brazilian Real' }
 ] as const;
 
-export type CurrencyCode = (typeof currencyOptions)[number]['value'];
+// Qualquer código ISO 4217 habilitado no backend (GET /currencies)
+export type CurrencyCode = string;
 
 export const defaultCurrency: CurrencyCode = currencyOptions[0].value;
@@ -0,0 +1,40 @@
+import { useQuery } from '@tanstack/react-query';
+
+import { api } from '../services/api';
+import { currencyOptions } from '../constants/currencyOptions';
+
+export interface CurrencyMetadata {
+  code: string;
+  numericCode: string;
+  minorUnits: number;
+  symbol: string;
+  name: string;
+  enabled: boolean;
+}
+
+// Moedas padrão usadas enquanto a API não responde ou quando a chamada falha
+const fallbackCurrencies: CurrencyMetadata[] = currencyOptions.map((option) => ({
+  code: option.value,
+  numericCode: '',
+  minorUnits: 2,
+  symbol: option.value,
+  name: option.label,
+  enabled: true
+}));
+
+export const useCurrencies = () => {
+  const query = useQuery<CurrencyMetadata[]>({
+    queryKey: ['currencies'],
+    queryFn: async () => {
+      const { data } = await api.get<CurrencyMetadata[]>('/currencies');
+      return data;
+    },
+    staleTime: 60 * 60 * 1000
+  });
+
+  const currencies = query.data && query.data.length > 0 ? query.data : fallbackCurrencies;
+  const options = currencies.map((currency) => ({ value: currency.code, label: currency.name }));
+  const findCurrency = (code: string) => currencies.find((currency) => currency.code === code);
+
+  return { currencies, options, findCurrency };
+};
@@ -25,7 +25,9 @@ import {
 import DeleteIcon from '@mui/icons-material/Delete';
 
 import { api } from '../services/api';
-import { currencyOptions, defaultCurrency, CurrencyCode } from '../constants/currencyOptions';
+import { formatMoney, MoneyValue } from '../utils/money';
+import { defaultCurrency, CurrencyCode } from '../constants/currencyOptions';
+import { useCurrencies } from '../hooks/useCurrencies';
 import CurrencyInput from '../components/CurrencyInput';
 
 interface Account {
@@ -34,7 +36,7 @@ interface Account {
   type: string;
   currency: string;
   description: string;
-  balance: number;
+  balance: MoneyValue;
 }
 
 interface AccountFormState {
@@ -54,6 +56,7 @@ const accountTypes = [
 
 const AccountsPage = () => {
   const queryClient = useQueryClient();
+  const { options: currencyOptions } = useCurrencies();
   const [open, setOpen] = useState(false);
   const [form, setForm] = useState<AccountFormState>({
     name: '',
@@ -160,7 +163,7 @@ const AccountsPage = () => {
                 <TableCell>{account.name}</TableCell>
                 <TableCell>{account.type}</TableCell>
                 <TableCell>{account.currency}</TableCell>
-                <TableCell align="right">${account.balance.toFixed(2)}</TableCell>
+                <TableCell align="right">${formatMoney(account.balance)}</TableCell>
                 <TableCell>{account.description}</TableCell>
                 <TableCell align="right">
                   <IconButton
@@ -24,18 +24,20 @@ import {
 import dayjs from 'dayjs';
 
 import { api } from '../services/api';
-import { currencyOptions, defaultCurrency, CurrencyCode } from '../constants/currencyOptions';
+import { formatMoney, moneyToNumber, MoneyValue } from '../utils/money';
+import { defaultCurrency, CurrencyCode } from '../constants/currencyOptions';
+import { useCurrencies } from '../hooks/useCurrencies';
 import CurrencyInput from '../components/CurrencyInput';
 
 interface Budget {
   id: string;
   categoryId: string;
-  amount: number;
+  amount: MoneyValue;
   currency: string;
   period: string;
   periodStart: string;
   periodEnd: string;
-  spent: number;
+  spent: MoneyValue;
   alertPercent: number;
 }
 
@@ -62,6 +64,7 @@ const periods = [
 
 const BudgetsPage = () => {
   const queryClient = useQueryClient();
+  const { options: currencyOptions } = useCurrencies();
   const [open, setOpen] = useState(false);
   const [form, setForm] = useState<BudgetFormState>({
     categoryId: '',
@@ -138,17 +141,18 @@ const BudgetsPage = () => {
               </TableRow>
             )}
             {budgetsQuery.data?.map((budget) => {
-              const usage = (budget.spent / budget.amount) * 100;
+              const amount = moneyToNumber(budget.amount);
+              const usage = amount === 0 ? 0 : (moneyToNumber(budget.spent) / amount) * 100;
               const categoryName = categoriesQuery.data?.find((c) => c.id === budget.categoryId)?.name ??
                 budget.categoryId;
               return (
                 <TableRow key={budget.id} hover>
                   <TableCell>{categoryName}</TableCell>
                   <TableCell align="right">
-                    {budget.currency} {budget.amount.toFixed(2)}
+                    {budget.currency} {formatMoney(budget.amount)}
                   </TableCell>
                   <TableCell align="right">
-                    {budget.currency} {budget.spent.toFixed(2)}
+                    {budget.currency} {formatMoney(budget.spent)}
                   </TableCell>
                   <TableCell>
                     {dayjs(budget.periodStart).format('YYYY-MM-DD')} -{' '}
@@ -11,12 +11,14 @@ import {
 } from '@mui/material';
 
 import { api } from '../services/api';
+import { formatMoney, moneyToNumber, MoneyValue } from '../utils/money';
 
 interface SummaryReportResponse {
-  totalIncome: number;
-  totalExpense: number;
-  netBalance: number;
-  spendingByCategory: Record<string, number>;
+  currency: string;
+  totalIncome: MoneyValue;
+  totalExpense: MoneyValue;
+  netBalance: MoneyValue;
+  spendingByCategory: Record<string, MoneyValue>;
   budgetUsage: Record<string, number>;
   goalProgress: Record<string, number>;
 }
@@ -65,7 +67,7 @@ const DashboardPage = () => {
           <Typography variant="subtitle2" color="text.secondary">
             Total Income
           </Typography>
-          <Typography variant="h5">${data.totalIncome.toFixed(2)}</Typography>
+          <Typography variant="h5">{data.currency} {formatMoney(data.totalIncome)}</Typography>
         </Paper>
       </Grid>
       <Grid item xs={12} md={4}>
@@ -73,7 +75,7 @@ const DashboardPage = () => {
           <Typography variant="subtitle2" color="text.secondary">
             Total Expense
           </Typography>
-          <Typography variant="h5">${data.totalExpense.toFixed(2)}</Typography>
+          <Typography variant="h5">{data.currency} {formatMoney(data.totalExpense)}</Typography>
         </Paper>
       </Grid>
       <Grid item xs={12} md={4}>
@@ -81,7 +83,7 @@ const DashboardPage = () => {
           <Typography variant="subtitle2" color="text.secondary">
             Net Balance
           </Typography>
-          <Typography variant="h5">${data.netBalance.toFixed(2)}</Typography>
+          <Typography variant="h5">{data.currency} {formatMoney(data.netBalance)}</Typography>
         </Paper>
       </Grid>
 
@@ -99,8 +101,8 @@ const DashboardPage = () => {
             {spendingEntries.map(([category, value]) => (
               <Stack key={category} spacing={1}>
                 <Typography variant="body2">{category}</Typography>
-                <LinearProgress variant="determinate" value={Math.min(100, value)} />
-                <Typography variant="caption">${value.toFixed(2)}</Typography>
+                <LinearProgress variant="determinate" value={Math.min(100, moneyToNumber(value))} />
+                <Typography variant="caption">{data.currency} {formatMoney(value)}</Typography>
               </Stack>
             ))}
           </Stack>
@@ -24,14 +24,16 @@ import {
 import dayjs from 'dayjs';
 
 import { api } from '../services/api';
-import { currencyOptions, defaultCurrency, CurrencyCode } from '../constants/currencyOptions';
+import { formatMoney, moneyToNumber, MoneyValue } from '../utils/money';
+import { defaultCurrency, CurrencyCode } from '../constants/currencyOptions';
+import { useCurrencies } from '../hooks/useCurrencies';
 import CurrencyInput from '../components/CurrencyInput';
 
 interface Goal {
   id: string;
   name: string;
-  targetAmount: number;
-  currentAmount: number;
+  targetAmount: MoneyValue;
+  currentAmount: MoneyValue;
   currency: string;
   deadline: string;
   status: string;
@@ -40,6 +42,7 @@ interface Goal {
 
 const GoalsPage = () => {
   const queryClient = useQueryClient();
+  const { options: currencyOptions } = useCurrencies();
   const [open, setOpen] = useState(false);
   const [progressOpen, setProgressOpen] = useState(false);
   const [selectedGoal, setSelectedGoal] = useState<Goal | null>(null);
@@ -132,15 +135,16 @@ const GoalsPage = () => {
               </TableRow>
             )}
             {goalsQuery.data?.map((goal) => {
-              const progress = (goal.currentAmount / goal.targetAmount) * 100;
+              const target = moneyToNumber(goal.targetAmount);
+              const progress = target === 0 ? 0 : (moneyToNumber(goal.currentAmount) / target) * 100;
               return (
                 <TableRow key={goal.id} hover>
                   <TableCell>{goal.name}</TableCell>
                   <TableCell align="right">
-                    {goal.currency} {goal.targetAmount.toFixed(2)}
+                    {goal.currency} {formatMoney(goal.targetAmount)}
                   </TableCell>
                   <TableCell align="right">
-                    {goal.currency} {goal.currentAmount.toFixed(2)}
+                    {goal.currency} {formatMoney(goal.currentAmount)}
                   </TableCell>
                   <TableCell>{dayjs(goal.deadline).format('YYYY-MM-DD')}</TableCell>
                   <TableCell>{goal.status}</TableCell>
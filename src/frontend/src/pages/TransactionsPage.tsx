@@ -25,14 +25,16 @@ import {
 import dayjs from 'dayjs';
 
 import { api } from '../services/api';
-import { currencyOptions, defaultCurrency, CurrencyCode } from '../constants/currencyOptions';
+import { formatMoney, MoneyValue } from '../utils/money';
+import { defaultCurrency, CurrencyCode } from '../constants/currencyOptions';
+import { useCurrencies } from '../hooks/useCurrencies';
 import CurrencyInput from '../components/CurrencyInput';
 
 interface Transaction {
   id: string;
   accountId: string;
   categoryId: string;
-  amount: number;
+  amount: MoneyValue;
   currency: string;
   description: string;
   occurredAt: string;
@@ -63,6 +65,7 @@ interface TransactionFormState {
 
 const TransactionsPage = () => {
   const queryClient = useQueryClient();
+  const { options: currencyOptions } = useCurrencies();
   const [open, setOpen] = useState(false);
   const [form, setForm] = useState<TransactionFormState>({
     accountId: '',
@@ -221,7 +224,7 @@ const TransactionsPage = () => {
                 <TableCell>{accountMap[transaction.accountId] ?? transaction.accountId}</TableCell>
                 <TableCell>{categoryMap[transaction.categoryId] ?? transaction.categoryId}</TableCell>
                 <TableCell align="right">
-                  {transaction.currency} {transaction.amount.toFixed(2)}
+                  {transaction.currency} {formatMoney(transaction.amount)}
                 </TableCell>
                 <TableCell>
                   <Chip label={transaction.status} size="small" color="primary" variant="outlined" />
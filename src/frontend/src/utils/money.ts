@@ -0,0 +1,10 @@
+// A API serializa valores monetários como strings decimais exatas (ex.: "120.50")
+export type MoneyValue = string;
+
+export const moneyToNumber = (value: MoneyValue | number | null | undefined): number => {
+  const parsed = Number(value ?? 0);
+  return Number.isNaN(parsed) ? 0 : parsed;
+};
+
+export const formatMoney = (value: MoneyValue | number | null | undefined): string =>
+  moneyToNumber(value).toFixed(2);
@@ -29,7 +29,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lista todas as contas do usuário autenticado",
+                "description": "Lista as contas do usuário autenticado; contas encerradas só aparecem com includeClosed",
                 "consumes": [
                     "application/json"
                 ],
@@ -52,6 +52,18 @@ const docTemplate = `{
                         "description": "Número de resultados para pular (default: 0)",
                         "name": "offset",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Inclui contas encerradas (default: false)",
+                        "name": "includeClosed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Paginação por cursor: vazio na primeira página e depois o nextCursor da resposta anterior. Nesse modo offset é ignorado e a resposta é {items, nextCursor}",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -60,20 +72,26 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.AccountResponse"
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.AccountResponse"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Cursor inválido",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Erro interno",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
@@ -102,7 +120,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.CreateAccountRequest"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateAccountRequest"
                         }
                     }
                 ],
@@ -110,25 +128,25 @@ const docTemplate = `{
                     "201": {
                         "description": "Conta criada com sucesso",
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.AccountResponse"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.AccountResponse"
                         }
                     },
                     "400": {
                         "description": "Dados inválidos",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Erro interno",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
@@ -141,7 +159,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove uma conta existente",
+                "description": "Remove uma conta existente. Contas com transações só são removidas com cascade=true, que remove também as transações dela; para tirar a conta das listagens mantendo o histórico, encerre a conta",
                 "produces": [
                     "application/json"
                 ],
@@ -156,6 +174,12 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove também as transações da conta (default: false)",
+                        "name": "cascade",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -165,19 +189,25 @@ const docTemplate = `{
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Conta não encontrada",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A conta ainda tem transações",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Erro interno",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
@@ -213,7 +243,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.UpdateAccountRequest"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.UpdateAccountRequest"
                         }
                     }
                 ],
@@ -221,33 +251,114 @@ const docTemplate = `{
                     "200": {
                         "description": "Conta atualizada",
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.AccountResponse"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.AccountResponse"
                         }
                     },
                     "400": {
                         "description": "Dados inválidos",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Conta não encontrada",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/auth/login": {
+        "/accounts/{id}/balance-history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Série do saldo da conta no fim de cada dia, semana (segunda a domingo) ou mês do período. Funciona para qualquer data passada: o saldo é reconstruído desfazendo as transações posteriores a partir do snapshot diário mais próximo ou do saldo atual. Limite de 400 pontos",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Get account balance history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da conta",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial (RFC3339, default: 30 dias atrás)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final (RFC3339, default: agora)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Intervalo dos pontos: day, week ou month (default: day)",
+                        "name": "interval",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Histórico de saldo",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.BalanceHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Período ou intervalo inválido",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{id}/close": {
             "post": {
-                "description": "Autentica um usuário e retorna tokens de acesso",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Encerra (arquiva) a conta na data informada ou agora. Contas encerradas saem das listagens padrão e não recebem novas transações, mas continuam no histórico de saldo e nos relatórios",
                 "consumes": [
                     "application/json"
                 ],
@@ -255,88 +366,120 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "accounts"
                 ],
-                "summary": "Authenticate user",
+                "summary": "Close an account",
                 "parameters": [
                     {
-                        "description": "Credenciais de login",
+                        "type": "string",
+                        "description": "ID da conta",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data de encerramento",
                         "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.LoginRequest"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CloseAccountRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Tokens de autenticação",
+                        "description": "Conta encerrada",
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.LoginResponse"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.AccountResponse"
                         }
                     },
                     "400": {
-                        "description": "Dados inválidos",
+                        "description": "Data inválida",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Credenciais inválidas",
+                        "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Erro interno",
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conta já encerrada",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/budgets": {
+        "/accounts/{id}/credit-card": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lista todos os orçamentos do usuário com status atualizado",
+                "description": "Mostra a última fatura fechada, a fatura aberta e a próxima, com totais, pagamentos e vencimentos, além do limite disponível. Compras a partir do dia de fechamento entram na fatura seguinte; pagamentos feitos depois do fechamento abatem a fatura fechada",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "budgets"
+                    "accounts"
+                ],
+                "summary": "Credit card statements",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da conta de cartão de crédito",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List budgets",
                 "responses": {
                     "200": {
-                        "description": "Lista de orçamentos",
+                        "description": "Faturas do cartão",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.BudgetResponse"
-                            }
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreditCardSummaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Conta não é cartão de crédito ou não tem ciclo de fatura",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/accounts/{id}/credit-card/payments": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cria um orçamento para controlar gastos por categoria",
+                "description": "Transfere da conta informada para o cartão. Sem valor, paga o saldo em aberto da última fatura fechada",
                 "consumes": [
                     "application/json"
                 ],
@@ -344,82 +487,121 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "budgets"
+                    "accounts"
                 ],
-                "summary": "Create a budget",
+                "summary": "Pay a credit card statement",
                 "parameters": [
                     {
-                        "description": "Dados do orçamento",
+                        "type": "string",
+                        "description": "ID da conta de cartão de crédito",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Conta pagadora e valor opcional",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.CreateBudgetRequest"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.PayStatementRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Orçamento criado",
+                        "description": "Pagamento registrado",
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.BudgetResponse"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransferResponse"
                         }
                     },
                     "400": {
-                        "description": "Dados inválidos",
+                        "description": "Dados inválidos ou nada a pagar",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/categories": {
+        "/accounts/{id}/loan": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lista todas as categorias do usuário",
+                "description": "Situação da conta de empréstimo ou financiamento: saldo devedor, parcelas pagas e restantes, juros a pagar, próxima parcela, data de quitação e o cronograma das parcelas restantes (Price ou SAC)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "loans"
+                ],
+                "summary": "Get a loan and its amortization schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da conta de empréstimo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List categories",
                 "responses": {
                     "200": {
-                        "description": "Lista de categorias",
+                        "description": "Empréstimo e cronograma",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.CategoryResponse"
-                            }
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Conta que não é de empréstimo",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/accounts/{id}/loan/payments": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cria uma nova categoria de transação (income ou expense)",
+                "description": "Paga a próxima parcela a partir de outra conta da mesma moeda. A parcela sai da conta de origem como transferência e os juros são lançados como despesa na conta do empréstimo, na categoria informada; o saldo devedor cai pelo valor amortizado. As transações geradas não podem ser editadas nem anuladas",
                 "consumes": [
                     "application/json"
                 ],
@@ -427,90 +609,146 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "loans"
                 ],
-                "summary": "Create a new category",
+                "summary": "Pay the next loan installment",
                 "parameters": [
                     {
-                        "description": "Dados da categoria",
+                        "type": "string",
+                        "description": "ID da conta de empréstimo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Conta de origem e categoria dos juros",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.CreateCategoryRequest"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.PayLoanInstallmentRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Categoria criada",
+                        "description": "Parcela paga",
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.CategoryResponse"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanPaymentResponse"
                         }
                     },
                     "400": {
-                        "description": "Dados inválidos",
+                        "description": "Dados inválidos, conta que não é de empréstimo ou moedas diferentes",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Empréstimo quitado ou conta encerrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/categories/{id}": {
+        "/accounts/{id}/loan/payments/{paymentId}": {
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove uma categoria",
+                "description": "Estorna o pagamento mais recente do empréstimo, parcela ou amortização extraordinária: as transações do pagamento são anuladas e o saldo devedor, as parcelas e o cronograma voltam ao que eram antes dele. Pagamentos anteriores só podem ser estornados depois dos mais recentes",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "categories"
+                    "loans"
                 ],
-                "summary": "Delete a category",
+                "summary": "Reverse the latest loan payment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da categoria",
+                        "description": "ID da conta de empréstimo",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do pagamento",
+                        "name": "paymentId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Categoria removida"
+                    "200": {
+                        "description": "Empréstimo depois do estorno",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Conta que não é de empréstimo",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Categoria não encontrada",
+                        "description": "Conta ou pagamento não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pagamento que não é o mais recente, transação conciliada, conta encerrada ou pagamento concorrente",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/goals": {
-            "get": {
+        "/accounts/{id}/loan/prepayments": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lista todas as metas financeiras do usuário",
+                "description": "Amortização extraordinária: o valor inteiro abate o saldo devedor, reduzindo o prazo (padrão) ou o valor das parcelas. Um valor igual ao saldo devedor quita o empréstimo",
                 "consumes": [
                     "application/json"
                 ],
@@ -518,48 +756,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "goals"
+                    "loans"
                 ],
-                "summary": "List goals",
+                "summary": "Make an extra loan payment",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Número máximo de resultados",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Offset para paginação",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "ID da conta de empréstimo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Conta de origem, valor e modo",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.PrepayLoanRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Lista de metas",
+                    "201": {
+                        "description": "Amortização registrada",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.GoalResponse"
-                            }
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanPaymentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos, valor acima do saldo devedor ou moedas diferentes",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Empréstimo quitado ou conta encerrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/accounts/{id}/loan/simulate": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cria uma nova meta financeira (ex: guardar R$ 10.000)",
+                "description": "Compara o cronograma atual com o de uma amortização extraordinária agora e/ou todo mês: parcelas, data de quitação, juros totais e quanto se economiza. Nada é gravado",
                 "consumes": [
                     "application/json"
                 ],
@@ -567,137 +832,141 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "goals"
+                    "loans"
                 ],
-                "summary": "Create a financial goal",
+                "summary": "Simulate extra loan payments",
                 "parameters": [
                     {
-                        "description": "Dados da meta",
+                        "type": "string",
+                        "description": "ID da conta de empréstimo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Amortizações hipotéticas",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.CreateGoalRequest"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SimulateLoanRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Meta criada",
+                    "200": {
+                        "description": "Simulação",
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.GoalResponse"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanSimulationResponse"
                         }
                     },
                     "400": {
-                        "description": "Dados inválidos",
+                        "description": "Dados inválidos ou conta que não é de empréstimo",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/health": {
-            "get": {
-                "description": "Retorna o status da API, uptime e status dos serviços",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "health"
-                ],
-                "summary": "Health check",
-                "responses": {
-                    "200": {
-                        "description": "API saudável",
+                    },
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.HealthResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/reports/summary": {
+        "/accounts/{id}/portfolio": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Gera um resumo financeiro com receitas, despesas e saldo do período",
+                "description": "Posições da conta de investimento com quantidade, custo (FIFO com lotes ou custo médio, conforme a conta), preço mais recente (histórico de preços ou última operação), valor de mercado, resultado realizado e não realizado e proventos. O valor total soma o caixa da conta",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "reports"
+                    "investments"
                 ],
-                "summary": "Get financial summary",
+                "summary": "Get an investment portfolio",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Data inicial (RFC3339, default: 30 dias atrás)",
-                        "name": "from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data final (RFC3339, default: hoje)",
-                        "name": "to",
-                        "in": "query"
+                        "description": "ID da conta de investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Resumo financeiro",
+                        "description": "Carteira",
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.SummaryReportResponse"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.PortfolioResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Conta que não é de investimento",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/transactions": {
+        "/accounts/{id}/reconciliations": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lista transações do usuário com filtros opcionais de data e paginação",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lista as conciliações da conta, da data de extrato mais recente para a mais antiga",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "reconciliations"
                 ],
-                "summary": "List transactions",
+                "summary": "List account reconciliations",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Data inicial (ISO 8601)",
-                        "name": "from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data final (ISO 8601)",
-                        "name": "to",
-                        "in": "query"
+                        "description": "ID da conta",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "integer",
@@ -714,24 +983,24 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lista de transações",
+                        "description": "Lista de conciliações",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.TransactionResponse"
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ReconciliationResponse"
                             }
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Erro interno",
+                    "404": {
+                        "description": "Conta não encontrada",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
@@ -742,7 +1011,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cria uma nova transação financeira e publica evento para processamento assíncrono de budgets",
+                "description": "Abre uma conciliação da conta com a data final e o saldo do extrato do banco. Há no máximo uma conciliação aberta por conta e a data não pode ser anterior à da última concluída",
                 "consumes": [
                     "application/json"
                 ],
@@ -750,250 +1019,5109 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "reconciliations"
                 ],
-                "summary": "Create a new transaction",
+                "summary": "Start an account reconciliation",
                 "parameters": [
                     {
-                        "description": "Dados da transação",
+                        "type": "string",
+                        "description": "ID da conta",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do extrato",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.CreateTransactionRequest"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.StartReconciliationRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Transação criada com sucesso",
+                        "description": "Conciliação aberta, com as transações candidatas",
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.TransactionResponse"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ReconciliationResponse"
                         }
                     },
                     "400": {
                         "description": "Dados inválidos",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Erro interno",
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Já existe uma conciliação aberta",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/transactions/{id}": {
-            "patch": {
+        "/accounts/{id}/reopen": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Atualiza uma transação existente",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Reabre uma conta encerrada, que volta às listagens e a aceitar transações",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "accounts"
                 ],
-                "summary": "Update a transaction",
+                "summary": "Reopen a closed account",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da transação",
+                        "description": "ID da conta",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Dados atualizados",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.UpdateTransactionRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Transação atualizada",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.TransactionResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Dados inválidos",
+                        "description": "Conta reaberta",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.AccountResponse"
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Transação não encontrada",
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A conta não está encerrada",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/transactions/{id}/receipt": {
-            "post": {
+        "/accounts/{id}/savings/projection": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Envia e criptografa um recibo para a transação. Arquivo é armazenado no S3 com AES-256",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+                "description": "Projeta o saldo da conta poupança mês a mês pela taxa e capitalização atuais, supondo o saldo atual constante. O primeiro mês inclui os dias ainda não lançados pela rotina de juros",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "accounts"
                 ],
-                "summary": "Attach receipt to transaction",
+                "summary": "Project a savings account balance",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da transação",
+                        "description": "ID da conta poupança",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "file",
-                        "description": "Arquivo do recibo (PDF, PNG, JPG - max 5MB)",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "Quantidade de meses (padrão 12, máximo 600)",
+                        "name": "months",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Recibo anexado com sucesso",
+                        "description": "Projeção",
                         "schema": {
-                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_internal_domain_dto.TransactionResponse"
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SavingsProjectionResponse"
                         }
                     },
                     "400": {
-                        "description": "Arquivo inválido ou muito grande",
+                        "description": "Parâmetros inválidos ou conta sem rendimento configurado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Não autenticado",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Transação não encontrada",
+                        "description": "Conta não encontrada",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Erro interno",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_handler.ErrorResponse"
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_vasconcellos_financial-control_internal_domain_dto.AccountResponse": {
-            "type": "object",
-            "properties": {
-                "balance": {
-                    "type": "number"
-                },
-                "currency": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "type": {
-                    "type": "string"
-                }
-            }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.BudgetResponse": {
-            "type": "object",
-            "properties": {
-                "alertPercent": {
-                    "type": "number"
-                },
-                "amount": {
-                    "type": "number"
-                },
-                "categoryId": {
-                    "type": "string"
-                },
-                "currency": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "period": {
-                    "type": "string"
-                },
-                "periodEnd": {
-                    "type": "string"
-                },
-                "periodStart": {
-                    "type": "string"
-                },
-                "spent": {
-                    "type": "number"
+        "/accounts/{id}/trades": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista as operações da conta de investimento em ordem de data",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "List investment trades",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da conta de investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de operações",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TradeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Conta que não é de investimento",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registra compra, venda ou provento em uma conta de investimento. O valor líquido entra ou sai do caixa da conta como transação: compra (valor mais taxas) e venda (valor menos taxas) como transferência, provento como receita na categoria informada. Vendas acima da quantidade em carteira são recusadas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "Record an investment trade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da conta de investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da operação",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateTradeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Operação registrada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TradeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos, conta que não é de investimento ou venda acima da posição",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conta encerrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Autentica um usuário e retorna tokens de acesso",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Authenticate user",
+                "parameters": [
+                    {
+                        "description": "Credenciais de login",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tokens de autenticação",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Credenciais inválidas",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/budgets": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista todos os orçamentos do usuário com status atualizado e valores convertidos para a moeda padrão quando a moeda do orçamento difere",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "List budgets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Paginação por cursor: vazio na primeira página e depois o nextCursor da resposta anterior. Nesse modo offset é ignorado e a resposta é {items, nextCursor}",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de orçamentos",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.BudgetResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Cursor inválido",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria um orçamento para controlar gastos por categoria",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Create a budget",
+                "parameters": [
+                    {
+                        "description": "Dados do orçamento",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateBudgetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Orçamento criado",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.BudgetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista todas as categorias do usuário",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "List categories",
+                "responses": {
+                    "200": {
+                        "description": "Lista de categorias",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CategoryResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma nova categoria de transação (income ou expense)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Create a new category",
+                "parameters": [
+                    {
+                        "description": "Dados da categoria",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Categoria criada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove uma categoria",
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Delete a category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da categoria",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Categoria removida"
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Categoria não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categorization-rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista as regras de categorização do usuário em ordem de prioridade",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categorization-rules"
+                ],
+                "summary": "List categorization rules",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Número máximo de resultados (default: 100, max: 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número de resultados para pular (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de regras",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CategorizationRuleResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma regra que categoriza, adiciona tags ou renomeia transações novas e importadas. Critérios: descrição (contém ou expressão regular), faixa de valor, contas e tags; regras de prioridade menor são avaliadas primeiro",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categorization-rules"
+                ],
+                "summary": "Create a categorization rule",
+                "parameters": [
+                    {
+                        "description": "Dados da regra",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateCategorizationRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Regra criada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CategorizationRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categorization-rules/dry-run": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Aplica as regras habilitadas (ou apenas a regra informada, sem salvá-la) às últimas N transações e mostra o que mudaria, sem gravar nada",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categorization-rules"
+                ],
+                "summary": "Dry run categorization rules",
+                "parameters": [
+                    {
+                        "description": "Quantidade de transações e regra opcional",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.DryRunRulesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resultado da simulação",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.DryRunRulesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categorization-rules/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a regra; transações já categorizadas por ela são mantidas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categorization-rules"
+                ],
+                "summary": "Delete a categorization rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da regra",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Regra removida"
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Regra não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza nome, prioridade, situação, critérios ou ações da regra; transações já gravadas não são alteradas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categorization-rules"
+                ],
+                "summary": "Update a categorization rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da regra",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.UpdateCategorizationRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Regra atualizada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CategorizationRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Regra não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/currencies": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista as moedas habilitadas com metadados ISO 4217 (código numérico, casas decimais, símbolo). Use all=true para o catálogo completo; o nome segue o parâmetro locale ou o cabeçalho Accept-Language (en, pt-BR)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "currencies"
+                ],
+                "summary": "List currencies",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Incluir moedas não habilitadas",
+                        "name": "all",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Idioma do nome (en, pt-BR)",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de moedas",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CurrencyResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/goals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista todas as metas financeiras do usuário com valores convertidos para a moeda padrão quando a moeda da meta difere",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "goals"
+                ],
+                "summary": "List goals",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Número máximo de resultados",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset para paginação",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Paginação por cursor: vazio na primeira página e depois o nextCursor da resposta anterior. Nesse modo offset é ignorado e a resposta é {items, nextCursor}",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de metas",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.GoalResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Cursor inválido",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma nova meta financeira (ex: guardar R$ 10.000)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "goals"
+                ],
+                "summary": "Create a financial goal",
+                "parameters": [
+                    {
+                        "description": "Dados da meta",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateGoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Meta criada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.GoalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Retorna o status da API, uptime e status dos serviços",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "API saudável",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/imports/csv": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Interpreta o extrato com o perfil de mapeamento e devolve a prévia das linhas sem gravar transações. A prévia expira em 24 horas se não for confirmada",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "Preview a CSV bank statement",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Extrato CSV (max 5MB)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do perfil de mapeamento",
+                        "name": "profileId",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da conta que receberá as transações",
+                        "name": "accountId",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Prévia da importação",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ImportBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Arquivo, perfil ou conta inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/imports/ofx": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Interpreta um extrato OFX 1.x (SGML) ou 2.x (XML) e devolve a prévia. O FITID de cada lançamento identifica a transação, então lançamentos já importados por um extrato sobreposto aparecem como duplicados e não são gravados de novo",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "Preview an OFX/QFX bank statement",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Extrato OFX ou QFX (max 5MB)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da conta que receberá as transações",
+                        "name": "accountId",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Prévia da importação",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ImportBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Arquivo ou conta inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/imports/profiles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista os perfis de mapeamento de extratos do usuário",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "List import mapping profiles",
+                "responses": {
+                    "200": {
+                        "description": "Lista de perfis",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ImportProfileResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Salva o mapeamento de colunas de um extrato CSV (índices a partir de zero, formato de data como DD/MM/YYYY, separador decimal, convenção de sinal e codificação como latin-1)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "Create an import mapping profile",
+                "parameters": [
+                    {
+                        "description": "Mapeamento do extrato",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateImportProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Perfil criado",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ImportProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Mapeamento inválido",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/imports/profiles/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove o perfil de mapeamento; importações já feitas são mantidas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "Delete an import mapping profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do perfil",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Perfil removido"
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Perfil não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/imports/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Consulta a prévia ou o resultado de uma importação",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "Get an import",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da importação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Importação",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ImportBatchResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Importação não encontrada ou expirada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/imports/{id}/commit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Grava as linhas válidas da prévia como transações na conta escolhida, usando a categoria de despesa ou de receita conforme o sinal. Repetir a confirmação não duplica transações",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "Commit an import",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da importação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Categorias padrão e linhas selecionadas",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CommitImportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resultado da importação",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ImportBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Categoria ausente ou de tipo incompatível",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Importação não encontrada ou expirada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliations/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mostra o saldo conferido, a diferença para o extrato e, na conciliação aberta, as transações candidatas (não conciliadas, até a data do extrato)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliations"
+                ],
+                "summary": "Get a reconciliation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da conciliação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Conciliação",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ReconciliationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conciliação não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Descarta a conciliação aberta; as marcações de conferida são mantidas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliations"
+                ],
+                "summary": "Cancel an open reconciliation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da conciliação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Conciliação descartada"
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conciliação não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conciliação já concluída",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliations/{id}/clear": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marca (ou desmarca, com cleared=false) transações como conferidas no extrato e devolve a diferença recalculada",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliations"
+                ],
+                "summary": "Mark transactions as cleared",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da conciliação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transações conferidas",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ClearTransactionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Conciliação atualizada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ReconciliationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Transação fora da conciliação",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conciliação ou transação não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conciliação já concluída",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliations/{id}/complete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Conclui a conciliação quando o saldo conferido bate com o extrato. As transações conferidas ficam travadas: não podem ser anuladas nem ter valor, conta, categoria, data ou moeda alterados",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliations"
+                ],
+                "summary": "Complete a reconciliation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da conciliação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Conciliação concluída",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ReconciliationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conciliação não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conciliação já concluída ou saldo conferido diferente do extrato",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/recurring-transactions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista as transações recorrentes do usuário com a próxima ocorrência",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-transactions"
+                ],
+                "summary": "List recurring transactions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Número máximo de resultados (default: 100, max: 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número de resultados para pular (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de recorrências",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RecurringTransactionResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma transação recorrente (diária, semanal, mensal no dia N ou no último dia útil). As ocorrências vencidas são gravadas automaticamente pelo agendador",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-transactions"
+                ],
+                "summary": "Create a recurring transaction",
+                "parameters": [
+                    {
+                        "description": "Dados da recorrência",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateRecurringTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Recorrência criada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RecurringTransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos, conta ou categoria inexistente",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conta encerrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/recurring-transactions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Consulta uma transação recorrente",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-transactions"
+                ],
+                "summary": "Get a recurring transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da recorrência",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recorrência",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RecurringTransactionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Recorrência não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a recorrência; transações já gravadas são mantidas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-transactions"
+                ],
+                "summary": "Delete a recurring transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da recorrência",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Recorrência removida"
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Recorrência não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza valor, conta, categoria, descrição ou data final; ocorrências já gravadas não mudam",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-transactions"
+                ],
+                "summary": "Update a recurring transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da recorrência",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.UpdateRecurringTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recorrência atualizada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RecurringTransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos, conta ou categoria inexistente",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Recorrência não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conta encerrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/recurring-transactions/{id}/pause": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Suspende a geração de ocorrências",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-transactions"
+                ],
+                "summary": "Pause a recurring transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da recorrência",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recorrência pausada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RecurringTransactionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Recorrência não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Recorrência não está ativa",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/recurring-transactions/{id}/resume": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reativa a recorrência a partir de hoje; ocorrências do período pausado não são geradas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-transactions"
+                ],
+                "summary": "Resume a recurring transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da recorrência",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recorrência reativada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RecurringTransactionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Recorrência não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Recorrência não está pausada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/recurring-transactions/{id}/skip": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Pula a próxima ocorrência sem gravar a transação",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-transactions"
+                ],
+                "summary": "Skip the next occurrence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da recorrência",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Próxima ocorrência atualizada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RecurringTransactionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Recorrência não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Recorrência não está ativa",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/net-worth": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soma os saldos de todas as contas convertidos para a moeda padrão do usuário pela cotação do dia: ativos (corrente, poupança, dinheiro) menos passivos (cartão de crédito), com o total por tipo de conta e o saldo de cada conta",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get current net worth",
+                "responses": {
+                    "200": {
+                        "description": "Patrimônio líquido",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.NetWorthResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Cotação de câmbio indisponível",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/net-worth/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Série do patrimônio líquido no fim de cada mês (ou dia/semana) do período, montada a partir do histórico de saldo das contas e convertida pela cotação do fim de cada período. Limite de 400 pontos",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get net worth history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Data inicial (RFC3339, default: 12 meses atrás)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final (RFC3339, default: agora)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Intervalo dos pontos: day, week ou month (default: month)",
+                        "name": "interval",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Histórico do patrimônio líquido",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.NetWorthHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Período ou intervalo inválido",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Cotação de câmbio indisponível",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/summary": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera um resumo financeiro com receitas, despesas e saldo do período, convertidos para a moeda padrão do usuário pela cotação da data de cada transação",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get financial summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Data inicial (RFC3339, default: 30 dias atrás)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final (RFC3339, default: hoje)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resumo financeiro",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SummaryReportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Cotação de câmbio indisponível",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/securities": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista os ativos cadastrados pelo usuário em ordem de símbolo",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "List securities",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Número máximo de resultados (default: 100, max: 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número de resultados para pular (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de ativos",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SecurityResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cadastra um ativo (ação, fundo, título) pelo símbolo, único por usuário. A moeda do ativo deve ser a mesma das contas de investimento em que ele for negociado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "Create a security",
+                "parameters": [
+                    {
+                        "description": "Dados do ativo",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateSecurityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Ativo cadastrado",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SecurityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Símbolo já cadastrado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/securities/prices/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Importa preços de fechamento de um CSV com as colunas symbol,date,price (ex.: PETR4,2024-01-31,38.42); a primeira linha pode ser um cabeçalho. Linhas de ativos não cadastrados são ignoradas e listadas na resposta; uma linha malformada recusa o arquivo inteiro",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "Import security prices",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Arquivo CSV de preços (max 5MB)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resumo da importação",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ImportPricesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Arquivo inválido",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/securities/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove o ativo e o histórico de preços dele. Ativos com operações registradas não podem ser removidos",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "Delete a security",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do ativo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Ativo removido"
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Ativo não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Ativo com operações",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Altera símbolo e nome do ativo; a moeda não pode ser alterada",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "Update a security",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do ativo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.UpdateSecurityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ativo atualizado",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SecurityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Ativo não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Símbolo já cadastrado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/securities/{id}/prices": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista o histórico de preços do ativo no período, do mais antigo para o mais recente",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "List security prices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do ativo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial (RFC3339, default: 30 dias atrás)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final (RFC3339, default: agora)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Histórico de preços",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SecurityPriceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Período inválido",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Ativo não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Grava o preço de fechamento do ativo no dia informado, substituindo o que houver para o mesmo dia",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "Set a security price",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do ativo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data e preço",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SetSecurityPriceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Preço gravado",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SecurityPriceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Preço ou data inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Ativo não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trades/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Desfaz a operação anulando o lançamento no caixa da conta. Recusada quando vendas posteriores ficariam acima da posição ou quando o lançamento já foi conciliado",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "Delete an investment trade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da operação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Operação removida"
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Operação não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Operação não pode ser desfeita",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista transações do usuário com filtros opcionais, busca textual na descrição, ordenação e paginação. Filtros de lista aceitam valores separados por vírgula",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "List transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Data inicial (ISO 8601)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final (ISO 8601)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número máximo de resultados (default: 100, max: 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número de resultados para pular (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Inclui transações anuladas (default: false)",
+                        "name": "includeVoided",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IDs das contas",
+                        "name": "accountId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IDs das categorias",
+                        "name": "categoryId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Inclui as subcategorias das categorias informadas (default: false)",
+                        "name": "includeSubcategories",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tags",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "any: qualquer uma das tags; all: todas (default: any)",
+                        "name": "tagMatch",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Valor mínimo",
+                        "name": "minAmount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Valor máximo",
+                        "name": "maxAmount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status (pending, completed, failed, voided)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Moedas (ISO 4217)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Busca textual na descrição",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordenação: occurredAt, amount, createdAt ou description; prefixo - para decrescente (default: -occurredAt)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Paginação por cursor: vazio na primeira página e depois o nextCursor da resposta anterior. Nesse modo offset é ignorado e a resposta é {items, nextCursor}",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de transações",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Filtro ou cursor inválido",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma nova transação financeira e publica evento para processamento assíncrono de budgets. Sem categoryId, a categoria vem das regras de categorização; as regras também adicionam tags e renomeiam a descrição (skipRules desativa). Possíveis duplicatas são marcadas para revisão ou recusadas conforme onDuplicate. Em contas de crédito, installments divide a compra em parcelas mensais vinculadas (metadado \"k/N\"), cada uma na sua fatura; a resposta traz a primeira parcela",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Create a new transaction",
+                "parameters": [
+                    {
+                        "description": "Dados da transação",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Transação criada com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Possível duplicata recusada (onDuplicate=reject)",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/duplicates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista as transações marcadas como possíveis duplicatas (mesma conta, tipo e valor, datas até 3 dias de distância e mesma descrição normalizada) ao lado da original",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "List suspected duplicate transactions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Número máximo de resultados (default: 100, max: 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número de resultados para pular (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Possíveis duplicatas",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.DuplicateTransactionResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Anula a transação (mantida para auditoria), estorna o saldo da conta e publica evento compensatório para os orçamentos",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Void a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Motivo da anulação",
+                        "name": "reason",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Transação anulada"
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Transação não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transação já anulada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza uma transação existente. Alterar valor, conta, categoria, data ou moeda estorna o efeito anterior no saldo e nos orçamentos e aplica o novo",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Update a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.UpdateTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transação atualizada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos ou moeda diferente da conta",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Transação não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/duplicate/dismiss": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Descarta a suspeita de duplicidade e mantém as duas transações",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Dismiss a duplicate suspicion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação marcada como duplicata",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transação sem a marcação",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Transação não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transação não está marcada como duplicata",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/duplicate/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Confirma a duplicidade: copia tags, notas e recibo que faltam na original e anula a duplicata, estornando saldo e orçamentos",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Merge a duplicate into the original transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação marcada como duplicata",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transação original atualizada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Transação não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transação não está marcada como duplicata",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/installments/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Anula as parcelas com data futura da compra parcelada a que a transação pertence, estornando o saldo do cartão e os orçamentos; parcelas já lançadas são mantidas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Cancel the remaining installments of a purchase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID de qualquer parcela da compra",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Motivo do cancelamento",
+                        "name": "reason",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Parcelas anuladas",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CancelInstallmentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Transação não é parcelada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Transação não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Não há parcelas restantes",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/receipt": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Envia e criptografa um recibo para a transação. Arquivo é armazenado no S3 com AES-256",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Attach receipt to transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Arquivo do recibo (PDF, PNG, JPG - max 5MB)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recibo anexado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Arquivo inválido ou muito grande",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Transação não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Debita a conta de origem e credita a conta de destino, vinculando as duas pernas. Transferências não entram nos totais de receitas/despesas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Transfer between accounts",
+                "parameters": [
+                    {
+                        "description": "Dados da transferência",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Transferência registrada",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos ou taxa de câmbio ausente",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Não autenticado",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conta não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/src_internal_adapters_http_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.AccountResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string",
+                    "example": "1500.00"
+                },
+                "closedAt": {
+                    "type": "string"
+                },
+                "creditCard": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreditCard"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "investment": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.InvestmentSettings"
+                },
+                "loan": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanTerms"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "savings": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SavingsSettings"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.AmortizationRowResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "description": "saldo devedor depois da parcela",
+                    "type": "string",
+                    "example": "349027.78"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "extra": {
+                    "type": "string",
+                    "example": "0.00"
+                },
+                "interest": {
+                    "type": "string",
+                    "example": "2920.79"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "payment": {
+                    "type": "string",
+                    "example": "3893.01"
+                },
+                "principal": {
+                    "type": "string",
+                    "example": "972.22"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.BalanceHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "interval": {
+                    "type": "string",
+                    "example": "day"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.BalancePoint"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.BalancePoint": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string",
+                    "example": "1520.35"
+                },
+                "date": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.BudgetResponse": {
+            "type": "object",
+            "properties": {
+                "alertPercent": {
+                    "type": "number"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "800.00"
+                },
+                "categoryId": {
+                    "type": "string"
+                },
+                "convertedAmount": {
+                    "type": "string",
+                    "example": "4000.00"
+                },
+                "convertedSpent": {
+                    "type": "string",
+                    "example": "602.50"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "displayCurrency": {
+                    "description": "Valores convertidos para a moeda padrão do usuário pela cotação do dia (ausentes sem cotação)",
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "periodEnd": {
+                    "type": "string"
+                },
+                "periodStart": {
+                    "type": "string"
+                },
+                "spent": {
+                    "type": "string",
+                    "example": "120.50"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CancelInstallmentsResponse": {
+            "type": "object",
+            "properties": {
+                "cancelledTransactionIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "refunded": {
+                    "description": "soma das parcelas anuladas, devolvida ao saldo do cartão",
+                    "type": "string",
+                    "example": "700.00"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CategorizationRuleResponse": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RuleActions"
+                },
+                "conditions": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RuleConditions"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parentId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.ClearTransactionsRequest": {
+            "type": "object",
+            "required": [
+                "transactionIds"
+            ],
+            "properties": {
+                "cleared": {
+                    "description": "padrão true; false desmarca",
+                    "type": "boolean"
+                },
+                "transactionIds": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CloseAccountRequest": {
+            "type": "object",
+            "properties": {
+                "closedAt": {
+                    "description": "padrão: agora",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CommitImportRequest": {
+            "type": "object",
+            "properties": {
+                "expenseCategoryId": {
+                    "type": "string"
+                },
+                "incomeCategoryId": {
+                    "type": "string"
+                },
+                "lines": {
+                    "description": "linhas a importar; vazio importa todas as linhas válidas",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateAccountRequest": {
+            "type": "object",
+            "required": [
+                "currency",
+                "name",
+                "type"
+            ],
+            "properties": {
+                "balance": {
+                    "type": "string",
+                    "example": "1500.00"
+                },
+                "creditCard": {
+                    "description": "ciclo de fatura; só para contas do tipo credit",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreditCard"
+                        }
+                    ]
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "investment": {
+                    "description": "método de custo; só para contas do tipo investment",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.InvestmentSettings"
+                        }
+                    ]
+                },
+                "loan": {
+                    "description": "termos do empréstimo; obrigatório em contas do tipo loan",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanTerms"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "savings": {
+                    "description": "rendimento; só para contas do tipo savings",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SavingsSettings"
+                        }
+                    ]
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "checking",
+                        "savings",
+                        "credit",
+                        "cash",
+                        "investment",
+                        "loan"
+                    ]
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateBudgetRequest": {
+            "type": "object",
+            "required": [
+                "alertPercent",
+                "amount",
+                "categoryId",
+                "currency",
+                "period",
+                "periodEnd",
+                "periodStart"
+            ],
+            "properties": {
+                "alertPercent": {
+                    "type": "number"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "800.00"
+                },
+                "categoryId": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string",
+                    "enum": [
+                        "monthly",
+                        "quarterly",
+                        "yearly"
+                    ]
+                },
+                "periodEnd": {
+                    "type": "string"
+                },
+                "periodStart": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateCategorizationRuleRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "actions": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RuleActions"
+                },
+                "conditions": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RuleConditions"
+                },
+                "enabled": {
+                    "description": "padrão true",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "priority": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 10
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateCategoryRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "type"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parentId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "income",
+                        "expense"
+                    ]
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateGoalRequest": {
+            "type": "object",
+            "required": [
+                "currency",
+                "deadline",
+                "name",
+                "targetAmount"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "deadline": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "targetAmount": {
+                    "type": "string",
+                    "example": "10000.00"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateImportProfileRequest": {
+            "type": "object",
+            "required": [
+                "dateFormat",
+                "name",
+                "signConvention"
+            ],
+            "properties": {
+                "amountColumn": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 2
+                },
+                "creditColumn": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "dateColumn": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "dateFormat": {
+                    "type": "string",
+                    "example": "DD/MM/YYYY"
+                },
+                "debitColumn": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "decimalSeparator": {
+                    "type": "string",
+                    "example": ","
+                },
+                "delimiter": {
+                    "type": "string",
+                    "example": ";"
+                },
+                "descriptionColumn": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                },
+                "encoding": {
+                    "type": "string",
+                    "enum": [
+                        "utf-8",
+                        "latin-1",
+                        "windows-1252"
+                    ],
+                    "example": "latin-1"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "signConvention": {
+                    "type": "string",
+                    "enum": [
+                        "negative_expense",
+                        "positive_expense",
+                        "debit_credit_columns"
+                    ],
+                    "example": "negative_expense"
+                },
+                "skipRows": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateRecurringTransactionRequest": {
+            "type": "object",
+            "required": [
+                "accountId",
+                "amount",
+                "categoryId",
+                "currency",
+                "frequency",
+                "startDate"
+            ],
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "1500.00"
+                },
+                "categoryId": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "dayOfMonth": {
+                    "type": "integer",
+                    "maximum": 31,
+                    "minimum": 1,
+                    "example": 5
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "frequency": {
+                    "type": "string",
+                    "enum": [
+                        "daily",
+                        "weekly",
+                        "monthly",
+                        "last_business_day"
+                    ],
+                    "example": "monthly"
+                },
+                "interval": {
+                    "type": "integer",
+                    "maximum": 366,
+                    "minimum": 1,
+                    "example": 1
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "weekday": {
+                    "type": "integer",
+                    "maximum": 6,
+                    "minimum": 0,
+                    "example": 1
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateSecurityRequest": {
+            "type": "object",
+            "required": [
+                "currency",
+                "symbol"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Petrobras PN"
+                },
+                "symbol": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "PETR4"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateTradeRequest": {
+            "type": "object",
+            "required": [
+                "occurredAt",
+                "securityId",
+                "type"
+            ],
+            "properties": {
+                "categoryId": {
+                    "description": "categoria de receita; obrigatória em proventos",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "padrão: tipo, quantidade e ativo",
+                    "type": "string"
+                },
+                "fees": {
+                    "description": "corretagem e outras taxas",
+                    "type": "string",
+                    "example": "4.90"
+                },
+                "occurredAt": {
+                    "type": "string"
+                },
+                "price": {
+                    "description": "preço unitário; em proventos, o valor bruto recebido",
+                    "type": "string",
+                    "example": "38.42"
+                },
+                "quantity": {
+                    "description": "cotas; vazio em proventos",
+                    "type": "string",
+                    "example": "100"
+                },
+                "securityId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "buy",
+                        "sell",
+                        "dividend"
+                    ]
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateTransactionRequest": {
+            "type": "object",
+            "required": [
+                "accountId",
+                "amount",
+                "currency",
+                "occurredAt"
+            ],
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "120.50"
+                },
+                "categoryId": {
+                    "description": "opcional quando uma regra de categorização define a categoria",
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "externalRef": {
+                    "description": "reenviar a mesma referência devolve a transação já gravada",
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "installments": {
+                    "description": "parcelas mensais; só em contas de crédito",
+                    "type": "integer",
+                    "maximum": 48,
+                    "minimum": 2,
+                    "example": 10
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "occurredAt": {
+                    "type": "string"
+                },
+                "onDuplicate": {
+                    "description": "possível duplicata: marcar (padrão), recusar ou ignorar",
+                    "type": "string",
+                    "enum": [
+                        "flag",
+                        "reject",
+                        "allow"
+                    ]
+                },
+                "skipRules": {
+                    "description": "não aplica as regras de categorização",
+                    "type": "boolean"
+                },
+                "splits": {
+                    "description": "rateio entre categorias; as linhas devem somar amount",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionSplit"
+                    }
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateTransferRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "fromAccountId",
+                "occurredAt",
+                "toAccountId"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "500.00"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "destinationAmount": {
+                    "type": "string",
+                    "example": "92.50"
+                },
+                "exchangeRate": {
+                    "type": "number"
+                },
+                "fromAccountId": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "occurredAt": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "toAccountId": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreditCard": {
+            "type": "object",
+            "required": [
+                "closingDay",
+                "dueDay"
+            ],
+            "properties": {
+                "closingDay": {
+                    "type": "integer",
+                    "maximum": 31,
+                    "minimum": 1,
+                    "example": 25
+                },
+                "creditLimit": {
+                    "type": "string",
+                    "example": "5000.00"
+                },
+                "dueDay": {
+                    "type": "integer",
+                    "maximum": 31,
+                    "minimum": 1,
+                    "example": 5
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CreditCardSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "availableCredit": {
+                    "type": "string",
+                    "example": "3800.00"
+                },
+                "balance": {
+                    "type": "string",
+                    "example": "-1200.00"
+                },
+                "closedStatement": {
+                    "description": "última fatura fechada, a que está para pagar",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.StatementResponse"
+                        }
+                    ]
+                },
+                "creditLimit": {
+                    "type": "string",
+                    "example": "5000.00"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "current": {
+                    "description": "fatura aberta",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.StatementResponse"
+                        }
+                    ]
+                },
+                "next": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.StatementResponse"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.CurrencyResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "minorUnits": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Yen"
+                },
+                "numericCode": {
+                    "type": "string",
+                    "example": "392"
+                },
+                "symbol": {
+                    "type": "string",
+                    "example": "¥"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.DryRunRulesRequest": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "últimas N transações (padrão 50)",
+                    "type": "integer",
+                    "maximum": 500,
+                    "minimum": 1,
+                    "example": 50
+                },
+                "rule": {
+                    "description": "testa só esta regra, sem salvá-la, em vez das regras habilitadas",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreateCategorizationRuleRequest"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.DryRunRulesResponse": {
+            "type": "object",
+            "properties": {
+                "evaluated": {
+                    "type": "integer"
+                },
+                "matched": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RuleDryRunResult"
+                    }
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.DuplicateTransactionResponse": {
+            "type": "object",
+            "properties": {
+                "original": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionResponse"
+                },
+                "transaction": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionResponse"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.GoalResponse": {
+            "type": "object",
+            "properties": {
+                "convertedCurrentAmount": {
+                    "type": "string",
+                    "example": "12500.00"
+                },
+                "convertedTargetAmount": {
+                    "type": "string",
+                    "example": "50000.00"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "currentAmount": {
+                    "type": "string",
+                    "example": "2500.00"
+                },
+                "deadline": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "displayCurrency": {
+                    "description": "Valores convertidos para a moeda padrão do usuário pela cotação do dia (ausentes sem cotação)",
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "targetAmount": {
+                    "type": "string",
+                    "example": "10000.00"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.HoldingResponse": {
+            "type": "object",
+            "properties": {
+                "averageCost": {
+                    "type": "string",
+                    "example": "38.47"
+                },
+                "costBasis": {
+                    "type": "string",
+                    "example": "3846.90"
+                },
+                "dividends": {
+                    "type": "string",
+                    "example": "52.00"
+                },
+                "lots": {
+                    "description": "só no método FIFO",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LotResponse"
+                    }
+                },
+                "marketValue": {
+                    "type": "string",
+                    "example": "4110.00"
+                },
+                "price": {
+                    "type": "string",
+                    "example": "41.10"
+                },
+                "priceDate": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "string",
+                    "example": "100"
+                },
+                "realizedGain": {
+                    "type": "string",
+                    "example": "0.00"
+                },
+                "securityId": {
+                    "type": "string"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "unrealizedGain": {
+                    "type": "string",
+                    "example": "263.10"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.ImportBatchResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "committedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "duplicateRows": {
+                    "type": "integer"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "importedRows": {
+                    "type": "integer"
+                },
+                "invalidRows": {
+                    "type": "integer"
+                },
+                "profileId": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ImportRowResponse"
+                    }
+                },
+                "source": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "totalRows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.ImportPricesResponse": {
+            "type": "object",
+            "properties": {
+                "imported": {
+                    "type": "integer"
+                },
+                "unknownSymbols": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.ImportProfileResponse": {
+            "type": "object",
+            "properties": {
+                "amountColumn": {
+                    "type": "integer"
+                },
+                "creditColumn": {
+                    "type": "integer"
+                },
+                "dateColumn": {
+                    "type": "integer"
+                },
+                "dateFormat": {
+                    "type": "string"
+                },
+                "debitColumn": {
+                    "type": "integer"
+                },
+                "decimalSeparator": {
+                    "type": "string"
+                },
+                "delimiter": {
+                    "type": "string"
+                },
+                "descriptionColumn": {
+                    "type": "integer"
+                },
+                "encoding": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "signConvention": {
+                    "type": "string"
+                },
+                "skipRows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.ImportRowResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "89.90"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "externalRef": {
+                    "type": "string"
+                },
+                "line": {
+                    "type": "integer"
+                },
+                "occurredAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "transactionId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.Installment": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.InvestmentSettings": {
+            "type": "object",
+            "properties": {
+                "costBasis": {
+                    "description": "padrão fifo",
+                    "type": "string",
+                    "enum": [
+                        "fifo",
+                        "average"
+                    ],
+                    "example": "fifo"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanPaymentResponse": {
+            "type": "object",
+            "properties": {
+                "interest": {
+                    "type": "string",
+                    "example": "2920.79"
+                },
+                "loan": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanResponse"
+                },
+                "number": {
+                    "description": "parcela paga; vazio em amortização extraordinária",
+                    "type": "integer"
+                },
+                "outstanding": {
+                    "type": "string",
+                    "example": "349027.78"
+                },
+                "paymentId": {
+                    "description": "usado para estornar o pagamento",
+                    "type": "string"
+                },
+                "principal": {
+                    "type": "string",
+                    "example": "972.22"
+                },
+                "transactionIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanProjection": {
+            "type": "object",
+            "properties": {
+                "installments": {
+                    "type": "integer"
+                },
+                "nextPayment": {
+                    "type": "string",
+                    "example": "3893.01"
+                },
+                "payoffDate": {
+                    "type": "string"
+                },
+                "totalInterest": {
+                    "type": "string",
+                    "example": "512345.67"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "annualRate": {
+                    "type": "number",
+                    "example": 10.5
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "lastPaymentId": {
+                    "description": "pagamento mais recente, o único que pode ser estornado",
+                    "type": "string"
+                },
+                "monthlyRate": {
+                    "description": "taxa mensal equivalente, em percentual",
+                    "type": "number",
+                    "example": 0.8355
+                },
+                "nextPayment": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.AmortizationRowResponse"
+                },
+                "outstanding": {
+                    "type": "string",
+                    "example": "349027.78"
+                },
+                "paidInstallments": {
+                    "type": "integer"
+                },
+                "payoffDate": {
+                    "type": "string"
+                },
+                "principal": {
+                    "type": "string",
+                    "example": "350000.00"
+                },
+                "remainingInstallments": {
+                    "type": "integer"
+                },
+                "remainingInterest": {
+                    "type": "string",
+                    "example": "512345.67"
+                },
+                "schedule": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.AmortizationRowResponse"
+                    }
+                },
+                "system": {
+                    "type": "string",
+                    "example": "sac"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanSimulationResponse": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanProjection"
+                },
+                "installmentsSaved": {
+                    "type": "integer"
+                },
+                "interestSaved": {
+                    "type": "string",
+                    "example": "98765.43"
+                },
+                "schedule": {
+                    "description": "cronograma simulado",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.AmortizationRowResponse"
+                    }
+                },
+                "simulated": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanProjection"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.LoanTerms": {
+            "type": "object",
+            "required": [
+                "firstDueDate",
+                "termMonths"
+            ],
+            "properties": {
+                "annualRate": {
+                    "description": "taxa efetiva ao ano, em percentual",
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 10.5
+                },
+                "firstDueDate": {
+                    "type": "string"
+                },
+                "principal": {
+                    "type": "string",
+                    "example": "350000.00"
+                },
+                "system": {
+                    "description": "padrão sac",
+                    "type": "string",
+                    "enum": [
+                        "price",
+                        "sac"
+                    ],
+                    "example": "sac"
+                },
+                "termMonths": {
+                    "type": "integer",
+                    "maximum": 420,
+                    "minimum": 1,
+                    "example": 360
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "expiresIn": {
+                    "type": "integer"
+                },
+                "idToken": {
+                    "type": "string"
+                },
+                "refreshToken": {
+                    "type": "string"
+                },
+                "tokenType": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.LotResponse": {
+            "type": "object",
+            "properties": {
+                "acquiredAt": {
+                    "type": "string"
+                },
+                "cost": {
+                    "type": "string",
+                    "example": "1921.45"
+                },
+                "quantity": {
+                    "type": "string",
+                    "example": "50"
+                },
+                "tradeId": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.NetWorthAccount": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "balance": {
+                    "type": "string",
+                    "example": "200.00"
+                },
+                "convertedBalance": {
+                    "description": "saldo mais valor de mercado, na moeda do relatório",
+                    "type": "string",
+                    "example": "1000.00"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "marketValue": {
+                    "description": "posições a preço de mercado; só em contas de investimento",
+                    "type": "string",
+                    "example": "0.00"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "checking"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.NetWorthHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "interval": {
+                    "type": "string",
+                    "example": "month"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.NetWorthPoint"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.NetWorthPoint": {
+            "type": "object",
+            "properties": {
+                "assets": {
+                    "type": "string",
+                    "example": "25000.00"
+                },
+                "byType": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "date": {
+                    "type": "string"
+                },
+                "liabilities": {
+                    "type": "string",
+                    "example": "3200.00"
+                },
+                "netWorth": {
+                    "type": "string",
+                    "example": "21800.00"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.NetWorthResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.NetWorthAccount"
+                    }
+                },
+                "assets": {
+                    "type": "string",
+                    "example": "25000.00"
+                },
+                "byType": {
+                    "description": "saldo somado por tipo de conta",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "liabilities": {
+                    "description": "valor devido, positivo",
+                    "type": "string",
+                    "example": "3200.00"
+                },
+                "netWorth": {
+                    "type": "string",
+                    "example": "21800.00"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.PayLoanInstallmentRequest": {
+            "type": "object",
+            "required": [
+                "categoryId",
+                "fromAccountId"
+            ],
+            "properties": {
+                "categoryId": {
+                    "description": "categoria de despesa dos juros",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "fromAccountId": {
+                    "type": "string"
+                },
+                "occurredAt": {
+                    "description": "padrão: agora",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.PayStatementRequest": {
+            "type": "object",
+            "required": [
+                "fromAccountId"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "padrão: saldo em aberto da última fatura fechada",
+                    "type": "string",
+                    "example": "1200.00"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "fromAccountId": {
+                    "type": "string"
+                },
+                "occurredAt": {
+                    "description": "padrão: agora",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.PortfolioResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "cash": {
+                    "type": "string",
+                    "example": "1200.00"
+                },
+                "costBasisMethod": {
+                    "type": "string",
+                    "example": "fifo"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "dividends": {
+                    "type": "string",
+                    "example": "52.00"
+                },
+                "holdings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.HoldingResponse"
+                    }
+                },
+                "marketValue": {
+                    "type": "string",
+                    "example": "4110.00"
+                },
+                "realizedGain": {
+                    "type": "string",
+                    "example": "0.00"
+                },
+                "totalValue": {
+                    "type": "string",
+                    "example": "5310.00"
+                },
+                "unrealizedGain": {
+                    "type": "string",
+                    "example": "263.10"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.PrepayLoanRequest": {
+            "type": "object",
+            "required": [
+                "fromAccountId"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "20000.00"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "fromAccountId": {
+                    "type": "string"
+                },
+                "mode": {
+                    "description": "padrão reduce_term",
+                    "type": "string",
+                    "enum": [
+                        "reduce_term",
+                        "reduce_payment"
+                    ],
+                    "example": "reduce_term"
+                },
+                "occurredAt": {
+                    "description": "padrão: agora",
+                    "type": "string"
                 }
             }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.CategoryResponse": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.ReconciliationResponse": {
             "type": "object",
             "properties": {
-                "description": {
+                "accountId": {
+                    "type": "string"
+                },
+                "clearedBalance": {
+                    "description": "saldo das transações conferidas",
+                    "type": "string",
+                    "example": "1490.35"
+                },
+                "completedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
                     "type": "string"
                 },
+                "difference": {
+                    "description": "extrato menos conferido; precisa ser zero para concluir",
+                    "type": "string",
+                    "example": "30.00"
+                },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "statementBalance": {
+                    "type": "string",
+                    "example": "1520.35"
+                },
+                "statementDate": {
                     "type": "string"
                 },
-                "parentId": {
+                "status": {
+                    "type": "string"
+                },
+                "transactionIds": {
+                    "description": "transações travadas, na conciliação concluída",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "transactions": {
+                    "description": "candidatas, só na conciliação aberta",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.ReconciliationTransaction"
+                    }
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.ReconciliationTransaction": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "120.50"
+                },
+                "cleared": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "occurredAt": {
                     "type": "string"
                 },
                 "type": {
@@ -1001,303 +6129,625 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.CreateAccountRequest": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.RecurringTransactionResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "1500.00"
+                },
+                "categoryId": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "dayOfMonth": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "failureCount": {
+                    "description": "falhas seguidas; a recorrência é pausada ao chegar ao limite",
+                    "type": "integer"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "interval": {
+                    "type": "integer"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "lastOccurrence": {
+                    "type": "string"
+                },
+                "nextOccurrence": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "weekday": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.RuleActions": {
+            "type": "object",
+            "properties": {
+                "addTags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "categoryId": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Uber"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.RuleConditions": {
+            "type": "object",
+            "properties": {
+                "accountIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "descriptionContains": {
+                    "type": "string",
+                    "example": "uber"
+                },
+                "descriptionRegex": {
+                    "type": "string",
+                    "example": "^PAG\\*UBER"
+                },
+                "maxAmount": {
+                    "type": "string",
+                    "example": "200.00"
+                },
+                "minAmount": {
+                    "type": "string",
+                    "example": "10.00"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.RuleDryRunResult": {
+            "type": "object",
+            "properties": {
+                "addedTags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "categoryId": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "matchedRules": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "newCategoryId": {
+                    "type": "string"
+                },
+                "newDescription": {
+                    "type": "string"
+                },
+                "occurredAt": {
+                    "type": "string"
+                },
+                "transactionId": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.SavingsProjectionResponse": {
             "type": "object",
-            "required": [
-                "currency",
-                "name",
-                "type"
-            ],
             "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "accruedThrough": {
+                    "description": "primeiro dia cujos juros ainda não foram lançados",
+                    "type": "string"
+                },
+                "annualRate": {
+                    "type": "number",
+                    "example": 6.17
+                },
                 "balance": {
-                    "type": "number"
+                    "type": "string",
+                    "example": "10000.00"
+                },
+                "compounding": {
+                    "type": "string",
+                    "example": "monthly"
                 },
                 "currency": {
+                    "type": "string"
+                },
+                "effectiveAnnualRate": {
+                    "description": "rendimento em um ano, em percentual",
+                    "type": "number",
+                    "example": 6.3451
+                },
+                "finalBalance": {
+                    "type": "string",
+                    "example": "10634.51"
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SavingsProjectionRow"
+                    }
+                },
+                "totalInterest": {
+                    "type": "string",
+                    "example": "634.51"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.SavingsProjectionRow": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string",
+                    "example": "10051.42"
+                },
+                "date": {
+                    "description": "data do lançamento dos juros",
+                    "type": "string"
+                },
+                "interest": {
+                    "type": "string",
+                    "example": "51.42"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.SavingsSettings": {
+            "type": "object",
+            "properties": {
+                "annualRate": {
+                    "description": "taxa nominal ao ano, em percentual",
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 6.17
+                },
+                "compounding": {
+                    "description": "padrão monthly",
                     "type": "string",
                     "enum": [
-                        "USD",
-                        "EUR",
-                        "CHF",
-                        "GBP",
-                        "BRL"
-                    ]
+                        "daily",
+                        "monthly"
+                    ],
+                    "example": "monthly"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.SecurityPriceResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
                 },
-                "description": {
+                "price": {
+                    "type": "string",
+                    "example": "38.42"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "manual"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.SecurityResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "type": {
+                "symbol": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.SetSecurityPriceRequest": {
+            "type": "object",
+            "required": [
+                "date"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string",
+                    "example": "38.42"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.SimulateLoanRequest": {
+            "type": "object",
+            "properties": {
+                "extra": {
+                    "type": "string",
+                    "example": "20000.00"
+                },
+                "mode": {
+                    "description": "padrão reduce_term",
                     "type": "string",
                     "enum": [
-                        "checking",
-                        "savings",
-                        "credit",
-                        "cash"
-                    ]
+                        "reduce_term",
+                        "reduce_payment"
+                    ],
+                    "example": "reduce_term"
+                },
+                "monthlyExtra": {
+                    "type": "string",
+                    "example": "500.00"
                 }
             }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.CreateBudgetRequest": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.StartReconciliationRequest": {
             "type": "object",
             "required": [
-                "alertPercent",
-                "amount",
-                "categoryId",
-                "currency",
-                "period",
-                "periodEnd",
-                "periodStart"
+                "statementDate"
             ],
             "properties": {
-                "alertPercent": {
-                    "type": "number"
+                "statementBalance": {
+                    "type": "string",
+                    "example": "1520.35"
                 },
-                "amount": {
-                    "type": "number"
+                "statementDate": {
+                    "description": "data final do extrato",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.StatementResponse": {
+            "type": "object",
+            "properties": {
+                "charges": {
+                    "description": "compras",
+                    "type": "string",
+                    "example": "1250.00"
                 },
-                "categoryId": {
+                "closed": {
+                    "type": "boolean"
+                },
+                "closingDate": {
                     "type": "string"
                 },
-                "currency": {
+                "credits": {
+                    "description": "estornos e créditos",
                     "type": "string",
-                    "enum": [
-                        "USD",
-                        "EUR",
-                        "CHF",
-                        "GBP",
-                        "BRL"
-                    ]
+                    "example": "50.00"
                 },
-                "period": {
+                "dueDate": {
+                    "type": "string"
+                },
+                "outstanding": {
+                    "description": "total menos pagamentos",
                     "type": "string",
-                    "enum": [
-                        "monthly",
-                        "quarterly",
-                        "yearly"
-                    ]
+                    "example": "1200.00"
                 },
-                "periodEnd": {
-                    "type": "string"
+                "paid": {
+                    "description": "pagamentos feitos depois do fechamento",
+                    "type": "string",
+                    "example": "0.00"
                 },
                 "periodStart": {
                     "type": "string"
+                },
+                "previousBalance": {
+                    "description": "saldo deixado sem pagar nas faturas anteriores",
+                    "type": "string",
+                    "example": "0.00"
+                },
+                "total": {
+                    "description": "saldo anterior mais compras menos créditos",
+                    "type": "string",
+                    "example": "1200.00"
                 }
             }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.CreateCategoryRequest": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.SummaryReportResponse": {
             "type": "object",
-            "required": [
-                "name",
-                "type"
-            ],
             "properties": {
-                "description": {
-                    "type": "string"
+                "budgetUsage": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "currency": {
+                    "type": "string",
+                    "example": "BRL"
                 },
-                "parentId": {
-                    "type": "string"
+                "goalProgress": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
                 },
-                "type": {
+                "netBalance": {
                     "type": "string",
-                    "enum": [
-                        "income",
-                        "expense"
-                    ]
+                    "example": "1800.00"
+                },
+                "spendingByCategory": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "totalExpense": {
+                    "type": "string",
+                    "example": "3200.00"
+                },
+                "totalIncome": {
+                    "type": "string",
+                    "example": "5000.00"
                 }
             }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.CreateGoalRequest": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.TradeResponse": {
             "type": "object",
-            "required": [
-                "currency",
-                "deadline",
-                "name",
-                "targetAmount"
-            ],
             "properties": {
-                "currency": {
+                "accountId": {
+                    "type": "string"
+                },
+                "cashAmount": {
+                    "description": "valor que saiu ou entrou no caixa da conta",
                     "type": "string",
-                    "enum": [
-                        "USD",
-                        "EUR",
-                        "CHF",
-                        "GBP",
-                        "BRL"
-                    ]
+                    "example": "3846.90"
                 },
-                "deadline": {
+                "fees": {
+                    "type": "string",
+                    "example": "4.90"
+                },
+                "id": {
                     "type": "string"
                 },
-                "description": {
+                "occurredAt": {
                     "type": "string"
                 },
-                "name": {
+                "price": {
+                    "type": "string",
+                    "example": "38.42"
+                },
+                "quantity": {
+                    "type": "string",
+                    "example": "100"
+                },
+                "securityId": {
                     "type": "string"
                 },
-                "targetAmount": {
-                    "type": "number"
+                "transactionId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.CreateTransactionRequest": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionResponse": {
             "type": "object",
-            "required": [
-                "accountId",
-                "amount",
-                "categoryId",
-                "currency",
-                "occurredAt"
-            ],
             "properties": {
                 "accountId": {
                     "type": "string"
                 },
                 "amount": {
-                    "type": "number"
+                    "type": "string",
+                    "example": "120.50"
                 },
                 "categoryId": {
                     "type": "string"
                 },
+                "cleared": {
+                    "type": "boolean"
+                },
                 "currency": {
-                    "type": "string",
-                    "enum": [
-                        "USD",
-                        "EUR",
-                        "CHF",
-                        "GBP",
-                        "BRL"
-                    ]
+                    "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
+                "duplicateOf": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "installment": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.Installment"
+                },
+                "linkedTransactionId": {
+                    "type": "string"
+                },
                 "notes": {
                     "type": "string"
                 },
                 "occurredAt": {
                     "type": "string"
                 },
-                "tags": {
+                "receiptUrl": {
+                    "type": "string"
+                },
+                "reconciliationId": {
+                    "description": "conciliada: não pode mais ser alterada nem anulada",
+                    "type": "string"
+                },
+                "splits": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionSplit"
                     }
-                }
-            }
-        },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.GoalResponse": {
-            "type": "object",
-            "properties": {
-                "currency": {
-                    "type": "string"
-                },
-                "currentAmount": {
-                    "type": "number"
                 },
-                "deadline": {
+                "status": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "id": {
+                "transferId": {
                     "type": "string"
                 },
-                "name": {
+                "type": {
                     "type": "string"
                 },
-                "status": {
+                "voidReason": {
                     "type": "string"
                 },
-                "targetAmount": {
-                    "type": "number"
+                "voidedAt": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.LoginRequest": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionSplit": {
             "type": "object",
             "required": [
-                "password",
-                "username"
+                "amount",
+                "categoryId"
             ],
             "properties": {
-                "password": {
+                "amount": {
                     "type": "string",
-                    "minLength": 8
+                    "example": "80.00"
                 },
-                "username": {
+                "categoryId": {
                     "type": "string"
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 200
                 }
             }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.LoginResponse": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.TransferResponse": {
             "type": "object",
             "properties": {
-                "accessToken": {
+                "exchangeRate": {
+                    "type": "number"
+                },
+                "from": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionResponse"
+                },
+                "id": {
                     "type": "string"
                 },
-                "expiresIn": {
-                    "type": "integer"
+                "to": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionResponse"
+                }
+            }
+        },
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.UpdateAccountRequest": {
+            "type": "object",
+            "properties": {
+                "creditCard": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.CreditCard"
                 },
-                "idToken": {
+                "currency": {
                     "type": "string"
                 },
-                "refreshToken": {
+                "description": {
                     "type": "string"
                 },
-                "tokenType": {
+                "investment": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.InvestmentSettings"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "savings": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.SavingsSettings"
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.SummaryReportResponse": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.UpdateCategorizationRuleRequest": {
             "type": "object",
             "properties": {
-                "budgetUsage": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "number",
-                        "format": "float64"
-                    }
-                },
-                "goalProgress": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "number",
-                        "format": "float64"
-                    }
+                "actions": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RuleActions"
                 },
-                "netBalance": {
-                    "type": "number"
+                "conditions": {
+                    "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.RuleConditions"
                 },
-                "spendingByCategory": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "number",
-                        "format": "float64"
-                    }
+                "enabled": {
+                    "type": "boolean"
                 },
-                "totalExpense": {
-                    "type": "number"
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
                 },
-                "totalIncome": {
-                    "type": "number"
+                "priority": {
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.TransactionResponse": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.UpdateRecurringTransactionRequest": {
             "type": "object",
             "properties": {
                 "accountId": {
                     "type": "string"
                 },
                 "amount": {
-                    "type": "number"
+                    "type": "string",
+                    "example": "1500.00"
                 },
                 "categoryId": {
                     "type": "string"
@@ -1308,19 +6758,7 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
-                },
-                "notes": {
-                    "type": "string"
-                },
-                "occurredAt": {
-                    "type": "string"
-                },
-                "receiptUrl": {
-                    "type": "string"
-                },
-                "status": {
+                "endDate": {
                     "type": "string"
                 },
                 "tags": {
@@ -1331,45 +6769,60 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.UpdateAccountRequest": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.UpdateSecurityRequest": {
             "type": "object",
             "properties": {
-                "currency": {
-                    "type": "string",
-                    "enum": [
-                        "USD",
-                        "EUR",
-                        "CHF",
-                        "GBP",
-                        "BRL"
-                    ]
-                },
-                "description": {
-                    "type": "string"
-                },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 },
-                "type": {
-                    "type": "string"
+                "symbol": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1
                 }
             }
         },
-        "github_com_vasconcellos_financial-control_internal_domain_dto.UpdateTransactionRequest": {
+        "github_com_vasconcellos_financial-control_src_internal_domain_dto.UpdateTransactionRequest": {
             "type": "object",
             "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "120.50"
+                },
                 "categoryId": {
                     "type": "string"
                 },
+                "currency": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
                 "notes": {
                     "type": "string"
                 },
-                "status": {
+                "occurredAt": {
                     "type": "string"
                 },
+                "splits": {
+                    "description": "substitui o rateio; lista vazia remove o rateio",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vasconcellos_financial-control_src_internal_domain_dto.TransactionSplit"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "completed",
+                        "failed"
+                    ]
+                },
                 "tags": {
                     "type": "array",
                     "items": {
@@ -1378,7 +6831,7 @@ const docTemplate = `{
                 }
             }
         },
-        "internal_interfaces_http_handler.ErrorResponse": {
+        "src_internal_adapters_http_handler.ErrorResponse": {
             "description": "Standard error response structure",
             "type": "object",
             "properties": {
@@ -1388,7 +6841,7 @@ const docTemplate = `{
                 }
             }
         },
-        "internal_interfaces_http_handler.HealthResponse": {
+        "src_internal_adapters_http_handler.HealthResponse": {
             "description": "Health check response with service status and uptime",
             "type": "object",
             "properties": {
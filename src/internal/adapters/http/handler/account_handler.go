@@ -104,14 +104,17 @@ func (h *AccountHandler) Update(c *gin.Context) {
 
 // List
 // @Summary List accounts
-// @Description Lista todas as contas do usuário autenticado
+// @Description Lista as contas do usuário autenticado; contas encerradas só aparecem com includeClosed
 // @Tags accounts
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param limit query int false "Número máximo de resultados (default: 100, max: 200)"
 // @Param offset query int false "Número de resultados para pular (default: 0)"
+// @Param includeClosed query bool false "Inclui contas encerradas (default: false)"
+// @Param cursor query string false "Paginação por cursor: vazio na primeira página e depois o nextCursor da resposta anterior. Nesse modo offset é ignorado e a resposta é {items, nextCursor}"
 // @Success 200 {array} dto.AccountResponse "Lista de contas"
+// @Failure 400 {object} ErrorResponse "Cursor inválido"
 // @Failure 401 {object} ErrorResponse "Não autenticado"
 // @Failure 500 {object} ErrorResponse "Erro interno"
 // @Router /accounts [get]
@@ -130,8 +133,21 @@ func (h *AccountHandler) List(c *gin.Context) {
 		return
 	}
 
+	includeClosed := c.Query("includeClosed") == "true"
+	if cursor, ok := c.GetQuery("cursor"); ok {
+		log.Info("listing accounts by cursor", zap.String("user_id", user.ID), zap.Int64("limit", limit))
+		page, err := h.accountUseCase.ListAccountsPage(c.Request.Context(), user.ID, includeClosed, cursor, limit)
+		if err != nil {
+			log.Error("failed to list accounts", zap.Error(err))
+			respondError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, page)
+		return
+	}
+
 	log.Info("listing accounts", zap.String("user_id", user.ID), zap.Int64("limit", limit), zap.Int64("offset", offset))
-	response, err := h.accountUseCase.ListAccounts(c.Request.Context(), user.ID, limit, offset)
+	response, err := h.accountUseCase.ListAccounts(c.Request.Context(), user.ID, includeClosed, limit, offset)
 	if err != nil {
 		log.Error("failed to list accounts", zap.Error(err))
 		respondError(c, err)
@@ -143,14 +159,16 @@ func (h *AccountHandler) List(c *gin.Context) {
 
 // Delete
 // @Summary Delete an account
-// @Description Remove uma conta existente
+// @Description Remove uma conta existente. Contas com transações só são removidas com cascade=true, que remove também as transações dela; para tirar a conta das listagens mantendo o histórico, encerre a conta
 // @Tags accounts
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "ID da conta"
+// @Param cascade query bool false "Remove também as transações da conta (default: false)"
 // @Success 204 "Conta removida com sucesso"
 // @Failure 401 {object} ErrorResponse "Não autenticado"
 // @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 409 {object} ErrorResponse "A conta ainda tem transações"
 // @Failure 500 {object} ErrorResponse "Erro interno"
 // @Router /accounts/{id} [delete]
 func (h *AccountHandler) Delete(c *gin.Context) {
@@ -163,8 +181,9 @@ func (h *AccountHandler) Delete(c *gin.Context) {
 	}
 
 	accountID := c.Param("id")
-	log.Info("deleting account", zap.String("user_id", user.ID), zap.String("account_id", accountID))
-	if err := h.accountUseCase.DeleteAccount(c.Request.Context(), user.ID, accountID); err != nil {
+	cascade := c.Query("cascade") == "true"
+	log.Info("deleting account", zap.String("user_id", user.ID), zap.String("account_id", accountID), zap.Bool("cascade", cascade))
+	if err := h.accountUseCase.DeleteAccount(c.Request.Context(), user.ID, accountID, cascade); err != nil {
 		log.Error("failed to delete account", zap.Error(err))
 		respondError(c, err)
 		return
@@ -173,3 +192,82 @@ func (h *AccountHandler) Delete(c *gin.Context) {
 	log.Info("account deleted", zap.String("account_id", accountID))
 	c.Status(http.StatusNoContent)
 }
+
+// Close
+// @Summary Close an account
+// @Description Encerra (arquiva) a conta na data informada ou agora. Contas encerradas saem das listagens padrão e não recebem novas transações, mas continuam no histórico de saldo e nos relatórios
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta"
+// @Param request body dto.CloseAccountRequest false "Data de encerramento"
+// @Success 200 {object} dto.AccountResponse "Conta encerrada"
+// @Failure 400 {object} ErrorResponse "Data inválida"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 409 {object} ErrorResponse "Conta já encerrada"
+// @Router /accounts/{id}/close [post]
+func (h *AccountHandler) Close(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized account close attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.CloseAccountRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			log.Warn("invalid account close payload", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	accountID := c.Param("id")
+	log.Info("closing account", zap.String("user_id", user.ID), zap.String("account_id", accountID))
+	response, err := h.accountUseCase.CloseAccount(c.Request.Context(), user.ID, accountID, request)
+	if err != nil {
+		log.Error("failed to close account", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("account closed", zap.String("account_id", accountID))
+	c.JSON(http.StatusOK, response)
+}
+
+// Reopen
+// @Summary Reopen a closed account
+// @Description Reabre uma conta encerrada, que volta às listagens e a aceitar transações
+// @Tags accounts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta"
+// @Success 200 {object} dto.AccountResponse "Conta reaberta"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 409 {object} ErrorResponse "A conta não está encerrada"
+// @Router /accounts/{id}/reopen [post]
+func (h *AccountHandler) Reopen(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized account reopen attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	accountID := c.Param("id")
+	log.Info("reopening account", zap.String("user_id", user.ID), zap.String("account_id", accountID))
+	response, err := h.accountUseCase.ReopenAccount(c.Request.Context(), user.ID, accountID)
+	if err != nil {
+		log.Error("failed to reopen account", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
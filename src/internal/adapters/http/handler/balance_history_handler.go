@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/vasconcellos/financial-control/src/internal/adapters/http/middleware"
+	_ "github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/usecase"
+)
+
+type BalanceHistoryHandler struct {
+	balanceHistoryUseCase *usecase.BalanceHistoryUseCase
+}
+
+func NewBalanceHistoryHandler(balanceHistoryUseCase *usecase.BalanceHistoryUseCase) *BalanceHistoryHandler {
+	return &BalanceHistoryHandler{balanceHistoryUseCase: balanceHistoryUseCase}
+}
+
+// Get
+// @Summary Get account balance history
+// @Description Série do saldo da conta no fim de cada dia, semana (segunda a domingo) ou mês do período. Funciona para qualquer data passada: o saldo é reconstruído desfazendo as transações posteriores a partir do snapshot diário mais próximo ou do saldo atual. Limite de 400 pontos
+// @Tags accounts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta"
+// @Param from query string false "Data inicial (RFC3339, default: 30 dias atrás)"
+// @Param to query string false "Data final (RFC3339, default: agora)"
+// @Param interval query string false "Intervalo dos pontos: day, week ou month (default: day)"
+// @Success 200 {object} dto.BalanceHistoryResponse "Histórico de saldo"
+// @Failure 400 {object} ErrorResponse "Período ou intervalo inválido"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /accounts/{id}/balance-history [get]
+func (h *BalanceHistoryHandler) Get(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized balance history attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	accountID := c.Param("id")
+	from, to := parseDateRange(c.Query("from"), c.Query("to"))
+	interval := c.Query("interval")
+	log.Info("loading balance history", zap.String("user_id", user.ID), zap.String("account_id", accountID), zap.Time("from", from), zap.Time("to", to), zap.String("interval", interval))
+	response, err := h.balanceHistoryUseCase.GetBalanceHistory(c.Request.Context(), user.ID, accountID, from, to, interval)
+	if err != nil {
+		log.Error("failed to load balance history", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -61,11 +61,13 @@ func (h *BudgetHandler) Create(c *gin.Context) {
 
 // List
 // @Summary List budgets
-// @Description Lista todos os orçamentos do usuário com status atualizado
+// @Description Lista todos os orçamentos do usuário com status atualizado e valores convertidos para a moeda padrão quando a moeda do orçamento difere
 // @Tags budgets
 // @Produce json
 // @Security BearerAuth
+// @Param cursor query string false "Paginação por cursor: vazio na primeira página e depois o nextCursor da resposta anterior. Nesse modo offset é ignorado e a resposta é {items, nextCursor}"
 // @Success 200 {array} dto.BudgetResponse "Lista de orçamentos"
+// @Failure 400 {object} ErrorResponse "Cursor inválido"
 // @Failure 401 {object} ErrorResponse "Não autenticado"
 // @Router /budgets [get]
 func (h *BudgetHandler) List(c *gin.Context) {
@@ -83,8 +85,20 @@ func (h *BudgetHandler) List(c *gin.Context) {
 		return
 	}
 
+	if cursor, ok := c.GetQuery("cursor"); ok {
+		log.Info("listing budgets by cursor", zap.String("user_id", user.ID), zap.Int64("limit", limit))
+		page, err := h.budgetUseCase.ListBudgetsPage(c.Request.Context(), user.ID, user.DefaultCurrency, cursor, limit)
+		if err != nil {
+			log.Error("failed to list budgets", zap.Error(err))
+			respondError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, page)
+		return
+	}
+
 	log.Info("listing budgets", zap.String("user_id", user.ID), zap.Int64("limit", limit), zap.Int64("offset", offset))
-	response, err := h.budgetUseCase.ListBudgets(c.Request.Context(), user.ID, limit, offset)
+	response, err := h.budgetUseCase.ListBudgets(c.Request.Context(), user.ID, user.DefaultCurrency, limit, offset)
 	if err != nil {
 		log.Error("failed to list budgets", zap.Error(err))
 		respondError(c, err)
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/vasconcellos/financial-control/src/internal/adapters/http/middleware"
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/usecase"
+)
+
+type CategorizationRuleHandler struct {
+	ruleUseCase *usecase.CategorizationRuleUseCase
+}
+
+func NewCategorizationRuleHandler(ruleUseCase *usecase.CategorizationRuleUseCase) *CategorizationRuleHandler {
+	return &CategorizationRuleHandler{ruleUseCase: ruleUseCase}
+}
+
+// Create
+// @Summary Create a categorization rule
+// @Description Cria uma regra que categoriza, adiciona tags ou renomeia transações novas e importadas. Critérios: descrição (contém ou expressão regular), faixa de valor, contas e tags; regras de prioridade menor são avaliadas primeiro
+// @Tags categorization-rules
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateCategorizationRuleRequest true "Dados da regra"
+// @Success 201 {object} dto.CategorizationRuleResponse "Regra criada"
+// @Failure 400 {object} ErrorResponse "Dados inválidos"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /categorization-rules [post]
+func (h *CategorizationRuleHandler) Create(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized categorization rule creation attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.CreateCategorizationRuleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid categorization rule payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Info("creating categorization rule", zap.String("user_id", user.ID), zap.Int("priority", request.Priority))
+	response, err := h.ruleUseCase.CreateRule(c.Request.Context(), user.ID, request)
+	if err != nil {
+		log.Error("failed to create categorization rule", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("categorization rule created", zap.String("rule_id", response.ID))
+	c.JSON(http.StatusCreated, response)
+}
+
+// List
+// @Summary List categorization rules
+// @Description Lista as regras de categorização do usuário em ordem de prioridade
+// @Tags categorization-rules
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Número máximo de resultados (default: 100, max: 200)"
+// @Param offset query int false "Número de resultados para pular (default: 0)"
+// @Success 200 {array} dto.CategorizationRuleResponse "Lista de regras"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /categorization-rules [get]
+func (h *CategorizationRuleHandler) List(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized categorization rule list attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	limit, offset, err := parsePagination(c.Query("limit"), c.Query("offset"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Info("listing categorization rules", zap.String("user_id", user.ID), zap.Int64("limit", limit), zap.Int64("offset", offset))
+	response, err := h.ruleUseCase.ListRules(c.Request.Context(), user.ID, limit, offset)
+	if err != nil {
+		log.Error("failed to list categorization rules", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Update
+// @Summary Update a categorization rule
+// @Description Atualiza nome, prioridade, situação, critérios ou ações da regra; transações já gravadas não são alteradas
+// @Tags categorization-rules
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da regra"
+// @Param request body dto.UpdateCategorizationRuleRequest true "Dados atualizados"
+// @Success 200 {object} dto.CategorizationRuleResponse "Regra atualizada"
+// @Failure 400 {object} ErrorResponse "Dados inválidos"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Regra não encontrada"
+// @Router /categorization-rules/{id} [patch]
+func (h *CategorizationRuleHandler) Update(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized categorization rule update attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.UpdateCategorizationRuleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid categorization rule update payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ruleID := c.Param("id")
+	log.Info("updating categorization rule", zap.String("user_id", user.ID), zap.String("rule_id", ruleID))
+	response, err := h.ruleUseCase.UpdateRule(c.Request.Context(), user.ID, ruleID, request)
+	if err != nil {
+		log.Error("failed to update categorization rule", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Delete
+// @Summary Delete a categorization rule
+// @Description Remove a regra; transações já categorizadas por ela são mantidas
+// @Tags categorization-rules
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da regra"
+// @Success 204 "Regra removida"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Regra não encontrada"
+// @Router /categorization-rules/{id} [delete]
+func (h *CategorizationRuleHandler) Delete(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized categorization rule delete attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ruleID := c.Param("id")
+	log.Info("deleting categorization rule", zap.String("user_id", user.ID), zap.String("rule_id", ruleID))
+	if err := h.ruleUseCase.DeleteRule(c.Request.Context(), user.ID, ruleID); err != nil {
+		log.Error("failed to delete categorization rule", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DryRun
+// @Summary Dry run categorization rules
+// @Description Aplica as regras habilitadas (ou apenas a regra informada, sem salvá-la) às últimas N transações e mostra o que mudaria, sem gravar nada
+// @Tags categorization-rules
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.DryRunRulesRequest false "Quantidade de transações e regra opcional"
+// @Success 200 {object} dto.DryRunRulesResponse "Resultado da simulação"
+// @Failure 400 {object} ErrorResponse "Dados inválidos"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /categorization-rules/dry-run [post]
+func (h *CategorizationRuleHandler) DryRun(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized categorization rule dry run attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.DryRunRulesRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			log.Warn("invalid categorization rule dry run payload", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	log.Info("dry running categorization rules", zap.String("user_id", user.ID), zap.Int64("limit", request.Limit), zap.Bool("single_rule", request.Rule != nil))
+	response, err := h.ruleUseCase.DryRun(c.Request.Context(), user.ID, request)
+	if err != nil {
+		log.Error("failed to dry run categorization rules", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/vasconcellos/financial-control/src/internal/adapters/http/middleware"
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/usecase"
+)
+
+type CreditCardHandler struct {
+	creditCardUseCase *usecase.CreditCardUseCase
+}
+
+func NewCreditCardHandler(creditCardUseCase *usecase.CreditCardUseCase) *CreditCardHandler {
+	return &CreditCardHandler{creditCardUseCase: creditCardUseCase}
+}
+
+// Summary
+// @Summary Credit card statements
+// @Description Mostra a última fatura fechada, a fatura aberta e a próxima, com totais, pagamentos e vencimentos, além do limite disponível. Compras a partir do dia de fechamento entram na fatura seguinte; pagamentos feitos depois do fechamento abatem a fatura fechada
+// @Tags accounts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta de cartão de crédito"
+// @Success 200 {object} dto.CreditCardSummaryResponse "Faturas do cartão"
+// @Failure 400 {object} ErrorResponse "Conta não é cartão de crédito ou não tem ciclo de fatura"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Router /accounts/{id}/credit-card [get]
+func (h *CreditCardHandler) Summary(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized credit card summary attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	accountID := c.Param("id")
+	log.Info("loading credit card statements", zap.String("user_id", user.ID), zap.String("account_id", accountID))
+	response, err := h.creditCardUseCase.GetSummary(c.Request.Context(), user.ID, accountID)
+	if err != nil {
+		log.Error("failed to load credit card statements", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PayStatement
+// @Summary Pay a credit card statement
+// @Description Transfere da conta informada para o cartão. Sem valor, paga o saldo em aberto da última fatura fechada
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta de cartão de crédito"
+// @Param request body dto.PayStatementRequest true "Conta pagadora e valor opcional"
+// @Success 201 {object} dto.TransferResponse "Pagamento registrado"
+// @Failure 400 {object} ErrorResponse "Dados inválidos ou nada a pagar"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Router /accounts/{id}/credit-card/payments [post]
+func (h *CreditCardHandler) PayStatement(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized statement payment attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.PayStatementRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid statement payment payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accountID := c.Param("id")
+	log.Info("paying credit card statement", zap.String("user_id", user.ID), zap.String("account_id", accountID), zap.String("from_account_id", request.FromAccountID))
+	response, err := h.creditCardUseCase.PayStatement(c.Request.Context(), user.ID, accountID, request)
+	if err != nil {
+		log.Error("failed to pay credit card statement", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("credit card statement paid", zap.String("transfer_id", response.ID))
+	c.JSON(http.StatusCreated, response)
+}
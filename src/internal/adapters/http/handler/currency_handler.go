@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/vasconcellos/financial-control/src/internal/adapters/http/middleware"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/usecase"
+)
+
+type CurrencyHandler struct {
+	currencyUseCase *usecase.CurrencyUseCase
+}
+
+func NewCurrencyHandler(currencyUseCase *usecase.CurrencyUseCase) *CurrencyHandler {
+	return &CurrencyHandler{currencyUseCase: currencyUseCase}
+}
+
+// List
+// @Summary List currencies
+// @Description Lista as moedas habilitadas com metadados ISO 4217 (código numérico, casas decimais, símbolo). Use all=true para o catálogo completo; o nome segue o parâmetro locale ou o cabeçalho Accept-Language (en, pt-BR)
+// @Tags currencies
+// @Produce json
+// @Security BearerAuth
+// @Param all query bool false "Incluir moedas não habilitadas"
+// @Param locale query string false "Idioma do nome (en, pt-BR)"
+// @Success 200 {array} github_com_vasconcellos_financial-control_src_internal_domain_dto.CurrencyResponse "Lista de moedas"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Router /currencies [get]
+func (h *CurrencyHandler) List(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	if _, ok := middleware.GetUserContext(c); !ok {
+		log.Warn("unauthorized currency list attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	includeDisabled := c.Query("all") == "true"
+	locale := c.Query("locale")
+	if locale == "" {
+		locale = preferredLocale(c.GetHeader("Accept-Language"))
+	}
+
+	currencies := h.currencyUseCase.ListCurrencies(includeDisabled, locale)
+	log.Debug("currencies listed", zap.Bool("all", includeDisabled), zap.String("locale", locale), zap.Int("count", len(currencies)))
+	c.JSON(http.StatusOK, currencies)
+}
+
+// preferredLocale extrai o primeiro idioma do cabeçalho Accept-Language
+func preferredLocale(header string) string {
+	first := strings.TrimSpace(strings.Split(header, ",")[0])
+	first = strings.TrimSpace(strings.Split(first, ";")[0])
+	if first == "" {
+		return entity.LocaleEnglish
+	}
+	return first
+}
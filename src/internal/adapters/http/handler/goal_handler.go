@@ -61,14 +61,16 @@ func (h *GoalHandler) Create(c *gin.Context) {
 
 // List
 // @Summary List goals
-// @Description Lista todas as metas financeiras do usuário
+// @Description Lista todas as metas financeiras do usuário com valores convertidos para a moeda padrão quando a moeda da meta difere
 // @Tags goals
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param limit query int false "Número máximo de resultados"
 // @Param offset query int false "Offset para paginação"
+// @Param cursor query string false "Paginação por cursor: vazio na primeira página e depois o nextCursor da resposta anterior. Nesse modo offset é ignorado e a resposta é {items, nextCursor}"
 // @Success 200 {array} dto.GoalResponse "Lista de metas"
+// @Failure 400 {object} ErrorResponse "Cursor inválido"
 // @Failure 401 {object} ErrorResponse "Não autenticado"
 // @Router /goals [get]
 func (h *GoalHandler) List(c *gin.Context) {
@@ -86,8 +88,20 @@ func (h *GoalHandler) List(c *gin.Context) {
 		return
 	}
 
+	if cursor, ok := c.GetQuery("cursor"); ok {
+		log.Info("listing goals by cursor", zap.String("user_id", user.ID), zap.Int64("limit", limit))
+		page, err := h.goalUseCase.ListGoalsPage(c.Request.Context(), user.ID, user.DefaultCurrency, cursor, limit)
+		if err != nil {
+			log.Error("failed to list goals", zap.Error(err))
+			respondError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, page)
+		return
+	}
+
 	log.Info("listing goals", zap.String("user_id", user.ID), zap.Int64("limit", limit), zap.Int64("offset", offset))
-	response, err := h.goalUseCase.ListGoals(c.Request.Context(), user.ID, limit, offset)
+	response, err := h.goalUseCase.ListGoals(c.Request.Context(), user.ID, user.DefaultCurrency, limit, offset)
 	if err != nil {
 		log.Error("failed to list goals", zap.Error(err))
 		respondError(c, err)
@@ -114,7 +128,7 @@ func (h *GoalHandler) UpdateProgress(c *gin.Context) {
 	}
 
 	goalID := c.Param("id")
-	log.Info("updating goal progress", zap.String("goal_id", goalID), zap.String("user_id", user.ID), zap.Float64("amount", request.Amount))
+	log.Info("updating goal progress", zap.String("goal_id", goalID), zap.String("user_id", user.ID), zap.Stringer("amount", request.Amount))
 	response, err := h.goalUseCase.UpdateProgress(c.Request.Context(), user.ID, c.Param("id"), request.Amount)
 	if err != nil {
 		log.Error("failed to update goal progress", zap.Error(err))
@@ -0,0 +1,322 @@
+package handler
+
+import (
+	"mime/multipart"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/vasconcellos/financial-control/src/internal/adapters/http/middleware"
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/usecase"
+)
+
+type ImportHandler struct {
+	importUseCase *usecase.ImportUseCase
+}
+
+func NewImportHandler(importUseCase *usecase.ImportUseCase) *ImportHandler {
+	return &ImportHandler{importUseCase: importUseCase}
+}
+
+// CreateProfile
+// @Summary Create an import mapping profile
+// @Description Salva o mapeamento de colunas de um extrato CSV (índices a partir de zero, formato de data como DD/MM/YYYY, separador decimal, convenção de sinal e codificação como latin-1)
+// @Tags imports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateImportProfileRequest true "Mapeamento do extrato"
+// @Success 201 {object} dto.ImportProfileResponse "Perfil criado"
+// @Failure 400 {object} ErrorResponse "Mapeamento inválido"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /imports/profiles [post]
+func (h *ImportHandler) CreateProfile(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized import profile creation attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.CreateImportProfileRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid import profile payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Info("creating import profile", zap.String("user_id", user.ID), zap.String("name", request.Name))
+	response, err := h.importUseCase.CreateProfile(c.Request.Context(), user.ID, request)
+	if err != nil {
+		log.Error("failed to create import profile", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListProfiles
+// @Summary List import mapping profiles
+// @Description Lista os perfis de mapeamento de extratos do usuário
+// @Tags imports
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.ImportProfileResponse "Lista de perfis"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /imports/profiles [get]
+func (h *ImportHandler) ListProfiles(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized import profile list attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	response, err := h.importUseCase.ListProfiles(c.Request.Context(), user.ID)
+	if err != nil {
+		log.Error("failed to list import profiles", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteProfile
+// @Summary Delete an import mapping profile
+// @Description Remove o perfil de mapeamento; importações já feitas são mantidas
+// @Tags imports
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do perfil"
+// @Success 204 "Perfil removido"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Perfil não encontrado"
+// @Router /imports/profiles/{id} [delete]
+func (h *ImportHandler) DeleteProfile(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized import profile delete attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	profileID := c.Param("id")
+	log.Info("deleting import profile", zap.String("user_id", user.ID), zap.String("profile_id", profileID))
+	if err := h.importUseCase.DeleteProfile(c.Request.Context(), user.ID, profileID); err != nil {
+		log.Error("failed to delete import profile", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// PreviewCSV
+// @Summary Preview a CSV bank statement
+// @Description Interpreta o extrato com o perfil de mapeamento e devolve a prévia das linhas sem gravar transações. A prévia expira em 24 horas se não for confirmada
+// @Tags imports
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Extrato CSV (max 5MB)"
+// @Param profileId formData string true "ID do perfil de mapeamento"
+// @Param accountId formData string true "ID da conta que receberá as transações"
+// @Success 201 {object} dto.ImportBatchResponse "Prévia da importação"
+// @Failure 400 {object} ErrorResponse "Arquivo, perfil ou conta inválidos"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /imports/csv [post]
+func (h *ImportHandler) PreviewCSV(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized csv import attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	profileID := c.PostForm("profileId")
+	accountID := c.PostForm("accountId")
+	if profileID == "" || accountID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "profileId and accountId are required"})
+		return
+	}
+	file, fileName, ok := openStatementFile(c, log)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	log.Info("previewing csv import", zap.String("user_id", user.ID), zap.String("profile_id", profileID), zap.String("account_id", accountID))
+	response, err := h.importUseCase.PreviewCSV(c.Request.Context(), user.ID, profileID, accountID, fileName, file)
+	if err != nil {
+		if err == domainErrors.ErrPayloadTooLarge {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "file too large (max 5MB)"})
+			return
+		}
+		log.Error("failed to preview csv import", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("csv import previewed", zap.String("import_id", response.ID), zap.Int("rows", response.TotalRows), zap.Int("invalid_rows", response.InvalidRows))
+	c.JSON(http.StatusCreated, response)
+}
+
+// PreviewOFX
+// @Summary Preview an OFX/QFX bank statement
+// @Description Interpreta um extrato OFX 1.x (SGML) ou 2.x (XML) e devolve a prévia. O FITID de cada lançamento identifica a transação, então lançamentos já importados por um extrato sobreposto aparecem como duplicados e não são gravados de novo
+// @Tags imports
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Extrato OFX ou QFX (max 5MB)"
+// @Param accountId formData string true "ID da conta que receberá as transações"
+// @Success 201 {object} dto.ImportBatchResponse "Prévia da importação"
+// @Failure 400 {object} ErrorResponse "Arquivo ou conta inválidos"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /imports/ofx [post]
+func (h *ImportHandler) PreviewOFX(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized ofx import attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	accountID := c.PostForm("accountId")
+	if accountID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "accountId is required"})
+		return
+	}
+	file, fileName, ok := openStatementFile(c, log)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	log.Info("previewing ofx import", zap.String("user_id", user.ID), zap.String("account_id", accountID))
+	response, err := h.importUseCase.PreviewOFX(c.Request.Context(), user.ID, accountID, fileName, file)
+	if err != nil {
+		if err == domainErrors.ErrPayloadTooLarge {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "file too large (max 5MB)"})
+			return
+		}
+		log.Error("failed to preview ofx import", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("ofx import previewed", zap.String("import_id", response.ID), zap.Int("rows", response.TotalRows), zap.Int("duplicate_rows", response.DuplicateRows))
+	c.JSON(http.StatusCreated, response)
+}
+
+// openStatementFile abre o arquivo enviado no campo "file" respeitando o limite de tamanho;
+// em caso de falha a resposta já foi escrita
+func openStatementFile(c *gin.Context, log *zap.Logger) (multipart.File, string, bool) {
+	header, err := c.FormFile("file")
+	if err != nil {
+		log.Warn("statement payload missing", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, "", false
+	}
+	if header.Size > usecase.MaxImportFileSizeBytes {
+		log.Warn("statement exceeds size limit", zap.Int64("size", header.Size), zap.Int64("limit_bytes", usecase.MaxImportFileSizeBytes))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file too large (max 5MB)"})
+		return nil, "", false
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		log.Error("failed to open statement", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read file"})
+		return nil, "", false
+	}
+	return file, header.Filename, true
+}
+
+// Get
+// @Summary Get an import
+// @Description Consulta a prévia ou o resultado de uma importação
+// @Tags imports
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da importação"
+// @Success 200 {object} dto.ImportBatchResponse "Importação"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Importação não encontrada ou expirada"
+// @Router /imports/{id} [get]
+func (h *ImportHandler) Get(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized import get attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	response, err := h.importUseCase.GetImport(c.Request.Context(), user.ID, c.Param("id"))
+	if err != nil {
+		log.Error("failed to get import", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Commit
+// @Summary Commit an import
+// @Description Grava as linhas válidas da prévia como transações na conta escolhida, usando a categoria de despesa ou de receita conforme o sinal. Repetir a confirmação não duplica transações
+// @Tags imports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da importação"
+// @Param request body dto.CommitImportRequest true "Categorias padrão e linhas selecionadas"
+// @Success 200 {object} dto.ImportBatchResponse "Resultado da importação"
+// @Failure 400 {object} ErrorResponse "Categoria ausente ou de tipo incompatível"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Importação não encontrada ou expirada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /imports/{id}/commit [post]
+func (h *ImportHandler) Commit(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized import commit attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.CommitImportRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid import commit payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	importID := c.Param("id")
+	log.Info("committing import", zap.String("user_id", user.ID), zap.String("import_id", importID))
+	response, err := h.importUseCase.CommitImport(c.Request.Context(), user.ID, importID, request)
+	if err != nil {
+		log.Error("failed to commit import", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("import committed", zap.String("import_id", importID), zap.Int("imported_rows", response.ImportedRows))
+	c.JSON(http.StatusOK, response)
+}
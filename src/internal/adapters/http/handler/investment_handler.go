@@ -0,0 +1,442 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/vasconcellos/financial-control/src/internal/adapters/http/middleware"
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/usecase"
+)
+
+type InvestmentHandler struct {
+	investmentUseCase *usecase.InvestmentUseCase
+}
+
+func NewInvestmentHandler(investmentUseCase *usecase.InvestmentUseCase) *InvestmentHandler {
+	return &InvestmentHandler{investmentUseCase: investmentUseCase}
+}
+
+// CreateSecurity
+// @Summary Create a security
+// @Description Cadastra um ativo (ação, fundo, título) pelo símbolo, único por usuário. A moeda do ativo deve ser a mesma das contas de investimento em que ele for negociado
+// @Tags investments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateSecurityRequest true "Dados do ativo"
+// @Success 201 {object} dto.SecurityResponse "Ativo cadastrado"
+// @Failure 400 {object} ErrorResponse "Dados inválidos"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 409 {object} ErrorResponse "Símbolo já cadastrado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /securities [post]
+func (h *InvestmentHandler) CreateSecurity(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized security creation attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.CreateSecurityRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid security payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Info("creating security", zap.String("user_id", user.ID), zap.String("symbol", request.Symbol))
+	response, err := h.investmentUseCase.CreateSecurity(c.Request.Context(), user.ID, request)
+	if err != nil {
+		log.Error("failed to create security", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("security created", zap.String("security_id", response.ID))
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListSecurities
+// @Summary List securities
+// @Description Lista os ativos cadastrados pelo usuário em ordem de símbolo
+// @Tags investments
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Número máximo de resultados (default: 100, max: 200)"
+// @Param offset query int false "Número de resultados para pular (default: 0)"
+// @Success 200 {array} dto.SecurityResponse "Lista de ativos"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /securities [get]
+func (h *InvestmentHandler) ListSecurities(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized security list attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	limit, offset, err := parsePagination(c.Query("limit"), c.Query("offset"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Info("listing securities", zap.String("user_id", user.ID), zap.Int64("limit", limit), zap.Int64("offset", offset))
+	response, err := h.investmentUseCase.ListSecurities(c.Request.Context(), user.ID, limit, offset)
+	if err != nil {
+		log.Error("failed to list securities", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateSecurity
+// @Summary Update a security
+// @Description Altera símbolo e nome do ativo; a moeda não pode ser alterada
+// @Tags investments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do ativo"
+// @Param request body dto.UpdateSecurityRequest true "Dados atualizados"
+// @Success 200 {object} dto.SecurityResponse "Ativo atualizado"
+// @Failure 400 {object} ErrorResponse "Dados inválidos"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Ativo não encontrado"
+// @Failure 409 {object} ErrorResponse "Símbolo já cadastrado"
+// @Router /securities/{id} [patch]
+func (h *InvestmentHandler) UpdateSecurity(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized security update attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.UpdateSecurityRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid security update payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	securityID := c.Param("id")
+	log.Info("updating security", zap.String("user_id", user.ID), zap.String("security_id", securityID))
+	response, err := h.investmentUseCase.UpdateSecurity(c.Request.Context(), user.ID, securityID, request)
+	if err != nil {
+		log.Error("failed to update security", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteSecurity
+// @Summary Delete a security
+// @Description Remove o ativo e o histórico de preços dele. Ativos com operações registradas não podem ser removidos
+// @Tags investments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do ativo"
+// @Success 204 "Ativo removido"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Ativo não encontrado"
+// @Failure 409 {object} ErrorResponse "Ativo com operações"
+// @Router /securities/{id} [delete]
+func (h *InvestmentHandler) DeleteSecurity(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized security delete attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	securityID := c.Param("id")
+	log.Info("deleting security", zap.String("user_id", user.ID), zap.String("security_id", securityID))
+	if err := h.investmentUseCase.DeleteSecurity(c.Request.Context(), user.ID, securityID); err != nil {
+		log.Error("failed to delete security", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SetPrice
+// @Summary Set a security price
+// @Description Grava o preço de fechamento do ativo no dia informado, substituindo o que houver para o mesmo dia
+// @Tags investments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do ativo"
+// @Param request body dto.SetSecurityPriceRequest true "Data e preço"
+// @Success 200 {object} dto.SecurityPriceResponse "Preço gravado"
+// @Failure 400 {object} ErrorResponse "Preço ou data inválidos"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Ativo não encontrado"
+// @Router /securities/{id}/prices [post]
+func (h *InvestmentHandler) SetPrice(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized security price attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.SetSecurityPriceRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid security price payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	securityID := c.Param("id")
+	log.Info("setting security price", zap.String("user_id", user.ID), zap.String("security_id", securityID), zap.Time("date", request.Date))
+	response, err := h.investmentUseCase.SetPrice(c.Request.Context(), user.ID, securityID, request)
+	if err != nil {
+		log.Error("failed to set security price", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListPrices
+// @Summary List security prices
+// @Description Lista o histórico de preços do ativo no período, do mais antigo para o mais recente
+// @Tags investments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do ativo"
+// @Param from query string false "Data inicial (RFC3339, default: 30 dias atrás)"
+// @Param to query string false "Data final (RFC3339, default: agora)"
+// @Success 200 {array} dto.SecurityPriceResponse "Histórico de preços"
+// @Failure 400 {object} ErrorResponse "Período inválido"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Ativo não encontrado"
+// @Router /securities/{id}/prices [get]
+func (h *InvestmentHandler) ListPrices(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized security price list attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	securityID := c.Param("id")
+	from, to := parseDateRange(c.Query("from"), c.Query("to"))
+	log.Info("listing security prices", zap.String("user_id", user.ID), zap.String("security_id", securityID), zap.Time("from", from), zap.Time("to", to))
+	response, err := h.investmentUseCase.ListPrices(c.Request.Context(), user.ID, securityID, from, to)
+	if err != nil {
+		log.Error("failed to list security prices", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ImportPrices
+// @Summary Import security prices
+// @Description Importa preços de fechamento de um CSV com as colunas symbol,date,price (ex.: PETR4,2024-01-31,38.42); a primeira linha pode ser um cabeçalho. Linhas de ativos não cadastrados são ignoradas e listadas na resposta; uma linha malformada recusa o arquivo inteiro
+// @Tags investments
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Arquivo CSV de preços (max 5MB)"
+// @Success 200 {object} dto.ImportPricesResponse "Resumo da importação"
+// @Failure 400 {object} ErrorResponse "Arquivo inválido"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /securities/prices/import [post]
+func (h *InvestmentHandler) ImportPrices(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized security price import attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	file, _, ok := openStatementFile(c, log)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	log.Info("importing security prices", zap.String("user_id", user.ID))
+	response, err := h.investmentUseCase.ImportPrices(c.Request.Context(), user.ID, file)
+	if err != nil {
+		if err == domainErrors.ErrPayloadTooLarge {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "file too large (max 5MB)"})
+			return
+		}
+		log.Error("failed to import security prices", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("security prices imported", zap.Int("imported", response.Imported), zap.Int("unknown_symbols", len(response.UnknownSymbols)))
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateTrade
+// @Summary Record an investment trade
+// @Description Registra compra, venda ou provento em uma conta de investimento. O valor líquido entra ou sai do caixa da conta como transação: compra (valor mais taxas) e venda (valor menos taxas) como transferência, provento como receita na categoria informada. Vendas acima da quantidade em carteira são recusadas
+// @Tags investments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta de investimento"
+// @Param request body dto.CreateTradeRequest true "Dados da operação"
+// @Success 201 {object} dto.TradeResponse "Operação registrada"
+// @Failure 400 {object} ErrorResponse "Dados inválidos, conta que não é de investimento ou venda acima da posição"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 409 {object} ErrorResponse "Conta encerrada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /accounts/{id}/trades [post]
+func (h *InvestmentHandler) CreateTrade(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized trade creation attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.CreateTradeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid trade payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accountID := c.Param("id")
+	log.Info("recording trade", zap.String("user_id", user.ID), zap.String("account_id", accountID), zap.String("security_id", request.SecurityID), zap.String("type", request.Type))
+	response, err := h.investmentUseCase.RecordTrade(c.Request.Context(), user.ID, accountID, request)
+	if err != nil {
+		log.Error("failed to record trade", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("trade recorded", zap.String("trade_id", response.ID), zap.String("transaction_id", response.TransactionID))
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListTrades
+// @Summary List investment trades
+// @Description Lista as operações da conta de investimento em ordem de data
+// @Tags investments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta de investimento"
+// @Success 200 {array} dto.TradeResponse "Lista de operações"
+// @Failure 400 {object} ErrorResponse "Conta que não é de investimento"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Router /accounts/{id}/trades [get]
+func (h *InvestmentHandler) ListTrades(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized trade list attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	accountID := c.Param("id")
+	log.Info("listing trades", zap.String("user_id", user.ID), zap.String("account_id", accountID))
+	response, err := h.investmentUseCase.ListTrades(c.Request.Context(), user.ID, accountID)
+	if err != nil {
+		log.Error("failed to list trades", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteTrade
+// @Summary Delete an investment trade
+// @Description Desfaz a operação anulando o lançamento no caixa da conta. Recusada quando vendas posteriores ficariam acima da posição ou quando o lançamento já foi conciliado
+// @Tags investments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da operação"
+// @Success 204 "Operação removida"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Operação não encontrada"
+// @Failure 409 {object} ErrorResponse "Operação não pode ser desfeita"
+// @Router /trades/{id} [delete]
+func (h *InvestmentHandler) DeleteTrade(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized trade delete attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	tradeID := c.Param("id")
+	log.Info("deleting trade", zap.String("user_id", user.ID), zap.String("trade_id", tradeID))
+	if err := h.investmentUseCase.DeleteTrade(c.Request.Context(), user.ID, tradeID); err != nil {
+		log.Error("failed to delete trade", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Portfolio
+// @Summary Get an investment portfolio
+// @Description Posições da conta de investimento com quantidade, custo (FIFO com lotes ou custo médio, conforme a conta), preço mais recente (histórico de preços ou última operação), valor de mercado, resultado realizado e não realizado e proventos. O valor total soma o caixa da conta
+// @Tags investments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta de investimento"
+// @Success 200 {object} dto.PortfolioResponse "Carteira"
+// @Failure 400 {object} ErrorResponse "Conta que não é de investimento"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /accounts/{id}/portfolio [get]
+func (h *InvestmentHandler) Portfolio(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized portfolio attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	accountID := c.Param("id")
+	log.Info("loading portfolio", zap.String("user_id", user.ID), zap.String("account_id", accountID))
+	response, err := h.investmentUseCase.GetPortfolio(c.Request.Context(), user.ID, accountID)
+	if err != nil {
+		log.Error("failed to load portfolio", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/vasconcellos/financial-control/src/internal/adapters/http/middleware"
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/usecase"
+)
+
+type LoanHandler struct {
+	loanUseCase *usecase.LoanUseCase
+}
+
+func NewLoanHandler(loanUseCase *usecase.LoanUseCase) *LoanHandler {
+	return &LoanHandler{loanUseCase: loanUseCase}
+}
+
+// GetLoan
+// @Summary Get a loan and its amortization schedule
+// @Description Situação da conta de empréstimo ou financiamento: saldo devedor, parcelas pagas e restantes, juros a pagar, próxima parcela, data de quitação e o cronograma das parcelas restantes (Price ou SAC)
+// @Tags loans
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta de empréstimo"
+// @Success 200 {object} dto.LoanResponse "Empréstimo e cronograma"
+// @Failure 400 {object} ErrorResponse "Conta que não é de empréstimo"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /accounts/{id}/loan [get]
+func (h *LoanHandler) GetLoan(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized loan attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	accountID := c.Param("id")
+	log.Info("loading loan", zap.String("user_id", user.ID), zap.String("account_id", accountID))
+	response, err := h.loanUseCase.GetLoan(c.Request.Context(), user.ID, accountID)
+	if err != nil {
+		log.Error("failed to load loan", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PayInstallment
+// @Summary Pay the next loan installment
+// @Description Paga a próxima parcela a partir de outra conta da mesma moeda. A parcela sai da conta de origem como transferência e os juros são lançados como despesa na conta do empréstimo, na categoria informada; o saldo devedor cai pelo valor amortizado. As transações geradas não podem ser editadas nem anuladas
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta de empréstimo"
+// @Param request body dto.PayLoanInstallmentRequest true "Conta de origem e categoria dos juros"
+// @Success 201 {object} dto.LoanPaymentResponse "Parcela paga"
+// @Failure 400 {object} ErrorResponse "Dados inválidos, conta que não é de empréstimo ou moedas diferentes"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 409 {object} ErrorResponse "Empréstimo quitado ou conta encerrada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /accounts/{id}/loan/payments [post]
+func (h *LoanHandler) PayInstallment(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized loan payment attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.PayLoanInstallmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid loan payment payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accountID := c.Param("id")
+	log.Info("paying loan installment", zap.String("user_id", user.ID), zap.String("account_id", accountID), zap.String("from_account_id", request.FromAccountID))
+	response, err := h.loanUseCase.PayInstallment(c.Request.Context(), user.ID, accountID, request)
+	if err != nil {
+		log.Error("failed to pay loan installment", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("loan installment paid", zap.Int("number", response.Number))
+	c.JSON(http.StatusCreated, response)
+}
+
+// Prepay
+// @Summary Make an extra loan payment
+// @Description Amortização extraordinária: o valor inteiro abate o saldo devedor, reduzindo o prazo (padrão) ou o valor das parcelas. Um valor igual ao saldo devedor quita o empréstimo
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta de empréstimo"
+// @Param request body dto.PrepayLoanRequest true "Conta de origem, valor e modo"
+// @Success 201 {object} dto.LoanPaymentResponse "Amortização registrada"
+// @Failure 400 {object} ErrorResponse "Dados inválidos, valor acima do saldo devedor ou moedas diferentes"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 409 {object} ErrorResponse "Empréstimo quitado ou conta encerrada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /accounts/{id}/loan/prepayments [post]
+func (h *LoanHandler) Prepay(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized loan prepayment attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.PrepayLoanRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid loan prepayment payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accountID := c.Param("id")
+	log.Info("prepaying loan", zap.String("user_id", user.ID), zap.String("account_id", accountID), zap.String("mode", request.Mode))
+	response, err := h.loanUseCase.Prepay(c.Request.Context(), user.ID, accountID, request)
+	if err != nil {
+		log.Error("failed to prepay loan", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("loan prepaid", zap.String("outstanding", response.Outstanding.String()))
+	c.JSON(http.StatusCreated, response)
+}
+
+// ReversePayment
+// @Summary Reverse the latest loan payment
+// @Description Estorna o pagamento mais recente do empréstimo, parcela ou amortização extraordinária: as transações do pagamento são anuladas e o saldo devedor, as parcelas e o cronograma voltam ao que eram antes dele. Pagamentos anteriores só podem ser estornados depois dos mais recentes
+// @Tags loans
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta de empréstimo"
+// @Param paymentId path string true "ID do pagamento"
+// @Success 200 {object} dto.LoanResponse "Empréstimo depois do estorno"
+// @Failure 400 {object} ErrorResponse "Conta que não é de empréstimo"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta ou pagamento não encontrado"
+// @Failure 409 {object} ErrorResponse "Pagamento que não é o mais recente, transação conciliada, conta encerrada ou pagamento concorrente"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /accounts/{id}/loan/payments/{paymentId} [delete]
+func (h *LoanHandler) ReversePayment(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized loan payment reversal attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	accountID := c.Param("id")
+	paymentID := c.Param("paymentId")
+	log.Info("reversing loan payment", zap.String("user_id", user.ID), zap.String("account_id", accountID), zap.String("payment_id", paymentID))
+	response, err := h.loanUseCase.ReversePayment(c.Request.Context(), user.ID, accountID, paymentID)
+	if err != nil {
+		log.Error("failed to reverse loan payment", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("loan payment reversed", zap.String("outstanding", response.Outstanding.String()))
+	c.JSON(http.StatusOK, response)
+}
+
+// Simulate
+// @Summary Simulate extra loan payments
+// @Description Compara o cronograma atual com o de uma amortização extraordinária agora e/ou todo mês: parcelas, data de quitação, juros totais e quanto se economiza. Nada é gravado
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta de empréstimo"
+// @Param request body dto.SimulateLoanRequest true "Amortizações hipotéticas"
+// @Success 200 {object} dto.LoanSimulationResponse "Simulação"
+// @Failure 400 {object} ErrorResponse "Dados inválidos ou conta que não é de empréstimo"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /accounts/{id}/loan/simulate [post]
+func (h *LoanHandler) Simulate(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized loan simulation attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.SimulateLoanRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid loan simulation payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accountID := c.Param("id")
+	log.Info("simulating loan", zap.String("user_id", user.ID), zap.String("account_id", accountID))
+	response, err := h.loanUseCase.Simulate(c.Request.Context(), user.ID, accountID, request)
+	if err != nil {
+		log.Error("failed to simulate loan", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,244 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/vasconcellos/financial-control/src/internal/adapters/http/middleware"
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/usecase"
+)
+
+type ReconciliationHandler struct {
+	reconciliationUseCase *usecase.ReconciliationUseCase
+}
+
+func NewReconciliationHandler(reconciliationUseCase *usecase.ReconciliationUseCase) *ReconciliationHandler {
+	return &ReconciliationHandler{reconciliationUseCase: reconciliationUseCase}
+}
+
+// Start
+// @Summary Start an account reconciliation
+// @Description Abre uma conciliação da conta com a data final e o saldo do extrato do banco. Há no máximo uma conciliação aberta por conta e a data não pode ser anterior à da última concluída
+// @Tags reconciliations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta"
+// @Param request body dto.StartReconciliationRequest true "Dados do extrato"
+// @Success 201 {object} dto.ReconciliationResponse "Conciliação aberta, com as transações candidatas"
+// @Failure 400 {object} ErrorResponse "Dados inválidos"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 409 {object} ErrorResponse "Já existe uma conciliação aberta"
+// @Router /accounts/{id}/reconciliations [post]
+func (h *ReconciliationHandler) Start(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized reconciliation start attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.StartReconciliationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid reconciliation payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accountID := c.Param("id")
+	log.Info("starting reconciliation", zap.String("user_id", user.ID), zap.String("account_id", accountID))
+	response, err := h.reconciliationUseCase.StartReconciliation(c.Request.Context(), user.ID, accountID, request)
+	if err != nil {
+		log.Error("failed to start reconciliation", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("reconciliation started", zap.String("reconciliation_id", response.ID))
+	c.JSON(http.StatusCreated, response)
+}
+
+// List
+// @Summary List account reconciliations
+// @Description Lista as conciliações da conta, da data de extrato mais recente para a mais antiga
+// @Tags reconciliations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta"
+// @Param limit query int false "Número máximo de resultados (default: 100, max: 200)"
+// @Param offset query int false "Número de resultados para pular (default: 0)"
+// @Success 200 {array} dto.ReconciliationResponse "Lista de conciliações"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Router /accounts/{id}/reconciliations [get]
+func (h *ReconciliationHandler) List(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized reconciliation list attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	limit, offset, err := parsePagination(c.Query("limit"), c.Query("offset"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accountID := c.Param("id")
+	log.Info("listing reconciliations", zap.String("user_id", user.ID), zap.String("account_id", accountID))
+	response, err := h.reconciliationUseCase.ListReconciliations(c.Request.Context(), user.ID, accountID, limit, offset)
+	if err != nil {
+		log.Error("failed to list reconciliations", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Get
+// @Summary Get a reconciliation
+// @Description Mostra o saldo conferido, a diferença para o extrato e, na conciliação aberta, as transações candidatas (não conciliadas, até a data do extrato)
+// @Tags reconciliations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conciliação"
+// @Success 200 {object} dto.ReconciliationResponse "Conciliação"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conciliação não encontrada"
+// @Router /reconciliations/{id} [get]
+func (h *ReconciliationHandler) Get(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized reconciliation get attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	reconciliationID := c.Param("id")
+	response, err := h.reconciliationUseCase.GetReconciliation(c.Request.Context(), user.ID, reconciliationID)
+	if err != nil {
+		log.Error("failed to load reconciliation", zap.String("reconciliation_id", reconciliationID), zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Clear
+// @Summary Mark transactions as cleared
+// @Description Marca (ou desmarca, com cleared=false) transações como conferidas no extrato e devolve a diferença recalculada
+// @Tags reconciliations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conciliação"
+// @Param request body dto.ClearTransactionsRequest true "Transações conferidas"
+// @Success 200 {object} dto.ReconciliationResponse "Conciliação atualizada"
+// @Failure 400 {object} ErrorResponse "Transação fora da conciliação"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conciliação ou transação não encontrada"
+// @Failure 409 {object} ErrorResponse "Conciliação já concluída"
+// @Router /reconciliations/{id}/clear [post]
+func (h *ReconciliationHandler) Clear(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized reconciliation clear attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.ClearTransactionsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid reconciliation clear payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reconciliationID := c.Param("id")
+	log.Info("clearing transactions", zap.String("user_id", user.ID), zap.String("reconciliation_id", reconciliationID), zap.Int("count", len(request.TransactionIDs)))
+	response, err := h.reconciliationUseCase.ClearTransactions(c.Request.Context(), user.ID, reconciliationID, request)
+	if err != nil {
+		log.Error("failed to clear transactions", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Complete
+// @Summary Complete a reconciliation
+// @Description Conclui a conciliação quando o saldo conferido bate com o extrato. As transações conferidas ficam travadas: não podem ser anuladas nem ter valor, conta, categoria, data ou moeda alterados
+// @Tags reconciliations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conciliação"
+// @Success 200 {object} dto.ReconciliationResponse "Conciliação concluída"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conciliação não encontrada"
+// @Failure 409 {object} ErrorResponse "Conciliação já concluída ou saldo conferido diferente do extrato"
+// @Router /reconciliations/{id}/complete [post]
+func (h *ReconciliationHandler) Complete(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized reconciliation completion attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	reconciliationID := c.Param("id")
+	log.Info("completing reconciliation", zap.String("user_id", user.ID), zap.String("reconciliation_id", reconciliationID))
+	response, err := h.reconciliationUseCase.CompleteReconciliation(c.Request.Context(), user.ID, reconciliationID)
+	if err != nil {
+		log.Error("failed to complete reconciliation", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("reconciliation completed", zap.String("reconciliation_id", reconciliationID), zap.Int("transactions", len(response.TransactionIDs)))
+	c.JSON(http.StatusOK, response)
+}
+
+// Cancel
+// @Summary Cancel an open reconciliation
+// @Description Descarta a conciliação aberta; as marcações de conferida são mantidas
+// @Tags reconciliations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conciliação"
+// @Success 204 "Conciliação descartada"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conciliação não encontrada"
+// @Failure 409 {object} ErrorResponse "Conciliação já concluída"
+// @Router /reconciliations/{id} [delete]
+func (h *ReconciliationHandler) Cancel(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized reconciliation cancel attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	reconciliationID := c.Param("id")
+	log.Info("cancelling reconciliation", zap.String("user_id", user.ID), zap.String("reconciliation_id", reconciliationID))
+	if err := h.reconciliationUseCase.CancelReconciliation(c.Request.Context(), user.ID, reconciliationID); err != nil {
+		log.Error("failed to cancel reconciliation", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/vasconcellos/financial-control/src/internal/adapters/http/middleware"
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/usecase"
+)
+
+type RecurringTransactionHandler struct {
+	recurringUseCase *usecase.RecurringTransactionUseCase
+}
+
+func NewRecurringTransactionHandler(recurringUseCase *usecase.RecurringTransactionUseCase) *RecurringTransactionHandler {
+	return &RecurringTransactionHandler{recurringUseCase: recurringUseCase}
+}
+
+// Create
+// @Summary Create a recurring transaction
+// @Description Cria uma transação recorrente (diária, semanal, mensal no dia N ou no último dia útil). As ocorrências vencidas são gravadas automaticamente pelo agendador
+// @Tags recurring-transactions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateRecurringTransactionRequest true "Dados da recorrência"
+// @Success 201 {object} dto.RecurringTransactionResponse "Recorrência criada"
+// @Failure 400 {object} ErrorResponse "Dados inválidos, conta ou categoria inexistente"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 409 {object} ErrorResponse "Conta encerrada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /recurring-transactions [post]
+func (h *RecurringTransactionHandler) Create(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized recurring transaction creation attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.CreateRecurringTransactionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid recurring transaction payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Info("creating recurring transaction", zap.String("user_id", user.ID), zap.String("frequency", request.Frequency), zap.Stringer("amount", request.Amount))
+	response, err := h.recurringUseCase.CreateRecurring(c.Request.Context(), user.ID, request)
+	if err != nil {
+		log.Error("failed to create recurring transaction", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("recurring transaction created", zap.String("recurring_id", response.ID))
+	c.JSON(http.StatusCreated, response)
+}
+
+// List
+// @Summary List recurring transactions
+// @Description Lista as transações recorrentes do usuário com a próxima ocorrência
+// @Tags recurring-transactions
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Número máximo de resultados (default: 100, max: 200)"
+// @Param offset query int false "Número de resultados para pular (default: 0)"
+// @Success 200 {array} dto.RecurringTransactionResponse "Lista de recorrências"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /recurring-transactions [get]
+func (h *RecurringTransactionHandler) List(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized recurring transaction list attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	limit, offset, err := parsePagination(c.Query("limit"), c.Query("offset"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Info("listing recurring transactions", zap.String("user_id", user.ID), zap.Int64("limit", limit), zap.Int64("offset", offset))
+	response, err := h.recurringUseCase.ListRecurring(c.Request.Context(), user.ID, limit, offset)
+	if err != nil {
+		log.Error("failed to list recurring transactions", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Get
+// @Summary Get a recurring transaction
+// @Description Consulta uma transação recorrente
+// @Tags recurring-transactions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da recorrência"
+// @Success 200 {object} dto.RecurringTransactionResponse "Recorrência"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Recorrência não encontrada"
+// @Router /recurring-transactions/{id} [get]
+func (h *RecurringTransactionHandler) Get(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized recurring transaction get attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	response, err := h.recurringUseCase.GetRecurring(c.Request.Context(), user.ID, c.Param("id"))
+	if err != nil {
+		log.Error("failed to get recurring transaction", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Update
+// @Summary Update a recurring transaction
+// @Description Atualiza valor, conta, categoria, descrição ou data final; ocorrências já gravadas não mudam
+// @Tags recurring-transactions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da recorrência"
+// @Param request body dto.UpdateRecurringTransactionRequest true "Dados atualizados"
+// @Success 200 {object} dto.RecurringTransactionResponse "Recorrência atualizada"
+// @Failure 400 {object} ErrorResponse "Dados inválidos, conta ou categoria inexistente"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Recorrência não encontrada"
+// @Failure 409 {object} ErrorResponse "Conta encerrada"
+// @Router /recurring-transactions/{id} [patch]
+func (h *RecurringTransactionHandler) Update(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized recurring transaction update attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.UpdateRecurringTransactionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid recurring transaction update payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	recurringID := c.Param("id")
+	log.Info("updating recurring transaction", zap.String("user_id", user.ID), zap.String("recurring_id", recurringID))
+	response, err := h.recurringUseCase.UpdateRecurring(c.Request.Context(), user.ID, recurringID, request)
+	if err != nil {
+		log.Error("failed to update recurring transaction", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Delete
+// @Summary Delete a recurring transaction
+// @Description Remove a recorrência; transações já gravadas são mantidas
+// @Tags recurring-transactions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da recorrência"
+// @Success 204 "Recorrência removida"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Recorrência não encontrada"
+// @Router /recurring-transactions/{id} [delete]
+func (h *RecurringTransactionHandler) Delete(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized recurring transaction delete attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	recurringID := c.Param("id")
+	log.Info("deleting recurring transaction", zap.String("user_id", user.ID), zap.String("recurring_id", recurringID))
+	if err := h.recurringUseCase.DeleteRecurring(c.Request.Context(), user.ID, recurringID); err != nil {
+		log.Error("failed to delete recurring transaction", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Pause
+// @Summary Pause a recurring transaction
+// @Description Suspende a geração de ocorrências
+// @Tags recurring-transactions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da recorrência"
+// @Success 200 {object} dto.RecurringTransactionResponse "Recorrência pausada"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Recorrência não encontrada"
+// @Failure 409 {object} ErrorResponse "Recorrência não está ativa"
+// @Router /recurring-transactions/{id}/pause [post]
+func (h *RecurringTransactionHandler) Pause(c *gin.Context) {
+	h.changeState(c, "pause", h.recurringUseCase.PauseRecurring)
+}
+
+// Resume
+// @Summary Resume a recurring transaction
+// @Description Reativa a recorrência a partir de hoje; ocorrências do período pausado não são geradas
+// @Tags recurring-transactions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da recorrência"
+// @Success 200 {object} dto.RecurringTransactionResponse "Recorrência reativada"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Recorrência não encontrada"
+// @Failure 409 {object} ErrorResponse "Recorrência não está pausada"
+// @Router /recurring-transactions/{id}/resume [post]
+func (h *RecurringTransactionHandler) Resume(c *gin.Context) {
+	h.changeState(c, "resume", h.recurringUseCase.ResumeRecurring)
+}
+
+// Skip
+// @Summary Skip the next occurrence
+// @Description Pula a próxima ocorrência sem gravar a transação
+// @Tags recurring-transactions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da recorrência"
+// @Success 200 {object} dto.RecurringTransactionResponse "Próxima ocorrência atualizada"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Recorrência não encontrada"
+// @Failure 409 {object} ErrorResponse "Recorrência não está ativa"
+// @Router /recurring-transactions/{id}/skip [post]
+func (h *RecurringTransactionHandler) Skip(c *gin.Context) {
+	h.changeState(c, "skip", h.recurringUseCase.SkipNextOccurrence)
+}
+
+type recurringStateChange func(ctx context.Context, userID string, recurringID string) (*dto.RecurringTransactionResponse, error)
+
+func (h *RecurringTransactionHandler) changeState(c *gin.Context, action string, change recurringStateChange) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized recurring transaction state change attempt", zap.String("action", action))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	recurringID := c.Param("id")
+	log.Info("changing recurring transaction state", zap.String("user_id", user.ID), zap.String("recurring_id", recurringID), zap.String("action", action))
+	response, err := change(c.Request.Context(), user.ID, recurringID)
+	if err != nil {
+		log.Error("failed to change recurring transaction state", zap.String("action", action), zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
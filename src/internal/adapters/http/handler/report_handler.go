@@ -13,16 +13,17 @@ import (
 )
 
 type ReportHandler struct {
-	reportUseCase *usecase.ReportUseCase
+	reportUseCase   *usecase.ReportUseCase
+	netWorthUseCase *usecase.NetWorthUseCase
 }
 
-func NewReportHandler(reportUseCase *usecase.ReportUseCase) *ReportHandler {
-	return &ReportHandler{reportUseCase: reportUseCase}
+func NewReportHandler(reportUseCase *usecase.ReportUseCase, netWorthUseCase *usecase.NetWorthUseCase) *ReportHandler {
+	return &ReportHandler{reportUseCase: reportUseCase, netWorthUseCase: netWorthUseCase}
 }
 
 // Summary
 // @Summary Get financial summary
-// @Description Gera um resumo financeiro com receitas, despesas e saldo do período
+// @Description Gera um resumo financeiro com receitas, despesas e saldo do período, convertidos para a moeda padrão do usuário pela cotação da data de cada transação
 // @Tags reports
 // @Produce json
 // @Security BearerAuth
@@ -30,6 +31,7 @@ func NewReportHandler(reportUseCase *usecase.ReportUseCase) *ReportHandler {
 // @Param to query string false "Data final (RFC3339, default: hoje)"
 // @Success 200 {object} dto.SummaryReportResponse "Resumo financeiro"
 // @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 422 {object} ErrorResponse "Cotação de câmbio indisponível"
 // @Router /reports/summary [get]
 func (h *ReportHandler) Summary(c *gin.Context) {
 	log := middleware.LoggerFromContext(c)
@@ -42,7 +44,7 @@ func (h *ReportHandler) Summary(c *gin.Context) {
 
 	from, to := parseSummaryRange(c.Query("from"), c.Query("to"))
 	log.Info("generating summary report", zap.String("user_id", user.ID), zap.Time("from", from), zap.Time("to", to))
-	response, err := h.reportUseCase.GetSummary(c.Request.Context(), user.ID, from, to)
+	response, err := h.reportUseCase.GetSummary(c.Request.Context(), user.ID, user.DefaultCurrency, from, to)
 	if err != nil {
 		log.Error("failed to generate summary report", zap.Error(err))
 		respondError(c, err)
@@ -52,6 +54,86 @@ func (h *ReportHandler) Summary(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// NetWorth
+// @Summary Get current net worth
+// @Description Soma os saldos de todas as contas convertidos para a moeda padrão do usuário pela cotação do dia: ativos (corrente, poupança, dinheiro) menos passivos (cartão de crédito), com o total por tipo de conta e o saldo de cada conta
+// @Tags reports
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.NetWorthResponse "Patrimônio líquido"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 422 {object} ErrorResponse "Cotação de câmbio indisponível"
+// @Router /reports/net-worth [get]
+func (h *ReportHandler) NetWorth(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized net worth report attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	log.Info("generating net worth report", zap.String("user_id", user.ID))
+	response, err := h.netWorthUseCase.GetNetWorth(c.Request.Context(), user.ID, user.DefaultCurrency)
+	if err != nil {
+		log.Error("failed to generate net worth report", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// NetWorthHistory
+// @Summary Get net worth history
+// @Description Série do patrimônio líquido no fim de cada mês (ou dia/semana) do período, montada a partir do histórico de saldo das contas e convertida pela cotação do fim de cada período. Limite de 400 pontos
+// @Tags reports
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "Data inicial (RFC3339, default: 12 meses atrás)"
+// @Param to query string false "Data final (RFC3339, default: agora)"
+// @Param interval query string false "Intervalo dos pontos: day, week ou month (default: month)"
+// @Success 200 {object} dto.NetWorthHistoryResponse "Histórico do patrimônio líquido"
+// @Failure 400 {object} ErrorResponse "Período ou intervalo inválido"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 422 {object} ErrorResponse "Cotação de câmbio indisponível"
+// @Router /reports/net-worth/history [get]
+func (h *ReportHandler) NetWorthHistory(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized net worth history attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	from, to := parseNetWorthRange(c.Query("from"), c.Query("to"))
+	interval := c.Query("interval")
+	log.Info("generating net worth history", zap.String("user_id", user.ID), zap.Time("from", from), zap.Time("to", to), zap.String("interval", interval))
+	response, err := h.netWorthUseCase.GetNetWorthHistory(c.Request.Context(), user.ID, user.DefaultCurrency, from, to, interval)
+	if err != nil {
+		log.Error("failed to generate net worth history", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func parseNetWorthRange(fromRaw, toRaw string) (time.Time, time.Time) {
+	const layout = time.RFC3339
+	now := time.Now().UTC()
+	from, err := time.Parse(layout, fromRaw)
+	if fromRaw == "" || err != nil {
+		from = now.AddDate(-1, 0, 0)
+	}
+	to, err := time.Parse(layout, toRaw)
+	if toRaw == "" || err != nil {
+		to = now
+	}
+	return from, to
+}
+
 func parseSummaryRange(fromRaw, toRaw string) (time.Time, time.Time) {
 	const layout = time.RFC3339
 	now := time.Now().UTC()
@@ -24,8 +24,10 @@ func respondError(c *gin.Context, err error) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 	case domainErrors.ErrInvalidInput:
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-	case domainErrors.ErrConflict:
+	case domainErrors.ErrConflict, domainErrors.ErrAccountClosed:
 		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case domainErrors.ErrRateNotFound:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
 	default:
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
 	}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/vasconcellos/financial-control/src/internal/adapters/http/middleware"
+	_ "github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/usecase"
+)
+
+type SavingsHandler struct {
+	savingsUseCase *usecase.SavingsUseCase
+}
+
+func NewSavingsHandler(savingsUseCase *usecase.SavingsUseCase) *SavingsHandler {
+	return &SavingsHandler{savingsUseCase: savingsUseCase}
+}
+
+// Projection
+// @Summary Project a savings account balance
+// @Description Projeta o saldo da conta poupança mês a mês pela taxa e capitalização atuais, supondo o saldo atual constante. O primeiro mês inclui os dias ainda não lançados pela rotina de juros
+// @Tags accounts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da conta poupança"
+// @Param months query int false "Quantidade de meses (padrão 12, máximo 600)"
+// @Success 200 {object} dto.SavingsProjectionResponse "Projeção"
+// @Failure 400 {object} ErrorResponse "Parâmetros inválidos ou conta sem rendimento configurado"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /accounts/{id}/savings/projection [get]
+func (h *SavingsHandler) Projection(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized savings projection attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	months := 0
+	if raw := c.Query("months"); raw != "" {
+		value, err := strconv.Atoi(raw)
+		if err != nil {
+			log.Warn("invalid projection months", zap.String("months", raw))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid months"})
+			return
+		}
+		months = value
+	}
+
+	accountID := c.Param("id")
+	log.Info("projecting savings", zap.String("user_id", user.ID), zap.String("account_id", accountID), zap.Int("months", months))
+	response, err := h.savingsUseCase.Project(c.Request.Context(), user.ID, accountID, months)
+	if err != nil {
+		log.Error("failed to project savings", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+// parseTransactionFilter lê os filtros de GET /transactions; listas aceitam valores repetidos ou separados por vírgula
+func parseTransactionFilter(c *gin.Context) (dto.TransactionFilter, error) {
+	from, to := parseDateRange(c.Query("from"), c.Query("to"))
+	filter := dto.TransactionFilter{
+		From:                 from,
+		To:                   to,
+		IncludeVoided:        c.Query("includeVoided") == "true",
+		AccountIDs:           queryList(c, "accountId"),
+		CategoryIDs:          queryList(c, "categoryId"),
+		IncludeSubcategories: c.Query("includeSubcategories") == "true",
+		Tags:                 queryList(c, "tags"),
+		Statuses:             queryList(c, "status"),
+		Currencies:           queryList(c, "currency"),
+		Search:               strings.TrimSpace(c.Query("q")),
+		Sort:                 c.Query("sort"),
+	}
+
+	switch c.Query("tagMatch") {
+	case "", "any":
+	case "all":
+		filter.MatchAllTags = true
+	default:
+		return filter, errors.New("invalid tagMatch parameter")
+	}
+
+	var err error
+	if filter.MinAmount, err = queryMoney(c, "minAmount"); err != nil {
+		return filter, err
+	}
+	if filter.MaxAmount, err = queryMoney(c, "maxAmount"); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+func queryList(c *gin.Context, key string) []string {
+	var values []string
+	for _, raw := range c.QueryArray(key) {
+		for _, value := range strings.Split(raw, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+func queryMoney(c *gin.Context, key string) (*entity.Money, error) {
+	raw := strings.TrimSpace(c.Query(key))
+	if raw == "" {
+		return nil, nil
+	}
+	value, err := entity.ParseMoney(raw)
+	if err != nil {
+		return nil, errors.New("invalid " + key + " parameter")
+	}
+	return &value, nil
+}
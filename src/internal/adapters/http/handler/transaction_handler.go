@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -23,7 +24,7 @@ func NewTransactionHandler(transactionUseCase *usecase.TransactionUseCase) *Tran
 
 // Create
 // @Summary Create a new transaction
-// @Description Cria uma nova transação financeira e publica evento para processamento assíncrono de budgets
+// @Description Cria uma nova transação financeira e publica evento para processamento assíncrono de budgets. Sem categoryId, a categoria vem das regras de categorização; as regras também adicionam tags e renomeiam a descrição (skipRules desativa). Possíveis duplicatas são marcadas para revisão ou recusadas conforme onDuplicate. Em contas de crédito, installments divide a compra em parcelas mensais vinculadas (metadado "k/N"), cada uma na sua fatura; a resposta traz a primeira parcela
 // @Tags transactions
 // @Accept json
 // @Produce json
@@ -32,6 +33,7 @@ func NewTransactionHandler(transactionUseCase *usecase.TransactionUseCase) *Tran
 // @Success 201 {object} dto.TransactionResponse "Transação criada com sucesso"
 // @Failure 400 {object} ErrorResponse "Dados inválidos"
 // @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 409 {object} ErrorResponse "Possível duplicata recusada (onDuplicate=reject)"
 // @Failure 500 {object} ErrorResponse "Erro interno"
 // @Router /transactions [post]
 func (h *TransactionHandler) Create(c *gin.Context) {
@@ -54,7 +56,7 @@ func (h *TransactionHandler) Create(c *gin.Context) {
 		zap.String("user_id", user.ID),
 		zap.String("account_id", request.AccountID),
 		zap.String("category_id", request.CategoryID),
-		zap.Float64("amount", request.Amount),
+		zap.Stringer("amount", request.Amount),
 		zap.String("currency", request.Currency))
 
 	response, err := h.transactionUseCase.RecordTransaction(c.Request.Context(), user.ID, request)
@@ -63,7 +65,7 @@ func (h *TransactionHandler) Create(c *gin.Context) {
 			zap.Error(err),
 			zap.String("account_id", request.AccountID),
 			zap.String("category_id", request.CategoryID),
-			zap.Float64("amount", request.Amount),
+			zap.Stringer("amount", request.Amount),
 			zap.String("user_id", user.ID))
 
 		respondError(c, err)
@@ -74,9 +76,61 @@ func (h *TransactionHandler) Create(c *gin.Context) {
 	c.JSON(http.StatusCreated, response)
 }
 
+// CreateTransfer
+// @Summary Transfer between accounts
+// @Description Debita a conta de origem e credita a conta de destino, vinculando as duas pernas. Transferências não entram nos totais de receitas/despesas
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateTransferRequest true "Dados da transferência"
+// @Success 201 {object} dto.TransferResponse "Transferência registrada"
+// @Failure 400 {object} ErrorResponse "Dados inválidos ou taxa de câmbio ausente"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Conta não encontrada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /transfers [post]
+func (h *TransactionHandler) CreateTransfer(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized transfer attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var request dto.CreateTransferRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Warn("invalid transfer payload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Info("recording transfer",
+		zap.String("user_id", user.ID),
+		zap.String("from_account_id", request.FromAccountID),
+		zap.String("to_account_id", request.ToAccountID),
+		zap.Stringer("amount", request.Amount))
+
+	response, err := h.transactionUseCase.RecordTransfer(c.Request.Context(), user.ID, request)
+	if err != nil {
+		log.Error("failed to record transfer",
+			zap.Error(err),
+			zap.String("from_account_id", request.FromAccountID),
+			zap.String("to_account_id", request.ToAccountID),
+			zap.String("user_id", user.ID))
+
+		respondError(c, err)
+		return
+	}
+
+	log.Info("transfer recorded", zap.String("transfer_id", response.ID))
+	c.JSON(http.StatusCreated, response)
+}
+
 // Update
 // @Summary Update a transaction
-// @Description Atualiza uma transação existente
+// @Description Atualiza uma transação existente. Alterar valor, conta, categoria, data ou moeda estorna o efeito anterior no saldo e nos orçamentos e aplica o novo
 // @Tags transactions
 // @Accept json
 // @Produce json
@@ -84,7 +138,7 @@ func (h *TransactionHandler) Create(c *gin.Context) {
 // @Param id path string true "ID da transação"
 // @Param request body dto.UpdateTransactionRequest true "Dados atualizados"
 // @Success 200 {object} dto.TransactionResponse "Transação atualizada"
-// @Failure 400 {object} ErrorResponse "Dados inválidos"
+// @Failure 400 {object} ErrorResponse "Dados inválidos ou moeda diferente da conta"
 // @Failure 401 {object} ErrorResponse "Não autenticado"
 // @Failure 404 {object} ErrorResponse "Transação não encontrada"
 // @Router /transactions/{id} [patch]
@@ -116,9 +170,81 @@ func (h *TransactionHandler) Update(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// Delete
+// @Summary Void a transaction
+// @Description Anula a transação (mantida para auditoria), estorna o saldo da conta e publica evento compensatório para os orçamentos
+// @Tags transactions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da transação"
+// @Param reason query string false "Motivo da anulação"
+// @Success 204 "Transação anulada"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Transação não encontrada"
+// @Failure 409 {object} ErrorResponse "Transação já anulada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /transactions/{id} [delete]
+func (h *TransactionHandler) Delete(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized transaction void attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	transactionID := c.Param("id")
+	log.Info("voiding transaction", zap.String("transaction_id", transactionID), zap.String("user_id", user.ID))
+	if err := h.transactionUseCase.VoidTransaction(c.Request.Context(), user.ID, transactionID, c.Query("reason")); err != nil {
+		log.Error("failed to void transaction", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("transaction voided", zap.String("transaction_id", transactionID))
+	c.Status(http.StatusNoContent)
+}
+
+// CancelInstallments
+// @Summary Cancel the remaining installments of a purchase
+// @Description Anula as parcelas com data futura da compra parcelada a que a transação pertence, estornando o saldo do cartão e os orçamentos; parcelas já lançadas são mantidas
+// @Tags transactions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID de qualquer parcela da compra"
+// @Param reason query string false "Motivo do cancelamento"
+// @Success 200 {object} dto.CancelInstallmentsResponse "Parcelas anuladas"
+// @Failure 400 {object} ErrorResponse "Transação não é parcelada"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Transação não encontrada"
+// @Failure 409 {object} ErrorResponse "Não há parcelas restantes"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /transactions/{id}/installments/cancel [post]
+func (h *TransactionHandler) CancelInstallments(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized installment cancellation attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	transactionID := c.Param("id")
+	log.Info("cancelling remaining installments", zap.String("transaction_id", transactionID), zap.String("user_id", user.ID))
+	response, err := h.transactionUseCase.CancelRemainingInstallments(c.Request.Context(), user.ID, transactionID, c.Query("reason"))
+	if err != nil {
+		log.Error("failed to cancel remaining installments", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	log.Info("remaining installments cancelled", zap.String("group_id", response.GroupID), zap.Int("count", len(response.CancelledTransactionIDs)))
+	c.JSON(http.StatusOK, response)
+}
+
 // List
 // @Summary List transactions
-// @Description Lista transações do usuário com filtros opcionais de data e paginação
+// @Description Lista transações do usuário com filtros opcionais, busca textual na descrição, ordenação e paginação. Filtros de lista aceitam valores separados por vírgula
 // @Tags transactions
 // @Accept json
 // @Produce json
@@ -127,7 +253,21 @@ func (h *TransactionHandler) Update(c *gin.Context) {
 // @Param to query string false "Data final (ISO 8601)"
 // @Param limit query int false "Número máximo de resultados (default: 100, max: 200)"
 // @Param offset query int false "Número de resultados para pular (default: 0)"
+// @Param includeVoided query bool false "Inclui transações anuladas (default: false)"
+// @Param accountId query string false "IDs das contas"
+// @Param categoryId query string false "IDs das categorias"
+// @Param includeSubcategories query bool false "Inclui as subcategorias das categorias informadas (default: false)"
+// @Param tags query string false "Tags"
+// @Param tagMatch query string false "any: qualquer uma das tags; all: todas (default: any)" Enums(any, all)
+// @Param minAmount query string false "Valor mínimo"
+// @Param maxAmount query string false "Valor máximo"
+// @Param status query string false "Status (pending, completed, failed, voided)"
+// @Param currency query string false "Moedas (ISO 4217)"
+// @Param q query string false "Busca textual na descrição"
+// @Param sort query string false "Ordenação: occurredAt, amount, createdAt ou description; prefixo - para decrescente (default: -occurredAt)"
+// @Param cursor query string false "Paginação por cursor: vazio na primeira página e depois o nextCursor da resposta anterior. Nesse modo offset é ignorado e a resposta é {items, nextCursor}"
 // @Success 200 {array} dto.TransactionResponse "Lista de transações"
+// @Failure 400 {object} ErrorResponse "Filtro ou cursor inválido"
 // @Failure 401 {object} ErrorResponse "Não autenticado"
 // @Failure 500 {object} ErrorResponse "Erro interno"
 // @Router /transactions [get]
@@ -140,15 +280,31 @@ func (h *TransactionHandler) List(c *gin.Context) {
 		return
 	}
 
-	from, to := parseDateRange(c.Query("from"), c.Query("to"))
+	filter, err := parseTransactionFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 	limit, offset, err := parsePagination(c.Query("limit"), c.Query("offset"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	log.Info("listing transactions", zap.String("user_id", user.ID), zap.Time("from", from), zap.Time("to", to), zap.Int64("limit", limit), zap.Int64("offset", offset))
-	response, err := h.transactionUseCase.ListTransactions(c.Request.Context(), user.ID, from, to, limit, offset)
+	if cursor, ok := c.GetQuery("cursor"); ok {
+		log.Info("listing transactions by cursor", zap.String("user_id", user.ID), zap.Int64("limit", limit))
+		page, err := h.transactionUseCase.ListTransactionsPage(c.Request.Context(), user.ID, filter, cursor, limit)
+		if err != nil {
+			log.Error("failed to list transactions", zap.Error(err))
+			respondError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, page)
+		return
+	}
+
+	log.Info("listing transactions", zap.String("user_id", user.ID), zap.Time("from", filter.From), zap.Time("to", filter.To), zap.String("sort", filter.Sort), zap.Bool("search", filter.Search != ""), zap.Int64("limit", limit), zap.Int64("offset", offset))
+	response, err := h.transactionUseCase.ListTransactions(c.Request.Context(), user.ID, filter, limit, offset)
 	if err != nil {
 		log.Error("failed to list transactions", zap.Error(err))
 		respondError(c, err)
@@ -158,6 +314,99 @@ func (h *TransactionHandler) List(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// ListDuplicates
+// @Summary List suspected duplicate transactions
+// @Description Lista as transações marcadas como possíveis duplicatas (mesma conta, tipo e valor, datas até 3 dias de distância e mesma descrição normalizada) ao lado da original
+// @Tags transactions
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Número máximo de resultados (default: 100, max: 200)"
+// @Param offset query int false "Número de resultados para pular (default: 0)"
+// @Success 200 {array} dto.DuplicateTransactionResponse "Possíveis duplicatas"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /transactions/duplicates [get]
+func (h *TransactionHandler) ListDuplicates(c *gin.Context) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized duplicate list attempt")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	limit, offset, err := parsePagination(c.Query("limit"), c.Query("offset"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Info("listing duplicate transactions", zap.String("user_id", user.ID), zap.Int64("limit", limit), zap.Int64("offset", offset))
+	response, err := h.transactionUseCase.ListDuplicates(c.Request.Context(), user.ID, limit, offset)
+	if err != nil {
+		log.Error("failed to list duplicate transactions", zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// MergeDuplicate
+// @Summary Merge a duplicate into the original transaction
+// @Description Confirma a duplicidade: copia tags, notas e recibo que faltam na original e anula a duplicata, estornando saldo e orçamentos
+// @Tags transactions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da transação marcada como duplicata"
+// @Success 200 {object} dto.TransactionResponse "Transação original atualizada"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Transação não encontrada"
+// @Failure 409 {object} ErrorResponse "Transação não está marcada como duplicata"
+// @Router /transactions/{id}/duplicate/merge [post]
+func (h *TransactionHandler) MergeDuplicate(c *gin.Context) {
+	h.reviewDuplicate(c, "merge", h.transactionUseCase.MergeDuplicate)
+}
+
+// DismissDuplicate
+// @Summary Dismiss a duplicate suspicion
+// @Description Descarta a suspeita de duplicidade e mantém as duas transações
+// @Tags transactions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da transação marcada como duplicata"
+// @Success 200 {object} dto.TransactionResponse "Transação sem a marcação"
+// @Failure 401 {object} ErrorResponse "Não autenticado"
+// @Failure 404 {object} ErrorResponse "Transação não encontrada"
+// @Failure 409 {object} ErrorResponse "Transação não está marcada como duplicata"
+// @Router /transactions/{id}/duplicate/dismiss [post]
+func (h *TransactionHandler) DismissDuplicate(c *gin.Context) {
+	h.reviewDuplicate(c, "dismiss", h.transactionUseCase.DismissDuplicate)
+}
+
+type duplicateReview func(ctx context.Context, userID string, transactionID string) (*dto.TransactionResponse, error)
+
+func (h *TransactionHandler) reviewDuplicate(c *gin.Context, action string, review duplicateReview) {
+	log := middleware.LoggerFromContext(c)
+	user, ok := middleware.GetUserContext(c)
+	if !ok {
+		log.Warn("unauthorized duplicate review attempt", zap.String("action", action))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	transactionID := c.Param("id")
+	log.Info("reviewing duplicate transaction", zap.String("user_id", user.ID), zap.String("transaction_id", transactionID), zap.String("action", action))
+	response, err := review(c.Request.Context(), user.ID, transactionID)
+	if err != nil {
+		log.Error("failed to review duplicate transaction", zap.String("action", action), zap.Error(err))
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 // AttachReceipt
 // @Summary Attach receipt to transaction
 // @Description Envia e criptografa um recibo para a transação. Arquivo é armazenado no S3 com AES-256
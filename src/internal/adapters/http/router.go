@@ -1,35 +1,53 @@
 package http
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"go.uber.org/zap"
 
 	"github.com/vasconcellos/financial-control/src/internal/adapters/http/handler"
 	"github.com/vasconcellos/financial-control/src/internal/adapters/http/middleware"
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
 )
 
 type RouterParams struct {
-	AuthHandler        *handler.AuthHandler
-	AccountHandler     *handler.AccountHandler
-	CategoryHandler    *handler.CategoryHandler
-	TransactionHandler *handler.TransactionHandler
-	BudgetHandler      *handler.BudgetHandler
-	GoalHandler        *handler.GoalHandler
-	ReportHandler      *handler.ReportHandler
-	HealthHandler      *handler.HealthHandler
-	AuthMiddleware     *middleware.AuthMiddleware
-	AllowedOrigins     []string
-	Logger             *zap.Logger
-	ForceHTTPS         bool
-	Environment        string
+	AuthHandler           *handler.AuthHandler
+	AccountHandler        *handler.AccountHandler
+	CreditCardHandler     *handler.CreditCardHandler
+	ReconciliationHandler *handler.ReconciliationHandler
+	BalanceHistoryHandler *handler.BalanceHistoryHandler
+	InvestmentHandler     *handler.InvestmentHandler
+	LoanHandler           *handler.LoanHandler
+	SavingsHandler        *handler.SavingsHandler
+	CategoryHandler       *handler.CategoryHandler
+	RuleHandler           *handler.CategorizationRuleHandler
+	CurrencyHandler       *handler.CurrencyHandler
+	ImportHandler         *handler.ImportHandler
+	RecurringHandler      *handler.RecurringTransactionHandler
+	TransactionHandler    *handler.TransactionHandler
+	BudgetHandler         *handler.BudgetHandler
+	GoalHandler           *handler.GoalHandler
+	ReportHandler         *handler.ReportHandler
+	HealthHandler         *handler.HealthHandler
+	AuthMiddleware        *middleware.AuthMiddleware
+	AllowedOrigins        []string
+	Logger                *zap.Logger
+	ForceHTTPS            bool
+	Environment           string
 }
 
-func NewRouter(params RouterParams) *gin.Engine {
+func NewRouter(params RouterParams) (*gin.Engine, error) {
+	if err := registerValidators(); err != nil {
+		return nil, err
+	}
 	engine := gin.New()
 	engine.Use(gin.Recovery())
 
@@ -69,15 +87,76 @@ func NewRouter(params RouterParams) *gin.Engine {
 			protected.POST("/accounts", params.AccountHandler.Create)
 			protected.PATCH("/accounts/:id", params.AccountHandler.Update)
 			protected.DELETE("/accounts/:id", params.AccountHandler.Delete)
+			protected.POST("/accounts/:id/close", params.AccountHandler.Close)
+			protected.POST("/accounts/:id/reopen", params.AccountHandler.Reopen)
+			protected.GET("/accounts/:id/balance-history", params.BalanceHistoryHandler.Get)
+			protected.GET("/accounts/:id/credit-card", params.CreditCardHandler.Summary)
+			protected.POST("/accounts/:id/credit-card/payments", params.CreditCardHandler.PayStatement)
+			protected.GET("/accounts/:id/reconciliations", params.ReconciliationHandler.List)
+			protected.POST("/accounts/:id/reconciliations", params.ReconciliationHandler.Start)
+			protected.GET("/reconciliations/:id", params.ReconciliationHandler.Get)
+			protected.POST("/reconciliations/:id/clear", params.ReconciliationHandler.Clear)
+			protected.POST("/reconciliations/:id/complete", params.ReconciliationHandler.Complete)
+			protected.DELETE("/reconciliations/:id", params.ReconciliationHandler.Cancel)
+
+			protected.GET("/accounts/:id/portfolio", params.InvestmentHandler.Portfolio)
+			protected.GET("/accounts/:id/trades", params.InvestmentHandler.ListTrades)
+			protected.POST("/accounts/:id/trades", params.InvestmentHandler.CreateTrade)
+			protected.DELETE("/trades/:id", params.InvestmentHandler.DeleteTrade)
+			protected.GET("/accounts/:id/loan", params.LoanHandler.GetLoan)
+			protected.POST("/accounts/:id/loan/payments", params.LoanHandler.PayInstallment)
+			protected.DELETE("/accounts/:id/loan/payments/:paymentId", params.LoanHandler.ReversePayment)
+			protected.POST("/accounts/:id/loan/prepayments", params.LoanHandler.Prepay)
+			protected.POST("/accounts/:id/loan/simulate", params.LoanHandler.Simulate)
+			protected.GET("/accounts/:id/savings/projection", params.SavingsHandler.Projection)
+			protected.GET("/securities", params.InvestmentHandler.ListSecurities)
+			protected.POST("/securities", params.InvestmentHandler.CreateSecurity)
+			protected.POST("/securities/prices/import", params.InvestmentHandler.ImportPrices)
+			protected.PATCH("/securities/:id", params.InvestmentHandler.UpdateSecurity)
+			protected.DELETE("/securities/:id", params.InvestmentHandler.DeleteSecurity)
+			protected.GET("/securities/:id/prices", params.InvestmentHandler.ListPrices)
+			protected.POST("/securities/:id/prices", params.InvestmentHandler.SetPrice)
 
 			protected.GET("/categories", params.CategoryHandler.List)
 			protected.POST("/categories", params.CategoryHandler.Create)
 			protected.DELETE("/categories/:id", params.CategoryHandler.Delete)
 
+			protected.GET("/currencies", params.CurrencyHandler.List)
+
 			protected.GET("/transactions", params.TransactionHandler.List)
+			protected.GET("/transactions/duplicates", params.TransactionHandler.ListDuplicates)
 			protected.POST("/transactions", params.TransactionHandler.Create)
 			protected.PATCH("/transactions/:id", params.TransactionHandler.Update)
+			protected.DELETE("/transactions/:id", params.TransactionHandler.Delete)
 			protected.POST("/transactions/:id/receipt", params.TransactionHandler.AttachReceipt)
+			protected.POST("/transactions/:id/duplicate/merge", params.TransactionHandler.MergeDuplicate)
+			protected.POST("/transactions/:id/duplicate/dismiss", params.TransactionHandler.DismissDuplicate)
+			protected.POST("/transactions/:id/installments/cancel", params.TransactionHandler.CancelInstallments)
+
+			protected.POST("/transfers", params.TransactionHandler.CreateTransfer)
+
+			protected.GET("/imports/profiles", params.ImportHandler.ListProfiles)
+			protected.POST("/imports/profiles", params.ImportHandler.CreateProfile)
+			protected.DELETE("/imports/profiles/:id", params.ImportHandler.DeleteProfile)
+			protected.POST("/imports/csv", params.ImportHandler.PreviewCSV)
+			protected.POST("/imports/ofx", params.ImportHandler.PreviewOFX)
+			protected.GET("/imports/:id", params.ImportHandler.Get)
+			protected.POST("/imports/:id/commit", params.ImportHandler.Commit)
+
+			protected.GET("/categorization-rules", params.RuleHandler.List)
+			protected.POST("/categorization-rules", params.RuleHandler.Create)
+			protected.POST("/categorization-rules/dry-run", params.RuleHandler.DryRun)
+			protected.PATCH("/categorization-rules/:id", params.RuleHandler.Update)
+			protected.DELETE("/categorization-rules/:id", params.RuleHandler.Delete)
+
+			protected.GET("/recurring-transactions", params.RecurringHandler.List)
+			protected.POST("/recurring-transactions", params.RecurringHandler.Create)
+			protected.GET("/recurring-transactions/:id", params.RecurringHandler.Get)
+			protected.PATCH("/recurring-transactions/:id", params.RecurringHandler.Update)
+			protected.DELETE("/recurring-transactions/:id", params.RecurringHandler.Delete)
+			protected.POST("/recurring-transactions/:id/pause", params.RecurringHandler.Pause)
+			protected.POST("/recurring-transactions/:id/resume", params.RecurringHandler.Resume)
+			protected.POST("/recurring-transactions/:id/skip", params.RecurringHandler.Skip)
 
 			protected.GET("/budgets", params.BudgetHandler.List)
 			protected.POST("/budgets", params.BudgetHandler.Create)
@@ -87,8 +166,27 @@ func NewRouter(params RouterParams) *gin.Engine {
 			protected.POST("/goals/:id/progress", params.GoalHandler.UpdateProgress)
 
 			protected.GET("/reports/summary", params.ReportHandler.Summary)
+			protected.GET("/reports/net-worth", params.ReportHandler.NetWorth)
+			protected.GET("/reports/net-worth/history", params.ReportHandler.NetWorthHistory)
 		}
 	}
 
-	return engine
+	return engine, nil
+}
+
+// registerValidators ensina o validator do Gin a tratar tipos de domínio usados nos DTOs; uma tag que não registra
+// faria as requisições com ela falharem em tempo de execução, então o erro interrompe a inicialização
+func registerValidators() error {
+	validate, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return fmt.Errorf("unexpected validator engine %T", binding.Validator.Engine())
+	}
+	validate.RegisterCustomTypeFunc(dto.MoneyValidationValue, entity.Money{})
+	if err := validate.RegisterValidation(dto.CurrencyValidationTagName, dto.ValidateCurrencyField); err != nil {
+		return fmt.Errorf("register %s validation: %w", dto.CurrencyValidationTagName, err)
+	}
+	if err := validate.RegisterValidation(dto.MinorUnitsValidationTagName, dto.ValidateMinorUnitsField); err != nil {
+		return fmt.Errorf("register %s validation: %w", dto.MinorUnitsValidationTagName, err)
+	}
+	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -77,6 +78,37 @@ type QueueConfig struct {
 	TransactionQueue string
 }
 
+type CurrencyConfig struct {
+	Enabled []string
+}
+
+type ExchangeRatesConfig struct {
+	Provider     string
+	File         string
+	SyncInterval time.Duration
+}
+
+type RecurringConfig struct {
+	SchedulerInterval time.Duration
+	BatchSize         int
+}
+
+type BalanceSnapshotConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+type SavingsInterestConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+type OutboxConfig struct {
+	RelayInterval time.Duration
+	BatchSize     int
+	MaxAttempts   int
+}
+
 type LocalConfig struct {
 	CredentialsFile string
 	AuthUsers       []LocalAuthUser
@@ -92,14 +124,20 @@ type LocalAuthUser struct {
 }
 
 type Config struct {
-	App      AppConfig
-	Mongo    MongoConfig
-	AWS      AWSConfig
-	Auth     AuthConfig
-	Security SecurityConfig
-	Queue    QueueConfig
-	Storage  StorageConfig
-	Local    LocalConfig
+	App           AppConfig
+	Mongo         MongoConfig
+	AWS           AWSConfig
+	Auth          AuthConfig
+	Security      SecurityConfig
+	Queue         QueueConfig
+	Storage       StorageConfig
+	Outbox        OutboxConfig
+	Currencies    CurrencyConfig
+	ExchangeRates ExchangeRatesConfig
+	Recurring     RecurringConfig
+	Snapshots     BalanceSnapshotConfig
+	Interest      SavingsInterestConfig
+	Local         LocalConfig
 }
 
 var (
@@ -191,6 +229,31 @@ func LoadConfig() (*Config, error) {
 			Storage: StorageConfig{
 				ReceiptBucket: viper.GetString("storage.receiptBucket"),
 			},
+			Outbox: OutboxConfig{
+				RelayInterval: viper.GetDuration("outbox.relayInterval"),
+				BatchSize:     viper.GetInt("outbox.batchSize"),
+				MaxAttempts:   viper.GetInt("outbox.maxAttempts"),
+			},
+			Currencies: CurrencyConfig{
+				Enabled: viper.GetStringSlice("currencies.enabled"),
+			},
+			ExchangeRates: ExchangeRatesConfig{
+				Provider:     viper.GetString("exchangeRates.provider"),
+				File:         viper.GetString("exchangeRates.file"),
+				SyncInterval: viper.GetDuration("exchangeRates.syncInterval"),
+			},
+			Recurring: RecurringConfig{
+				SchedulerInterval: viper.GetDuration("recurring.schedulerInterval"),
+				BatchSize:         viper.GetInt("recurring.batchSize"),
+			},
+			Snapshots: BalanceSnapshotConfig{
+				Interval:  viper.GetDuration("snapshots.interval"),
+				BatchSize: viper.GetInt("snapshots.batchSize"),
+			},
+			Interest: SavingsInterestConfig{
+				Interval:  viper.GetDuration("interest.interval"),
+				BatchSize: viper.GetInt("interest.batchSize"),
+			},
 			Local: LocalConfig{
 				CredentialsFile: viper.GetString("local.credentialsFile"),
 				AuthUsers:       readLocalAuthUsers(viper.Get("local.authUsers")),
@@ -236,6 +299,19 @@ func setDefaults() {
 	viper.SetDefault("security.allowedOrigins", []string{"*"})
 	viper.SetDefault("queue.transactionQueue", "financial-transactions-queue")
 	viper.SetDefault("storage.receiptBucket", "financial-control-receipts")
+	viper.SetDefault("outbox.relayInterval", "2s")
+	viper.SetDefault("outbox.batchSize", 50)
+	viper.SetDefault("outbox.maxAttempts", 10)
+	viper.SetDefault("currencies.enabled", []string{"USD", "EUR", "CHF", "GBP", "BRL"})
+	viper.SetDefault("exchangeRates.provider", "csv")
+	viper.SetDefault("exchangeRates.file", "")
+	viper.SetDefault("exchangeRates.syncInterval", "24h")
+	viper.SetDefault("recurring.schedulerInterval", "5m")
+	viper.SetDefault("recurring.batchSize", 100)
+	viper.SetDefault("snapshots.interval", "24h")
+	viper.SetDefault("snapshots.batchSize", 200)
+	viper.SetDefault("interest.interval", "24h")
+	viper.SetDefault("interest.batchSize", 200)
 	viper.SetDefault("local.credentialsFile", "config/local_credentials.yaml")
 }
 
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 // TestLoadConfigFromFile garante que o carregamento via arquivo YAML popula os campos básicos
@@ -43,6 +44,15 @@ mongo:
 	if cfg.Mongo.Database != "financial" {
 		t.Errorf("esperado mongo.database 'financial', obtido '%s'", cfg.Mongo.Database)
 	}
+	if cfg.Outbox.RelayInterval != 2*time.Second || cfg.Outbox.MaxAttempts != 10 {
+		t.Errorf("esperado padrão do outbox 2s/10, obtido %v/%d", cfg.Outbox.RelayInterval, cfg.Outbox.MaxAttempts)
+	}
+	if cfg.ExchangeRates.Provider != "csv" || cfg.ExchangeRates.SyncInterval != 24*time.Hour {
+		t.Errorf("esperado padrão de cotações csv/24h, obtido %s/%v", cfg.ExchangeRates.Provider, cfg.ExchangeRates.SyncInterval)
+	}
+	if cfg.Recurring.SchedulerInterval != 5*time.Minute || cfg.Recurring.BatchSize != 100 {
+		t.Errorf("esperado padrão do agendador de recorrências 5m/100, obtido %v/%d", cfg.Recurring.SchedulerInterval, cfg.Recurring.BatchSize)
+	}
 }
 
 // TestLoadConfigEnvOverride valida que variáveis de ambiente sobrescrevem valores do arquivo
@@ -1,25 +1,53 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
 type CreateAccountRequest struct {
-	Name        string  `json:"name" binding:"required"`
-	Type        string  `json:"type" binding:"required,oneof=checking savings credit cash"`
-	Currency    string  `json:"currency" binding:"required,oneof=USD EUR CHF GBP BRL"`
-	Description string  `json:"description"`
-	Balance     float64 `json:"balance"`
+	Name        string              `json:"name" binding:"required"`
+	Type        string              `json:"type" binding:"required,oneof=checking savings credit cash investment loan"`
+	Currency    string              `json:"currency" binding:"required,currency"`
+	Description string              `json:"description"`
+	Balance     entity.Money        `json:"balance" binding:"minorunits=Currency" swaggertype:"string" example:"1500.00"`
+	CreditCard  *CreditCard         `json:"creditCard,omitempty"` // ciclo de fatura; só para contas do tipo credit
+	Investment  *InvestmentSettings `json:"investment,omitempty"` // método de custo; só para contas do tipo investment
+	Loan        *LoanTerms          `json:"loan,omitempty"`       // termos do empréstimo; obrigatório em contas do tipo loan
+	Savings     *SavingsSettings    `json:"savings,omitempty"`    // rendimento; só para contas do tipo savings
 }
 
 type UpdateAccountRequest struct {
-	Name        *string `json:"name"`
-	Type        *string `json:"type"`
-	Currency    *string `json:"currency" binding:"omitempty,oneof=USD EUR CHF GBP BRL"`
-	Description *string `json:"description"`
+	Name        *string             `json:"name"`
+	Type        *string             `json:"type"`
+	Currency    *string             `json:"currency" binding:"omitempty,currency"`
+	Description *string             `json:"description"`
+	CreditCard  *CreditCard         `json:"creditCard"`
+	Investment  *InvestmentSettings `json:"investment"`
+	Savings     *SavingsSettings    `json:"savings"`
 }
 
 type AccountResponse struct {
-	ID          string  `json:"id"`
-	Name        string  `json:"name"`
-	Type        string  `json:"type"`
-	Currency    string  `json:"currency"`
-	Description string  `json:"description"`
-	Balance     float64 `json:"balance"`
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Type        string              `json:"type"`
+	Currency    string              `json:"currency"`
+	Description string              `json:"description"`
+	Balance     entity.Money        `json:"balance" swaggertype:"string" example:"1500.00"`
+	CreditCard  *CreditCard         `json:"creditCard,omitempty"`
+	Investment  *InvestmentSettings `json:"investment,omitempty"`
+	Loan        *LoanTerms          `json:"loan,omitempty"`
+	Savings     *SavingsSettings    `json:"savings,omitempty"`
+	ClosedAt    *time.Time          `json:"closedAt,omitempty"`
+}
+
+type CloseAccountRequest struct {
+	ClosedAt *time.Time `json:"closedAt"` // padrão: agora
+}
+
+// AccountPage é a resposta da paginação por chave; NextCursor vazio indica a última página
+type AccountPage struct {
+	Items      []*AccountResponse `json:"items"`
+	NextCursor string             `json:"nextCursor,omitempty"`
 }
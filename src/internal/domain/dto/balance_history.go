@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+// BalancePoint é o saldo da conta no fim do período que começa em Date (no último período, o saldo em "to")
+type BalancePoint struct {
+	Date    time.Time    `json:"date"`
+	Balance entity.Money `json:"balance" swaggertype:"string" example:"1520.35"`
+}
+
+type BalanceHistoryResponse struct {
+	AccountID string         `json:"accountId"`
+	Currency  string         `json:"currency"`
+	Interval  string         `json:"interval" example:"day"`
+	From      time.Time      `json:"from"`
+	To        time.Time      `json:"to"`
+	Points    []BalancePoint `json:"points"`
+}
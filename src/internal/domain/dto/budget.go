@@ -1,25 +1,39 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
 
 type CreateBudgetRequest struct {
-	CategoryID   string    `json:"categoryId" binding:"required"`
-	Amount       float64   `json:"amount" binding:"required"`
-	Currency     string    `json:"currency" binding:"required,oneof=USD EUR CHF GBP BRL"`
-	Period       string    `json:"period" binding:"required,oneof=monthly quarterly yearly"`
-	PeriodStart  time.Time `json:"periodStart" binding:"required"`
-	PeriodEnd    time.Time `json:"periodEnd" binding:"required"`
-	AlertPercent float64   `json:"alertPercent" binding:"required"`
+	CategoryID   string       `json:"categoryId" binding:"required"`
+	Amount       entity.Money `json:"amount" binding:"required,minorunits=Currency" swaggertype:"string" example:"800.00"`
+	Currency     string       `json:"currency" binding:"required,currency"`
+	Period       string       `json:"period" binding:"required,oneof=monthly quarterly yearly"`
+	PeriodStart  time.Time    `json:"periodStart" binding:"required"`
+	PeriodEnd    time.Time    `json:"periodEnd" binding:"required"`
+	AlertPercent float64      `json:"alertPercent" binding:"required"`
 }
 
 type BudgetResponse struct {
-	ID           string    `json:"id"`
-	CategoryID   string    `json:"categoryId"`
-	Amount       float64   `json:"amount"`
-	Currency     string    `json:"currency"`
-	Period       string    `json:"period"`
-	PeriodStart  time.Time `json:"periodStart"`
-	PeriodEnd    time.Time `json:"periodEnd"`
-	Spent        float64   `json:"spent"`
-	AlertPercent float64   `json:"alertPercent"`
+	ID           string       `json:"id"`
+	CategoryID   string       `json:"categoryId"`
+	Amount       entity.Money `json:"amount" swaggertype:"string" example:"800.00"`
+	Currency     string       `json:"currency"`
+	Period       string       `json:"period"`
+	PeriodStart  time.Time    `json:"periodStart"`
+	PeriodEnd    time.Time    `json:"periodEnd"`
+	Spent        entity.Money `json:"spent" swaggertype:"string" example:"120.50"`
+	AlertPercent float64      `json:"alertPercent"`
+	// Valores convertidos para a moeda padrão do usuário pela cotação do dia (ausentes sem cotação)
+	DisplayCurrency string        `json:"displayCurrency,omitempty" example:"BRL"`
+	ConvertedAmount *entity.Money `json:"convertedAmount,omitempty" swaggertype:"string" example:"4000.00"`
+	ConvertedSpent  *entity.Money `json:"convertedSpent,omitempty" swaggertype:"string" example:"602.50"`
+}
+
+// BudgetPage é a resposta da paginação por chave; NextCursor vazio indica a última página
+type BudgetPage struct {
+	Items      []*BudgetResponse `json:"items"`
+	NextCursor string            `json:"nextCursor,omitempty"`
 }
@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type RuleConditions struct {
+	DescriptionContains string        `json:"descriptionContains,omitempty" example:"uber"`
+	DescriptionRegex    string        `json:"descriptionRegex,omitempty" example:"^PAG\\*UBER"`
+	MinAmount           *entity.Money `json:"minAmount,omitempty" swaggertype:"string" example:"10.00"`
+	MaxAmount           *entity.Money `json:"maxAmount,omitempty" swaggertype:"string" example:"200.00"`
+	AccountIDs          []string      `json:"accountIds,omitempty"`
+	Tags                []string      `json:"tags,omitempty"`
+}
+
+type RuleActions struct {
+	CategoryID  string   `json:"categoryId,omitempty"`
+	AddTags     []string `json:"addTags,omitempty"`
+	Description string   `json:"description,omitempty" example:"Uber"`
+}
+
+type CreateCategorizationRuleRequest struct {
+	Name       string         `json:"name" binding:"required,max=100"`
+	Priority   int            `json:"priority" binding:"min=0" example:"10"`
+	Enabled    *bool          `json:"enabled"` // padrão true
+	Conditions RuleConditions `json:"conditions"`
+	Actions    RuleActions    `json:"actions"`
+}
+
+type UpdateCategorizationRuleRequest struct {
+	Name       *string         `json:"name" binding:"omitempty,max=100"`
+	Priority   *int            `json:"priority" binding:"omitempty,min=0"`
+	Enabled    *bool           `json:"enabled"`
+	Conditions *RuleConditions `json:"conditions"`
+	Actions    *RuleActions    `json:"actions"`
+}
+
+type CategorizationRuleResponse struct {
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	Priority   int            `json:"priority"`
+	Enabled    bool           `json:"enabled"`
+	Conditions RuleConditions `json:"conditions"`
+	Actions    RuleActions    `json:"actions"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	UpdatedAt  time.Time      `json:"updatedAt"`
+}
+
+type DryRunRulesRequest struct {
+	Limit int64                            `json:"limit" binding:"omitempty,min=1,max=500" example:"50"` // últimas N transações (padrão 50)
+	Rule  *CreateCategorizationRuleRequest `json:"rule"`                                                 // testa só esta regra, sem salvá-la, em vez das regras habilitadas
+}
+
+// RuleDryRunResult mostra o que as regras fariam com uma transação já gravada, sem alterá-la
+type RuleDryRunResult struct {
+	TransactionID  string    `json:"transactionId"`
+	OccurredAt     time.Time `json:"occurredAt"`
+	Description    string    `json:"description"`
+	CategoryID     string    `json:"categoryId"`
+	NewDescription string    `json:"newDescription,omitempty"`
+	NewCategoryID  string    `json:"newCategoryId,omitempty"`
+	AddedTags      []string  `json:"addedTags,omitempty"`
+	MatchedRules   []string  `json:"matchedRules"`
+}
+
+type DryRunRulesResponse struct {
+	Evaluated int                `json:"evaluated"`
+	Matched   int                `json:"matched"`
+	Results   []RuleDryRunResult `json:"results"`
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type CreditCard struct {
+	ClosingDay  int          `json:"closingDay" binding:"required,min=1,max=31" example:"25"`
+	DueDay      int          `json:"dueDay" binding:"required,min=1,max=31" example:"5"`
+	CreditLimit entity.Money `json:"creditLimit" swaggertype:"string" example:"5000.00"`
+}
+
+// StatementResponse resume uma fatura; compras de periodStart até a véspera de closingDate entram nela
+type StatementResponse struct {
+	PeriodStart     time.Time    `json:"periodStart"`
+	ClosingDate     time.Time    `json:"closingDate"`
+	DueDate         time.Time    `json:"dueDate"`
+	Closed          bool         `json:"closed"`
+	PreviousBalance entity.Money `json:"previousBalance" swaggertype:"string" example:"0.00"` // saldo deixado sem pagar nas faturas anteriores
+	Charges         entity.Money `json:"charges" swaggertype:"string" example:"1250.00"`      // compras
+	Credits         entity.Money `json:"credits" swaggertype:"string" example:"50.00"`        // estornos e créditos
+	Total           entity.Money `json:"total" swaggertype:"string" example:"1200.00"`        // saldo anterior mais compras menos créditos
+	Paid            entity.Money `json:"paid" swaggertype:"string" example:"0.00"`            // pagamentos feitos depois do fechamento
+	Outstanding     entity.Money `json:"outstanding" swaggertype:"string" example:"1200.00"`  // total menos pagamentos
+}
+
+type CreditCardSummaryResponse struct {
+	AccountID       string             `json:"accountId"`
+	Currency        string             `json:"currency"`
+	Balance         entity.Money       `json:"balance" swaggertype:"string" example:"-1200.00"`
+	CreditLimit     entity.Money       `json:"creditLimit" swaggertype:"string" example:"5000.00"`
+	AvailableCredit entity.Money       `json:"availableCredit" swaggertype:"string" example:"3800.00"`
+	ClosedStatement *StatementResponse `json:"closedStatement,omitempty"` // última fatura fechada, a que está para pagar
+	Current         StatementResponse  `json:"current"`                   // fatura aberta
+	Next            StatementResponse  `json:"next"`
+}
+
+type PayStatementRequest struct {
+	FromAccountID string        `json:"fromAccountId" binding:"required"`
+	Amount        *entity.Money `json:"amount" binding:"omitempty,gt=0" swaggertype:"string" example:"1200.00"` // padrão: saldo em aberto da última fatura fechada
+	OccurredAt    *time.Time    `json:"occurredAt"`                                                             // padrão: agora
+	Description   string        `json:"description"`
+}
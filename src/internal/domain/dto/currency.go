@@ -0,0 +1,10 @@
+package dto
+
+type CurrencyResponse struct {
+	Code        string `json:"code" example:"JPY"`
+	NumericCode string `json:"numericCode" example:"392"`
+	MinorUnits  int    `json:"minorUnits" example:"0"`
+	Symbol      string `json:"symbol" example:"¥"`
+	Name        string `json:"name" example:"Yen"`
+	Enabled     bool   `json:"enabled"`
+}
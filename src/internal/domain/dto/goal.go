@@ -1,26 +1,40 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
 
 type CreateGoalRequest struct {
-	Name         string    `json:"name" binding:"required"`
-	TargetAmount float64   `json:"targetAmount" binding:"required"`
-	Currency     string    `json:"currency" binding:"required,oneof=USD EUR CHF GBP BRL"`
-	Deadline     time.Time `json:"deadline" binding:"required"`
-	Description  string    `json:"description"`
+	Name         string       `json:"name" binding:"required"`
+	TargetAmount entity.Money `json:"targetAmount" binding:"required,minorunits=Currency" swaggertype:"string" example:"10000.00"`
+	Currency     string       `json:"currency" binding:"required,currency"`
+	Deadline     time.Time    `json:"deadline" binding:"required"`
+	Description  string       `json:"description"`
 }
 
 type UpdateGoalProgressRequest struct {
-	Amount float64 `json:"amount" binding:"required"`
+	Amount entity.Money `json:"amount" binding:"required" swaggertype:"string" example:"250.00"`
 }
 
 type GoalResponse struct {
-	ID            string    `json:"id"`
-	Name          string    `json:"name"`
-	TargetAmount  float64   `json:"targetAmount"`
-	CurrentAmount float64   `json:"currentAmount"`
-	Currency      string    `json:"currency"`
-	Deadline      time.Time `json:"deadline"`
-	Status        string    `json:"status"`
-	Description   string    `json:"description"`
+	ID            string       `json:"id"`
+	Name          string       `json:"name"`
+	TargetAmount  entity.Money `json:"targetAmount" swaggertype:"string" example:"10000.00"`
+	CurrentAmount entity.Money `json:"currentAmount" swaggertype:"string" example:"2500.00"`
+	Currency      string       `json:"currency"`
+	Deadline      time.Time    `json:"deadline"`
+	Status        string       `json:"status"`
+	Description   string       `json:"description"`
+	// Valores convertidos para a moeda padrão do usuário pela cotação do dia (ausentes sem cotação)
+	DisplayCurrency        string        `json:"displayCurrency,omitempty" example:"BRL"`
+	ConvertedTargetAmount  *entity.Money `json:"convertedTargetAmount,omitempty" swaggertype:"string" example:"50000.00"`
+	ConvertedCurrentAmount *entity.Money `json:"convertedCurrentAmount,omitempty" swaggertype:"string" example:"12500.00"`
+}
+
+// GoalPage é a resposta da paginação por chave; NextCursor vazio indica a última página
+type GoalPage struct {
+	Items      []*GoalResponse `json:"items"`
+	NextCursor string          `json:"nextCursor,omitempty"`
 }
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type CreateImportProfileRequest struct {
+	Name              string `json:"name" binding:"required"`
+	Delimiter         string `json:"delimiter" example:";"`
+	Encoding          string `json:"encoding" binding:"omitempty,oneof=utf-8 latin-1 windows-1252" example:"latin-1"`
+	SkipRows          int    `json:"skipRows" binding:"min=0" example:"1"`
+	DateColumn        int    `json:"dateColumn" binding:"min=0" example:"0"`
+	DescriptionColumn int    `json:"descriptionColumn" binding:"min=0" example:"1"`
+	AmountColumn      *int   `json:"amountColumn" binding:"omitempty,min=0" example:"2"`
+	DebitColumn       *int   `json:"debitColumn" binding:"omitempty,min=0"`
+	CreditColumn      *int   `json:"creditColumn" binding:"omitempty,min=0"`
+	DateFormat        string `json:"dateFormat" binding:"required" example:"DD/MM/YYYY"`
+	DecimalSeparator  string `json:"decimalSeparator" example:","`
+	SignConvention    string `json:"signConvention" binding:"required,oneof=negative_expense positive_expense debit_credit_columns" example:"negative_expense"`
+}
+
+type ImportProfileResponse struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Delimiter         string `json:"delimiter"`
+	Encoding          string `json:"encoding"`
+	SkipRows          int    `json:"skipRows"`
+	DateColumn        int    `json:"dateColumn"`
+	DescriptionColumn int    `json:"descriptionColumn"`
+	AmountColumn      *int   `json:"amountColumn,omitempty"`
+	DebitColumn       *int   `json:"debitColumn,omitempty"`
+	CreditColumn      *int   `json:"creditColumn,omitempty"`
+	DateFormat        string `json:"dateFormat"`
+	DecimalSeparator  string `json:"decimalSeparator"`
+	SignConvention    string `json:"signConvention"`
+}
+
+type CommitImportRequest struct {
+	ExpenseCategoryID string `json:"expenseCategoryId"`
+	IncomeCategoryID  string `json:"incomeCategoryId"`
+	Lines             []int  `json:"lines"` // linhas a importar; vazio importa todas as linhas válidas
+}
+
+type ImportRowResponse struct {
+	Line          int          `json:"line"`
+	OccurredAt    time.Time    `json:"occurredAt"`
+	Description   string       `json:"description"`
+	Amount        entity.Money `json:"amount" swaggertype:"string" example:"89.90"`
+	Type          string       `json:"type,omitempty"`
+	Status        string       `json:"status"`
+	Error         string       `json:"error,omitempty"`
+	TransactionID string       `json:"transactionId,omitempty"`
+	ExternalRef   string       `json:"externalRef,omitempty"`
+}
+
+type ImportBatchResponse struct {
+	ID            string               `json:"id"`
+	AccountID     string               `json:"accountId"`
+	ProfileID     string               `json:"profileId,omitempty"`
+	Source        string               `json:"source"`
+	FileName      string               `json:"fileName"`
+	Currency      string               `json:"currency"`
+	Status        string               `json:"status"`
+	TotalRows     int                  `json:"totalRows"`
+	InvalidRows   int                  `json:"invalidRows"`
+	ImportedRows  int                  `json:"importedRows"`
+	DuplicateRows int                  `json:"duplicateRows"`
+	Rows          []*ImportRowResponse `json:"rows"`
+	CreatedAt     time.Time            `json:"createdAt"`
+	CommittedAt   *time.Time           `json:"committedAt,omitempty"`
+	ExpiresAt     *time.Time           `json:"expiresAt,omitempty"`
+}
@@ -0,0 +1,108 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+// InvestmentSettings configura uma conta de investimento
+type InvestmentSettings struct {
+	CostBasis string `json:"costBasis" binding:"omitempty,oneof=fifo average" example:"fifo"` // padrão fifo
+}
+
+type CreateSecurityRequest struct {
+	Symbol   string `json:"symbol" binding:"required,max=20" example:"PETR4"`
+	Name     string `json:"name" binding:"max=100" example:"Petrobras PN"`
+	Currency string `json:"currency" binding:"required,currency" example:"BRL"`
+}
+
+type UpdateSecurityRequest struct {
+	Symbol *string `json:"symbol" binding:"omitempty,min=1,max=20"`
+	Name   *string `json:"name" binding:"omitempty,max=100"`
+}
+
+type SecurityResponse struct {
+	ID        string    `json:"id"`
+	Symbol    string    `json:"symbol"`
+	Name      string    `json:"name"`
+	Currency  string    `json:"currency"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type SetSecurityPriceRequest struct {
+	Date  time.Time    `json:"date" binding:"required"`
+	Price entity.Money `json:"price" swaggertype:"string" example:"38.42"`
+}
+
+type SecurityPriceResponse struct {
+	Date   time.Time    `json:"date"`
+	Price  entity.Money `json:"price" swaggertype:"string" example:"38.42"`
+	Source string       `json:"source" example:"manual"`
+}
+
+// ImportPricesResponse resume a importação de um arquivo de preços; linhas de ativos não cadastrados são ignoradas
+type ImportPricesResponse struct {
+	Imported       int      `json:"imported"`
+	UnknownSymbols []string `json:"unknownSymbols,omitempty"`
+}
+
+type CreateTradeRequest struct {
+	SecurityID  string       `json:"securityId" binding:"required"`
+	Type        string       `json:"type" binding:"required,oneof=buy sell dividend"`
+	Quantity    entity.Money `json:"quantity" swaggertype:"string" example:"100"` // cotas; vazio em proventos
+	Price       entity.Money `json:"price" swaggertype:"string" example:"38.42"`  // preço unitário; em proventos, o valor bruto recebido
+	Fees        entity.Money `json:"fees" swaggertype:"string" example:"4.90"`    // corretagem e outras taxas
+	CategoryID  string       `json:"categoryId"`                                  // categoria de receita; obrigatória em proventos
+	Description string       `json:"description"`                                 // padrão: tipo, quantidade e ativo
+	OccurredAt  time.Time    `json:"occurredAt" binding:"required"`
+}
+
+type TradeResponse struct {
+	ID            string       `json:"id"`
+	AccountID     string       `json:"accountId"`
+	SecurityID    string       `json:"securityId"`
+	Type          string       `json:"type"`
+	Quantity      entity.Money `json:"quantity" swaggertype:"string" example:"100"`
+	Price         entity.Money `json:"price" swaggertype:"string" example:"38.42"`
+	Fees          entity.Money `json:"fees" swaggertype:"string" example:"4.90"`
+	CashAmount    entity.Money `json:"cashAmount" swaggertype:"string" example:"3846.90"` // valor que saiu ou entrou no caixa da conta
+	TransactionID string       `json:"transactionId"`
+	OccurredAt    time.Time    `json:"occurredAt"`
+}
+
+type LotResponse struct {
+	TradeID    string       `json:"tradeId"`
+	AcquiredAt time.Time    `json:"acquiredAt"`
+	Quantity   entity.Money `json:"quantity" swaggertype:"string" example:"50"`
+	Cost       entity.Money `json:"cost" swaggertype:"string" example:"1921.45"`
+}
+
+type HoldingResponse struct {
+	SecurityID     string        `json:"securityId"`
+	Symbol         string        `json:"symbol"`
+	Quantity       entity.Money  `json:"quantity" swaggertype:"string" example:"100"`
+	CostBasis      entity.Money  `json:"costBasis" swaggertype:"string" example:"3846.90"`
+	AverageCost    entity.Money  `json:"averageCost" swaggertype:"string" example:"38.47"`
+	Price          entity.Money  `json:"price" swaggertype:"string" example:"41.10"`
+	PriceDate      time.Time     `json:"priceDate"`
+	MarketValue    entity.Money  `json:"marketValue" swaggertype:"string" example:"4110.00"`
+	UnrealizedGain entity.Money  `json:"unrealizedGain" swaggertype:"string" example:"263.10"`
+	RealizedGain   entity.Money  `json:"realizedGain" swaggertype:"string" example:"0.00"`
+	Dividends      entity.Money  `json:"dividends" swaggertype:"string" example:"52.00"`
+	Lots           []LotResponse `json:"lots,omitempty"` // só no método FIFO
+}
+
+// PortfolioResponse é a carteira da conta de investimento: caixa, posições a preço de mercado e resultados
+type PortfolioResponse struct {
+	AccountID      string            `json:"accountId"`
+	Currency       string            `json:"currency"`
+	CostBasis      string            `json:"costBasisMethod" example:"fifo"`
+	Cash           entity.Money      `json:"cash" swaggertype:"string" example:"1200.00"`
+	MarketValue    entity.Money      `json:"marketValue" swaggertype:"string" example:"4110.00"`
+	TotalValue     entity.Money      `json:"totalValue" swaggertype:"string" example:"5310.00"`
+	UnrealizedGain entity.Money      `json:"unrealizedGain" swaggertype:"string" example:"263.10"`
+	RealizedGain   entity.Money      `json:"realizedGain" swaggertype:"string" example:"0.00"`
+	Dividends      entity.Money      `json:"dividends" swaggertype:"string" example:"52.00"`
+	Holdings       []HoldingResponse `json:"holdings"`
+}
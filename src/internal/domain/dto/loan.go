@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+// LoanTerms são os termos de uma conta de empréstimo ou financiamento; o saldo da conta começa em -principal
+type LoanTerms struct {
+	Principal    entity.Money `json:"principal" swaggertype:"string" example:"350000.00"`
+	AnnualRate   float64      `json:"annualRate" binding:"min=0,max=1000" example:"10.5"` // taxa efetiva ao ano, em percentual
+	TermMonths   int          `json:"termMonths" binding:"required,min=1,max=420" example:"360"`
+	FirstDueDate time.Time    `json:"firstDueDate" binding:"required"`
+	System       string       `json:"system" binding:"omitempty,oneof=price sac" example:"sac"` // padrão sac
+}
+
+type AmortizationRowResponse struct {
+	Number    int          `json:"number"`
+	DueDate   time.Time    `json:"dueDate"`
+	Payment   entity.Money `json:"payment" swaggertype:"string" example:"3893.01"`
+	Principal entity.Money `json:"principal" swaggertype:"string" example:"972.22"`
+	Interest  entity.Money `json:"interest" swaggertype:"string" example:"2920.79"`
+	Extra     entity.Money `json:"extra" swaggertype:"string" example:"0.00"`
+	Balance   entity.Money `json:"balance" swaggertype:"string" example:"349027.78"` // saldo devedor depois da parcela
+}
+
+// LoanResponse é a situação do empréstimo e o cronograma das parcelas restantes
+type LoanResponse struct {
+	AccountID             string                    `json:"accountId"`
+	Currency              string                    `json:"currency"`
+	System                string                    `json:"system" example:"sac"`
+	AnnualRate            float64                   `json:"annualRate" example:"10.5"`
+	MonthlyRate           float64                   `json:"monthlyRate" example:"0.8355"` // taxa mensal equivalente, em percentual
+	Principal             entity.Money              `json:"principal" swaggertype:"string" example:"350000.00"`
+	Outstanding           entity.Money              `json:"outstanding" swaggertype:"string" example:"349027.78"`
+	PaidInstallments      int                       `json:"paidInstallments"`
+	RemainingInstallments int                       `json:"remainingInstallments"`
+	RemainingInterest     entity.Money              `json:"remainingInterest" swaggertype:"string" example:"512345.67"`
+	NextPayment           *AmortizationRowResponse  `json:"nextPayment,omitempty"`
+	PayoffDate            *time.Time                `json:"payoffDate,omitempty"`
+	LastPaymentID         string                    `json:"lastPaymentId,omitempty"` // pagamento mais recente, o único que pode ser estornado
+	Schedule              []AmortizationRowResponse `json:"schedule"`
+}
+
+type PayLoanInstallmentRequest struct {
+	FromAccountID string     `json:"fromAccountId" binding:"required"`
+	CategoryID    string     `json:"categoryId" binding:"required"` // categoria de despesa dos juros
+	OccurredAt    *time.Time `json:"occurredAt"`                    // padrão: agora
+	Description   string     `json:"description"`
+}
+
+type PrepayLoanRequest struct {
+	FromAccountID string       `json:"fromAccountId" binding:"required"`
+	Amount        entity.Money `json:"amount" swaggertype:"string" example:"20000.00"`
+	Mode          string       `json:"mode" binding:"omitempty,oneof=reduce_term reduce_payment" example:"reduce_term"` // padrão reduce_term
+	OccurredAt    *time.Time   `json:"occurredAt"`                                                                      // padrão: agora
+	Description   string       `json:"description"`
+}
+
+// LoanPaymentResponse mostra como o pagamento foi dividido e a situação do empréstimo depois dele
+type LoanPaymentResponse struct {
+	PaymentID      string       `json:"paymentId"`        // usado para estornar o pagamento
+	Number         int          `json:"number,omitempty"` // parcela paga; vazio em amortização extraordinária
+	Principal      entity.Money `json:"principal" swaggertype:"string" example:"972.22"`
+	Interest       entity.Money `json:"interest" swaggertype:"string" example:"2920.79"`
+	Outstanding    entity.Money `json:"outstanding" swaggertype:"string" example:"349027.78"`
+	TransactionIDs []string     `json:"transactionIds"`
+	Loan           LoanResponse `json:"loan"`
+}
+
+// SimulateLoanRequest descreve amortizações extraordinárias hipotéticas: um valor agora e/ou um valor todo mês
+type SimulateLoanRequest struct {
+	Extra        entity.Money `json:"extra" swaggertype:"string" example:"20000.00"`
+	MonthlyExtra entity.Money `json:"monthlyExtra" swaggertype:"string" example:"500.00"`
+	Mode         string       `json:"mode" binding:"omitempty,oneof=reduce_term reduce_payment" example:"reduce_term"` // padrão reduce_term
+}
+
+type LoanProjection struct {
+	Installments  int          `json:"installments"`
+	PayoffDate    *time.Time   `json:"payoffDate,omitempty"`
+	TotalInterest entity.Money `json:"totalInterest" swaggertype:"string" example:"512345.67"`
+	NextPayment   entity.Money `json:"nextPayment" swaggertype:"string" example:"3893.01"`
+}
+
+// LoanSimulationResponse compara o cronograma atual com o simulado
+type LoanSimulationResponse struct {
+	Current           LoanProjection            `json:"current"`
+	Simulated         LoanProjection            `json:"simulated"`
+	InterestSaved     entity.Money              `json:"interestSaved" swaggertype:"string" example:"98765.43"`
+	InstallmentsSaved int                       `json:"installmentsSaved"`
+	Schedule          []AmortizationRowResponse `json:"schedule"` // cronograma simulado
+}
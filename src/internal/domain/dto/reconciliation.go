@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type StartReconciliationRequest struct {
+	StatementDate    time.Time    `json:"statementDate" binding:"required"` // data final do extrato
+	StatementBalance entity.Money `json:"statementBalance" swaggertype:"string" example:"1520.35"`
+}
+
+type ClearTransactionsRequest struct {
+	TransactionIDs []string `json:"transactionIds" binding:"required,min=1"`
+	Cleared        *bool    `json:"cleared"` // padrão true; false desmarca
+}
+
+// ReconciliationTransaction é uma transação candidata: ainda não conciliada e com data até o fim do extrato
+type ReconciliationTransaction struct {
+	ID          string       `json:"id"`
+	Type        string       `json:"type"`
+	Amount      entity.Money `json:"amount" swaggertype:"string" example:"120.50"`
+	Description string       `json:"description"`
+	OccurredAt  time.Time    `json:"occurredAt"`
+	Cleared     bool         `json:"cleared"`
+}
+
+type ReconciliationResponse struct {
+	ID               string                      `json:"id"`
+	AccountID        string                      `json:"accountId"`
+	Status           string                      `json:"status"`
+	StatementDate    time.Time                   `json:"statementDate"`
+	StatementBalance entity.Money                `json:"statementBalance" swaggertype:"string" example:"1520.35"`
+	ClearedBalance   entity.Money                `json:"clearedBalance" swaggertype:"string" example:"1490.35"` // saldo das transações conferidas
+	Difference       entity.Money                `json:"difference" swaggertype:"string" example:"30.00"`       // extrato menos conferido; precisa ser zero para concluir
+	Transactions     []ReconciliationTransaction `json:"transactions,omitempty"`                                // candidatas, só na conciliação aberta
+	TransactionIDs   []string                    `json:"transactionIds,omitempty"`                              // transações travadas, na conciliação concluída
+	CreatedAt        time.Time                   `json:"createdAt"`
+	CompletedAt      *time.Time                  `json:"completedAt,omitempty"`
+}
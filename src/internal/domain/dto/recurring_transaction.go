@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type CreateRecurringTransactionRequest struct {
+	AccountID   string       `json:"accountId" binding:"required"`
+	CategoryID  string       `json:"categoryId" binding:"required"`
+	Amount      entity.Money `json:"amount" binding:"required,gt=0,minorunits=Currency" swaggertype:"string" example:"1500.00"`
+	Currency    string       `json:"currency" binding:"required,currency"`
+	Description string       `json:"description"`
+	Tags        []string     `json:"tags"`
+	Frequency   string       `json:"frequency" binding:"required,oneof=daily weekly monthly last_business_day" example:"monthly"`
+	Interval    int          `json:"interval" binding:"omitempty,min=1,max=366" example:"1"`
+	Weekday     *int         `json:"weekday" binding:"omitempty,min=0,max=6" example:"1"`
+	DayOfMonth  int          `json:"dayOfMonth" binding:"omitempty,min=1,max=31" example:"5"`
+	StartDate   time.Time    `json:"startDate" binding:"required"`
+	EndDate     *time.Time   `json:"endDate"`
+}
+
+type UpdateRecurringTransactionRequest struct {
+	AccountID   *string       `json:"accountId"`
+	CategoryID  *string       `json:"categoryId"`
+	Amount      *entity.Money `json:"amount" binding:"omitempty,gt=0,minorunits=Currency" swaggertype:"string" example:"1500.00"`
+	Currency    *string       `json:"currency" binding:"omitempty,currency"`
+	Description *string       `json:"description"`
+	Tags        []string      `json:"tags"`
+	EndDate     *time.Time    `json:"endDate"`
+}
+
+type RecurringTransactionResponse struct {
+	ID             string       `json:"id"`
+	AccountID      string       `json:"accountId"`
+	CategoryID     string       `json:"categoryId"`
+	Amount         entity.Money `json:"amount" swaggertype:"string" example:"1500.00"`
+	Currency       string       `json:"currency"`
+	Description    string       `json:"description"`
+	Tags           []string     `json:"tags"`
+	Frequency      string       `json:"frequency"`
+	Interval       int          `json:"interval"`
+	Weekday        *int         `json:"weekday,omitempty"`
+	DayOfMonth     int          `json:"dayOfMonth,omitempty"`
+	StartDate      time.Time    `json:"startDate"`
+	EndDate        *time.Time   `json:"endDate,omitempty"`
+	NextOccurrence *time.Time   `json:"nextOccurrence,omitempty"`
+	LastOccurrence *time.Time   `json:"lastOccurrence,omitempty"`
+	Status         string       `json:"status"`
+	LastError      string       `json:"lastError,omitempty"`
+	FailureCount   int          `json:"failureCount,omitempty"` // falhas seguidas; a recorrência é pausada ao chegar ao limite
+}
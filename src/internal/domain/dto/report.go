@@ -1,10 +1,55 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
 type SummaryReportResponse struct {
-	TotalIncome        float64            `json:"totalIncome"`
-	TotalExpense       float64            `json:"totalExpense"`
-	NetBalance         float64            `json:"netBalance"`
-	SpendingByCategory map[string]float64 `json:"spendingByCategory"`
-	BudgetUsage        map[string]float64 `json:"budgetUsage"`
-	GoalProgress       map[string]float64 `json:"goalProgress"`
+	Currency           string                  `json:"currency" example:"BRL"`
+	TotalIncome        entity.Money            `json:"totalIncome" swaggertype:"string" example:"5000.00"`
+	TotalExpense       entity.Money            `json:"totalExpense" swaggertype:"string" example:"3200.00"`
+	NetBalance         entity.Money            `json:"netBalance" swaggertype:"string" example:"1800.00"`
+	SpendingByCategory map[string]entity.Money `json:"spendingByCategory" swaggertype:"object,string"`
+	BudgetUsage        map[string]float64      `json:"budgetUsage"`
+	GoalProgress       map[string]float64      `json:"goalProgress"`
+}
+
+// NetWorthAccount é o saldo de uma conta na moeda dela e convertido para a moeda do relatório
+type NetWorthAccount struct {
+	AccountID        string       `json:"accountId"`
+	Name             string       `json:"name"`
+	Type             string       `json:"type" example:"checking"`
+	Currency         string       `json:"currency" example:"USD"`
+	Balance          entity.Money `json:"balance" swaggertype:"string" example:"200.00"`
+	MarketValue      entity.Money `json:"marketValue" swaggertype:"string" example:"0.00"`         // posições a preço de mercado; só em contas de investimento
+	ConvertedBalance entity.Money `json:"convertedBalance" swaggertype:"string" example:"1000.00"` // saldo mais valor de mercado, na moeda do relatório
+}
+
+type NetWorthResponse struct {
+	Currency    string                  `json:"currency" example:"BRL"`
+	Date        time.Time               `json:"date"`
+	Assets      entity.Money            `json:"assets" swaggertype:"string" example:"25000.00"`
+	Liabilities entity.Money            `json:"liabilities" swaggertype:"string" example:"3200.00"` // valor devido, positivo
+	NetWorth    entity.Money            `json:"netWorth" swaggertype:"string" example:"21800.00"`
+	ByType      map[string]entity.Money `json:"byType" swaggertype:"object,string"` // saldo somado por tipo de conta
+	Accounts    []NetWorthAccount       `json:"accounts"`
+}
+
+// NetWorthPoint é o patrimônio no fim do período que começa em Date (no último período, em "to")
+type NetWorthPoint struct {
+	Date        time.Time               `json:"date"`
+	Assets      entity.Money            `json:"assets" swaggertype:"string" example:"25000.00"`
+	Liabilities entity.Money            `json:"liabilities" swaggertype:"string" example:"3200.00"`
+	NetWorth    entity.Money            `json:"netWorth" swaggertype:"string" example:"21800.00"`
+	ByType      map[string]entity.Money `json:"byType" swaggertype:"object,string"`
+}
+
+type NetWorthHistoryResponse struct {
+	Currency string          `json:"currency" example:"BRL"`
+	Interval string          `json:"interval" example:"month"`
+	From     time.Time       `json:"from"`
+	To       time.Time       `json:"to"`
+	Points   []NetWorthPoint `json:"points"`
 }
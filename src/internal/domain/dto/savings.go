@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+// SavingsSettings é o rendimento de uma conta poupança; os juros são lançados todo mês pela rotina de juros
+type SavingsSettings struct {
+	AnnualRate  float64 `json:"annualRate" binding:"min=0,max=1000" example:"6.17"`                    // taxa nominal ao ano, em percentual
+	Compounding string  `json:"compounding" binding:"omitempty,oneof=daily monthly" example:"monthly"` // padrão monthly
+}
+
+type SavingsProjectionRow struct {
+	Date     time.Time    `json:"date"` // data do lançamento dos juros
+	Interest entity.Money `json:"interest" swaggertype:"string" example:"51.42"`
+	Balance  entity.Money `json:"balance" swaggertype:"string" example:"10051.42"`
+}
+
+// SavingsProjectionResponse projeta o saldo da poupança mês a mês, supondo o saldo atual constante e a taxa atual
+type SavingsProjectionResponse struct {
+	AccountID           string                 `json:"accountId"`
+	Currency            string                 `json:"currency"`
+	AnnualRate          float64                `json:"annualRate" example:"6.17"`
+	Compounding         string                 `json:"compounding" example:"monthly"`
+	EffectiveAnnualRate float64                `json:"effectiveAnnualRate" example:"6.3451"` // rendimento em um ano, em percentual
+	AccruedThrough      time.Time              `json:"accruedThrough"`                       // primeiro dia cujos juros ainda não foram lançados
+	Balance             entity.Money           `json:"balance" swaggertype:"string" example:"10000.00"`
+	TotalInterest       entity.Money           `json:"totalInterest" swaggertype:"string" example:"634.51"`
+	FinalBalance        entity.Money           `json:"finalBalance" swaggertype:"string" example:"10634.51"`
+	Months              []SavingsProjectionRow `json:"months"`
+}
@@ -1,36 +1,111 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
 
 type CreateTransactionRequest struct {
-	AccountID   string    `json:"accountId" binding:"required"`
-	CategoryID  string    `json:"categoryId" binding:"required"`
-	Amount      float64   `json:"amount" binding:"required"`
-	Currency    string    `json:"currency" binding:"required,oneof=USD EUR CHF GBP BRL"`
-	Description string    `json:"description"`
-	OccurredAt  time.Time `json:"occurredAt" binding:"required"`
-	Tags        []string  `json:"tags"`
-	Notes       string    `json:"notes"`
+	AccountID    string             `json:"accountId" binding:"required"`
+	CategoryID   string             `json:"categoryId"` // opcional quando uma regra de categorização define a categoria
+	Amount       entity.Money       `json:"amount" binding:"required,minorunits=Currency" swaggertype:"string" example:"120.50"`
+	Currency     string             `json:"currency" binding:"required,currency"`
+	Description  string             `json:"description"`
+	OccurredAt   time.Time          `json:"occurredAt" binding:"required"`
+	Tags         []string           `json:"tags"`
+	Notes        string             `json:"notes"`
+	ExternalRef  string             `json:"externalRef,omitempty" binding:"omitempty,max=200"`                    // reenviar a mesma referência devolve a transação já gravada
+	OnDuplicate  string             `json:"onDuplicate,omitempty" binding:"omitempty,oneof=flag reject allow"`    // possível duplicata: marcar (padrão), recusar ou ignorar
+	SkipRules    bool               `json:"skipRules,omitempty"`                                                  // não aplica as regras de categorização
+	Splits       []TransactionSplit `json:"splits,omitempty" binding:"omitempty,dive"`                            // rateio entre categorias; as linhas devem somar amount
+	Installments int                `json:"installments,omitempty" binding:"omitempty,min=2,max=48" example:"10"` // parcelas mensais; só em contas de crédito
+}
+
+// TransactionSplit é uma linha do rateio; todas as categorias do rateio devem ser do mesmo tipo (receita ou despesa)
+type TransactionSplit struct {
+	CategoryID string       `json:"categoryId" binding:"required"`
+	Amount     entity.Money `json:"amount" binding:"required" swaggertype:"string" example:"80.00"`
+	Note       string       `json:"note,omitempty" binding:"omitempty,max=200"`
 }
 
 type UpdateTransactionRequest struct {
-	CategoryID  *string  `json:"categoryId"`
-	Description *string  `json:"description"`
-	Tags        []string `json:"tags"`
-	Notes       *string  `json:"notes"`
-	Status      *string  `json:"status"`
+	AccountID   *string            `json:"accountId"`
+	CategoryID  *string            `json:"categoryId"`
+	Amount      *entity.Money      `json:"amount" binding:"omitempty,gt=0,minorunits=Currency" swaggertype:"string" example:"120.50"`
+	Currency    *string            `json:"currency" binding:"omitempty,currency"`
+	OccurredAt  *time.Time         `json:"occurredAt"`
+	Description *string            `json:"description"`
+	Tags        []string           `json:"tags"`
+	Notes       *string            `json:"notes"`
+	Status      *string            `json:"status" binding:"omitempty,oneof=pending completed failed"`
+	Splits      []TransactionSplit `json:"splits" binding:"omitempty,dive"` // substitui o rateio; lista vazia remove o rateio
+}
+
+// TransactionFilter reúne os filtros de GET /transactions; campos vazios não filtram
+type TransactionFilter struct {
+	From                 time.Time
+	To                   time.Time
+	IncludeVoided        bool
+	AccountIDs           []string
+	CategoryIDs          []string
+	IncludeSubcategories bool
+	Tags                 []string
+	MatchAllTags         bool
+	MinAmount            *entity.Money
+	MaxAmount            *entity.Money
+	Statuses             []string
+	Currencies           []string
+	Search               string
+	Sort                 string // occurredAt, amount, createdAt ou description; prefixo "-" para ordem decrescente
 }
 
 type TransactionResponse struct {
-	ID          string    `json:"id"`
-	AccountID   string    `json:"accountId"`
-	CategoryID  string    `json:"categoryId"`
-	Amount      float64   `json:"amount"`
-	Currency    string    `json:"currency"`
-	Description string    `json:"description"`
-	OccurredAt  time.Time `json:"occurredAt"`
-	Status      string    `json:"status"`
-	Tags        []string  `json:"tags"`
-	Notes       string    `json:"notes"`
-	ReceiptURL  *string   `json:"receiptUrl"`
+	ID                  string             `json:"id"`
+	AccountID           string             `json:"accountId"`
+	CategoryID          string             `json:"categoryId"`
+	Type                string             `json:"type"`
+	Amount              entity.Money       `json:"amount" swaggertype:"string" example:"120.50"`
+	Currency            string             `json:"currency"`
+	Description         string             `json:"description"`
+	OccurredAt          time.Time          `json:"occurredAt"`
+	Status              string             `json:"status"`
+	Tags                []string           `json:"tags"`
+	Notes               string             `json:"notes"`
+	ReceiptURL          *string            `json:"receiptUrl"`
+	TransferID          string             `json:"transferId,omitempty"`
+	LinkedTransactionID string             `json:"linkedTransactionId,omitempty"`
+	VoidedAt            *time.Time         `json:"voidedAt,omitempty"`
+	VoidReason          string             `json:"voidReason,omitempty"`
+	DuplicateOf         string             `json:"duplicateOf,omitempty"`
+	Splits              []TransactionSplit `json:"splits,omitempty"`
+	Installment         *Installment       `json:"installment,omitempty"`
+	Cleared             bool               `json:"cleared"`
+	ReconciliationID    string             `json:"reconciliationId,omitempty"` // conciliada: não pode mais ser alterada nem anulada
+}
+
+// Installment identifica a parcela k de N de uma compra parcelada
+type Installment struct {
+	GroupID string `json:"groupId"`
+	Number  int    `json:"number" example:"3"`
+	Count   int    `json:"count" example:"10"`
+}
+
+// CancelInstallmentsResponse lista as parcelas futuras anuladas
+type CancelInstallmentsResponse struct {
+	GroupID                 string       `json:"groupId"`
+	CancelledTransactionIDs []string     `json:"cancelledTransactionIds"`
+	Refunded                entity.Money `json:"refunded" swaggertype:"string" example:"700.00"` // soma das parcelas anuladas, devolvida ao saldo do cartão
+}
+
+// DuplicateTransactionResponse mostra a transação suspeita ao lado da original para revisão
+type DuplicateTransactionResponse struct {
+	Transaction *TransactionResponse `json:"transaction"`
+	Original    *TransactionResponse `json:"original,omitempty"`
+}
+
+// TransactionPage é a resposta da paginação por chave; NextCursor vazio indica a última página
+type TransactionPage struct {
+	Items      []*TransactionResponse `json:"items"`
+	NextCursor string                 `json:"nextCursor,omitempty"`
 }
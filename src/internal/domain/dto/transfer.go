@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+// CreateTransferRequest movimenta valores entre duas contas do mesmo usuário.
+// Amount está na moeda da conta de origem; quando as moedas diferem é preciso
+// informar DestinationAmount ou ExchangeRate.
+type CreateTransferRequest struct {
+	FromAccountID     string        `json:"fromAccountId" binding:"required"`
+	ToAccountID       string        `json:"toAccountId" binding:"required,nefield=FromAccountID"`
+	Amount            entity.Money  `json:"amount" binding:"required,gt=0" swaggertype:"string" example:"500.00"`
+	DestinationAmount *entity.Money `json:"destinationAmount" binding:"omitempty,gt=0" swaggertype:"string" example:"92.50"`
+	ExchangeRate      *float64      `json:"exchangeRate" binding:"omitempty,gt=0"`
+	Description       string        `json:"description"`
+	OccurredAt        time.Time     `json:"occurredAt" binding:"required"`
+	Tags              []string      `json:"tags"`
+	Notes             string        `json:"notes"`
+}
+
+type TransferResponse struct {
+	ID           string              `json:"id"`
+	ExchangeRate float64             `json:"exchangeRate"`
+	From         TransactionResponse `json:"from"`
+	To           TransactionResponse `json:"to"`
+}
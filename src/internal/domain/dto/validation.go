@@ -1,12 +1,19 @@
 package dto
 
 import (
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+
 	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
 )
 
+// CurrencyValidationTagName é a tag registrada no validator do Gin para moedas habilitadas
+const CurrencyValidationTagName = "currency"
+
 // CurrencyValidationTag retorna a tag de validação Gin para moedas suportadas
 func CurrencyValidationTag() string {
-	return "oneof=" + entity.SupportedCurrencyCodesString()
+	return CurrencyValidationTagName
 }
 
 // RequiredCurrencyValidationTag retorna a tag de validação Gin para moedas obrigatórias
@@ -18,3 +25,34 @@ func RequiredCurrencyValidationTag() string {
 func OptionalCurrencyValidationTag() string {
 	return "omitempty," + CurrencyValidationTag()
 }
+
+// ValidateCurrencyField aceita apenas códigos ISO 4217 habilitados em entity.EnabledCurrencies
+func ValidateCurrencyField(field validator.FieldLevel) bool {
+	return entity.IsValidCurrency(field.Field().String())
+}
+
+// MinorUnitsValidationTagName é a tag que recusa valores com mais casas decimais que a moeda do campo indicado no
+// parâmetro, como em binding:"minorunits=Currency"
+const MinorUnitsValidationTagName = "minorunits"
+
+// ValidateMinorUnitsField recusa valores com mais casas decimais que a moeda permite (ex.: 1.5 em JPY). Sem moeda
+// no campo indicado, como numa atualização que não troca a moeda, o valor não é verificado aqui
+func ValidateMinorUnitsField(field validator.FieldLevel) bool {
+	currency, kind, _, found := field.GetStructFieldOKAdvanced2(field.Parent(), field.Param())
+	if !found || kind != reflect.String || currency.String() == "" {
+		return true
+	}
+	// Money chega como unidades inteiras, via MoneyValidationValue
+	if field.Field().Kind() != reflect.Int64 {
+		return false
+	}
+	return entity.MoneyFromUnits(field.Field().Int()).FitsDecimals(entity.Currency(currency.String()).MinorUnits())
+}
+
+// MoneyValidationValue expõe Money ao validator como unidades inteiras, permitindo tags como gt=0 e required
+func MoneyValidationValue(field reflect.Value) any {
+	if money, ok := field.Interface().(entity.Money); ok {
+		return money.Units()
+	}
+	return nil
+}
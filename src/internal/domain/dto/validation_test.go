@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type minorUnitsRequest struct {
+	Amount   entity.Money  `binding:"minorunits=Currency"`
+	Currency string        `binding:"omitempty"`
+	Optional *entity.Money `binding:"omitempty,minorunits=Override"`
+	Override *string       `binding:"omitempty"`
+}
+
+// TestValidateMinorUnitsField garante que valores com mais casas decimais que a moeda são recusados
+func TestValidateMinorUnitsField(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+	validate.RegisterCustomTypeFunc(MoneyValidationValue, entity.Money{})
+	if err := validate.RegisterValidation(MinorUnitsValidationTagName, ValidateMinorUnitsField); err != nil {
+		t.Fatalf("não esperava erro ao registrar a validação: %v", err)
+	}
+	jpy := "JPY"
+	fraction := entity.MustParseMoney("1.5")
+
+	tests := []struct {
+		name    string
+		request minorUnitsRequest
+		valid   bool
+	}{
+		{"BRL com centavos", minorUnitsRequest{Amount: entity.MustParseMoney("10.50"), Currency: "BRL"}, true},
+		{"BRL com milésimos", minorUnitsRequest{Amount: entity.MustParseMoney("10.505"), Currency: "BRL"}, false},
+		{"JPY inteiro", minorUnitsRequest{Amount: entity.MoneyFromInt(150), Currency: "JPY"}, true},
+		{"JPY fracionado", minorUnitsRequest{Amount: fraction, Currency: "JPY"}, false},
+		{"sem moeda", minorUnitsRequest{Optional: &fraction}, true},
+		{"opcional em JPY fracionado", minorUnitsRequest{Optional: &fraction, Override: &jpy}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate.Struct(tt.request)
+			if tt.valid && err != nil {
+				t.Fatalf("esperava valor válido, obteve %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatalf("esperava erro de validação")
+			}
+		})
+	}
+}
@@ -5,20 +5,37 @@ import "time"
 type AccountType string
 
 const (
-	AccountTypeChecking AccountType = "checking"
-	AccountTypeSavings  AccountType = "savings"
-	AccountTypeCredit   AccountType = "credit"
-	AccountTypeCash     AccountType = "cash"
+	AccountTypeChecking   AccountType = "checking"
+	AccountTypeSavings    AccountType = "savings"
+	AccountTypeCredit     AccountType = "credit"
+	AccountTypeCash       AccountType = "cash"
+	AccountTypeInvestment AccountType = "investment"
+	AccountTypeLoan       AccountType = "loan"
 )
 
+// IsLiability indica os tipos de conta que representam dívida: o saldo fica negativo enquanto há valor devido
+func (t AccountType) IsLiability() bool {
+	return t == AccountTypeCredit || t == AccountTypeLoan
+}
+
 type Account struct {
-	ID          string      `bson:"_id"`
-	UserID      string      `bson:"user_id"`
-	Name        string      `bson:"name"`
-	Type        AccountType `bson:"type"`
-	Currency    Currency    `bson:"currency"`
-	Balance     float64     `bson:"balance"`
-	Description string      `bson:"description"`
-	CreatedAt   time.Time   `bson:"created_at"`
-	UpdatedAt   time.Time   `bson:"updated_at"`
+	ID          string           `bson:"_id"`
+	UserID      string           `bson:"user_id"`
+	Name        string           `bson:"name"`
+	Type        AccountType      `bson:"type"`
+	Currency    Currency         `bson:"currency"`
+	Balance     Money            `bson:"balance"`
+	Description string           `bson:"description"`
+	CreditCard  *CreditCard      `bson:"credit_card,omitempty"` // ciclo de fatura e limite; só para contas do tipo credit
+	Investment  *Investment      `bson:"investment,omitempty"`  // método de custo das posições; só para contas do tipo investment
+	Loan        *Loan            `bson:"loan,omitempty"`        // termos e saldo devedor; só para contas do tipo loan
+	Savings     *SavingsInterest `bson:"savings,omitempty"`     // taxa e capitalização dos juros; só para contas do tipo savings
+	ClosedAt    *time.Time       `bson:"closed_at,omitempty"`   // conta encerrada (arquivada): some das listagens, mas continua no histórico e nos relatórios
+	CreatedAt   time.Time        `bson:"created_at"`
+	UpdatedAt   time.Time        `bson:"updated_at"`
+}
+
+// IsClosed indica se a conta foi encerrada; contas encerradas não recebem novas transações
+func (a *Account) IsClosed() bool {
+	return a.ClosedAt != nil
 }
@@ -0,0 +1,84 @@
+package entity
+
+import "time"
+
+// BalanceInterval agrupa o histórico de saldo por dia, semana (de segunda a domingo) ou mês, sempre em UTC
+type BalanceInterval string
+
+const (
+	BalanceIntervalDay   BalanceInterval = "day"
+	BalanceIntervalWeek  BalanceInterval = "week"
+	BalanceIntervalMonth BalanceInterval = "month"
+)
+
+func (i BalanceInterval) IsValid() bool {
+	return i == BalanceIntervalDay || i == BalanceIntervalWeek || i == BalanceIntervalMonth
+}
+
+// PeriodStart devolve o início do período que contém at
+func (i BalanceInterval) PeriodStart(at time.Time) time.Time {
+	day := StartOfDay(at)
+	switch i {
+	case BalanceIntervalWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case BalanceIntervalMonth:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// Next devolve o início do período seguinte ao que começa em start
+func (i BalanceInterval) Next(start time.Time) time.Time {
+	switch i {
+	case BalanceIntervalWeek:
+		return start.AddDate(0, 0, 7)
+	case BalanceIntervalMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// StartOfDay trunca at para a meia-noite UTC
+func StartOfDay(at time.Time) time.Time {
+	at = at.UTC()
+	return time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// BalanceSnapshot guarda o saldo da conta no fim de Date. Os snapshots são gravados pela rotina diária e
+// descartados quando uma transação com data até o dia é gravada, alterada ou anulada
+type BalanceSnapshot struct {
+	ID        string    `bson:"_id"`
+	UserID    string    `bson:"user_id"`
+	AccountID string    `bson:"account_id"`
+	Date      time.Time `bson:"date"` // meia-noite UTC do dia
+	Balance   Money     `bson:"balance"`
+	Currency  Currency  `bson:"currency"`
+	TakenAt   time.Time `bson:"taken_at"`
+}
+
+// BalanceSnapshotID identifica o snapshot pela conta e pelo dia, para que gravar de novo substitua o anterior
+func BalanceSnapshotID(accountID string, date time.Time) string {
+	return accountID + ":" + StartOfDay(date).Format("2006-01-02")
+}
+
+// ClosesAt devolve o instante em que o saldo do snapshot vale: o início do dia seguinte
+func (s *BalanceSnapshot) ClosesAt() time.Time {
+	return s.Date.AddDate(0, 0, 1)
+}
+
+// BalanceBefore devolve o saldo imediatamente antes de at, desfazendo sobre o saldo em closing (o saldo depois
+// de todas as transações informadas) o efeito das transações com data a partir de at. As transações precisam ter
+// o tipo preenchido: registros antigos sem tipo têm de ser resolvidos pela categoria antes
+func BalanceBefore(closing Money, transactions []*Transaction, at time.Time) Money {
+	balance := closing
+	for _, transaction := range transactions {
+		if transaction.Status == TransactionStatusVoided || transaction.OccurredAt.Before(at) {
+			continue
+		}
+		balance = balance.Sub(transaction.Type.BalanceEffect(transaction.Amount))
+	}
+	return balance
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBalanceIntervalPeriods(t *testing.T) {
+	// 2024-03-14 é uma quinta-feira
+	at := time.Date(2024, 3, 14, 18, 30, 0, 0, time.UTC)
+	cases := []struct {
+		interval BalanceInterval
+		start    time.Time
+		next     time.Time
+	}{
+		{BalanceIntervalDay, time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{BalanceIntervalWeek, time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC)},
+		{BalanceIntervalMonth, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		start := tc.interval.PeriodStart(at)
+		if !start.Equal(tc.start) || !tc.interval.Next(start).Equal(tc.next) {
+			t.Errorf("%s: período inesperado %s a %s", tc.interval, start, tc.interval.Next(start))
+		}
+	}
+	if BalanceInterval("year").IsValid() {
+		t.Fatalf("intervalo desconhecido deveria ser inválido")
+	}
+}
+
+func TestBalanceBefore(t *testing.T) {
+	transactions := []*Transaction{
+		{Type: TransactionTypeIncome, Amount: MustParseMoney("300.00"), OccurredAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)},
+		{Type: TransactionTypeExpense, Amount: MustParseMoney("50.00"), OccurredAt: time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC)},
+		{Type: TransactionTypeExpense, Amount: MustParseMoney("999.00"), OccurredAt: time.Date(2024, 3, 2, 11, 0, 0, 0, time.UTC), Status: TransactionStatusVoided},
+	}
+	closing := MustParseMoney("1250.00")
+
+	if balance := BalanceBefore(closing, transactions, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)); balance.Cmp(MustParseMoney("1300.00")) != 0 {
+		t.Fatalf("saldo no início de 2 de março inesperado: %s", balance)
+	}
+	if balance := BalanceBefore(closing, transactions, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)); balance.Cmp(MustParseMoney("1000.00")) != 0 {
+		t.Fatalf("saldo no início de 1º de março inesperado: %s", balance)
+	}
+}
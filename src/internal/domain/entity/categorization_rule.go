@@ -0,0 +1,157 @@
+package entity
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RuleConditions reúne os critérios de uma regra; todos os critérios preenchidos precisam ser atendidos
+type RuleConditions struct {
+	DescriptionContains string   `bson:"description_contains,omitempty"` // comparado sem acentos, caixa ou pontuação
+	DescriptionRegex    string   `bson:"description_regex,omitempty"`    // sem diferenciar maiúsculas de minúsculas
+	MinAmount           *Money   `bson:"min_amount,omitempty"`
+	MaxAmount           *Money   `bson:"max_amount,omitempty"`
+	AccountIDs          []string `bson:"account_ids,omitempty"` // qualquer uma das contas
+	Tags                []string `bson:"tags,omitempty"`        // todas as tags
+}
+
+// RuleActions descreve o que a regra altera na transação
+type RuleActions struct {
+	CategoryID  string   `bson:"category_id,omitempty"`
+	AddTags     []string `bson:"add_tags,omitempty"`
+	Description string   `bson:"description,omitempty"` // nova descrição
+}
+
+// CategorizationRule categoriza transações automaticamente; regras com Priority menor são avaliadas primeiro
+type CategorizationRule struct {
+	ID         string         `bson:"_id"`
+	UserID     string         `bson:"user_id"`
+	Name       string         `bson:"name"`
+	Priority   int            `bson:"priority"`
+	Enabled    bool           `bson:"enabled"`
+	Conditions RuleConditions `bson:"conditions"`
+	Actions    RuleActions    `bson:"actions"`
+	CreatedAt  time.Time      `bson:"created_at"`
+	UpdatedAt  time.Time      `bson:"updated_at"`
+
+	// pattern guarda DescriptionRegex compilada; patternSource diz de qual expressão ela veio, para recompilar
+	// quando as condições mudam
+	pattern       *regexp.Regexp
+	patternSource string
+}
+
+// IsValid exige ao menos um critério e uma ação, expressão regular válida e faixa de valores coerente
+func (r *CategorizationRule) IsValid() bool {
+	if strings.TrimSpace(r.Name) == "" || r.Priority < 0 {
+		return false
+	}
+	c := r.Conditions
+	if c.DescriptionContains == "" && c.DescriptionRegex == "" && c.MinAmount == nil && c.MaxAmount == nil &&
+		len(c.AccountIDs) == 0 && len(c.Tags) == 0 {
+		return false
+	}
+	if c.DescriptionRegex != "" {
+		if _, err := r.descriptionPattern(); err != nil {
+			return false
+		}
+	}
+	if c.MinAmount != nil && c.MaxAmount != nil && c.MinAmount.Cmp(*c.MaxAmount) > 0 {
+		return false
+	}
+	a := r.Actions
+	return a.CategoryID != "" || len(a.AddTags) > 0 || strings.TrimSpace(a.Description) != ""
+}
+
+// descriptionPattern compila a expressão da regra uma única vez; Matches roda para cada transação avaliada
+func (r *CategorizationRule) descriptionPattern() (*regexp.Regexp, error) {
+	if r.pattern != nil && r.patternSource == r.Conditions.DescriptionRegex {
+		return r.pattern, nil
+	}
+	pattern, err := regexp.Compile("(?i)" + r.Conditions.DescriptionRegex)
+	if err != nil {
+		return nil, err
+	}
+	r.pattern = pattern
+	r.patternSource = r.Conditions.DescriptionRegex
+	return pattern, nil
+}
+
+// Matches indica se a transação atende a todos os critérios da regra
+func (r *CategorizationRule) Matches(t *Transaction) bool {
+	c := r.Conditions
+	if c.DescriptionContains != "" &&
+		!strings.Contains(NormalizeDescription(t.Description), NormalizeDescription(c.DescriptionContains)) {
+		return false
+	}
+	if c.DescriptionRegex != "" {
+		pattern, err := r.descriptionPattern()
+		if err != nil || !pattern.MatchString(t.Description) {
+			return false
+		}
+	}
+	if c.MinAmount != nil && t.Amount.Cmp(*c.MinAmount) < 0 {
+		return false
+	}
+	if c.MaxAmount != nil && t.Amount.Cmp(*c.MaxAmount) > 0 {
+		return false
+	}
+	if len(c.AccountIDs) > 0 && !containsString(c.AccountIDs, t.AccountID) {
+		return false
+	}
+	for _, tag := range c.Tags {
+		if !containsString(t.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyCategorizationRules aplica as regras habilitadas em ordem de prioridade e devolve as que casaram.
+// Os critérios são avaliados sobre a transação original, então uma regra não dispara outra; categoria e
+// descrição vêm da primeira regra que as define e a categoria só é preenchida se a transação não tiver uma.
+func ApplyCategorizationRules(rules []*CategorizationRule, t *Transaction) []*CategorizationRule {
+	ordered := make([]*CategorizationRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Enabled {
+			ordered = append(ordered, rule)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Priority < ordered[j].Priority
+	})
+
+	original := *t
+	original.Tags = append([]string(nil), t.Tags...)
+	renamed := false
+	var matched []*CategorizationRule
+	for _, rule := range ordered {
+		if !rule.Matches(&original) {
+			continue
+		}
+		matched = append(matched, rule)
+		if t.CategoryID == "" {
+			t.CategoryID = rule.Actions.CategoryID
+		}
+		if description := strings.TrimSpace(rule.Actions.Description); description != "" && !renamed {
+			t.Description = description
+			renamed = true
+		}
+		for _, tag := range rule.Actions.AddTags {
+			if !containsString(t.Tags, tag) {
+				t.Tags = append(t.Tags, tag)
+			}
+		}
+	}
+	return matched
+}
+
+func containsString(values []string, value string) bool {
+	for _, current := range values {
+		if current == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package entity
+
+import "testing"
+
+func TestCategorizationRuleMatches(t *testing.T) {
+	minimum := MoneyFromInt(10)
+	maximum := MoneyFromInt(100)
+	transaction := &Transaction{AccountID: "acc", Amount: MoneyFromInt(50), Description: "PAG*Padaria São João", Tags: []string{"casa", "fixo"}}
+
+	tests := []struct {
+		name       string
+		conditions RuleConditions
+		expected   bool
+	}{
+		{"contém sem acento", RuleConditions{DescriptionContains: "padaria sao"}, true},
+		{"regex sem caixa", RuleConditions{DescriptionRegex: `^pag\*padaria`}, true},
+		{"regex sem casar", RuleConditions{DescriptionRegex: `^uber`}, false},
+		{"faixa de valor", RuleConditions{MinAmount: &minimum, MaxAmount: &maximum}, true},
+		{"abaixo do mínimo", RuleConditions{MinAmount: &maximum}, false},
+		{"outra conta", RuleConditions{AccountIDs: []string{"other"}}, false},
+		{"todas as tags", RuleConditions{Tags: []string{"casa", "fixo"}}, true},
+		{"tag ausente", RuleConditions{Tags: []string{"casa", "viagem"}}, false},
+		{"critérios combinados", RuleConditions{DescriptionContains: "padaria", AccountIDs: []string{"acc"}, MaxAmount: &minimum}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &CategorizationRule{Conditions: tt.conditions}
+			if got := rule.Matches(transaction); got != tt.expected {
+				t.Errorf("Matches() = %v, esperado %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestCategorizationRuleCompilaRegexUmaVez garante que a expressão é reaproveitada entre chamadas e recompilada
+// quando a condição muda
+func TestCategorizationRuleCompilaRegexUmaVez(t *testing.T) {
+	rule := &CategorizationRule{Conditions: RuleConditions{DescriptionRegex: `^uber`}}
+	if !rule.Matches(&Transaction{Description: "UBER *TRIP"}) {
+		t.Fatalf("regra deveria casar")
+	}
+	compiled := rule.pattern
+	if !rule.Matches(&Transaction{Description: "Uber Eats"}) || rule.pattern != compiled {
+		t.Fatalf("a expressão deveria ser compilada uma única vez")
+	}
+
+	rule.Conditions.DescriptionRegex = `^99`
+	if rule.Matches(&Transaction{Description: "UBER *TRIP"}) || !rule.Matches(&Transaction{Description: "99 POP"}) {
+		t.Fatalf("a regra deveria usar a expressão nova")
+	}
+}
+
+func TestApplyCategorizationRulesPrioridade(t *testing.T) {
+	rules := []*CategorizationRule{
+		{Name: "genérica", Priority: 5, Enabled: true, Conditions: RuleConditions{DescriptionContains: "mercado"}, Actions: RuleActions{CategoryID: "general", Description: "Mercado"}},
+		{Name: "desabilitada", Priority: 0, Enabled: false, Conditions: RuleConditions{DescriptionContains: "mercado"}, Actions: RuleActions{CategoryID: "disabled"}},
+		{Name: "específica", Priority: 1, Enabled: true, Conditions: RuleConditions{DescriptionContains: "mercado livre"}, Actions: RuleActions{CategoryID: "shopping", AddTags: []string{"online"}}},
+		{Name: "encadeada", Priority: 9, Enabled: true, Conditions: RuleConditions{Tags: []string{"online"}}, Actions: RuleActions{AddTags: []string{"nunca"}}},
+	}
+	transaction := &Transaction{Description: "MERCADO LIVRE *LOJA"}
+
+	matched := ApplyCategorizationRules(rules, transaction)
+	if len(matched) != 2 || matched[0].Name != "específica" || matched[1].Name != "genérica" {
+		t.Fatalf("regras aplicadas fora de ordem: %v", matched)
+	}
+	if transaction.CategoryID != "shopping" || transaction.Description != "Mercado" {
+		t.Fatalf("categoria deveria vir da regra de maior prioridade: %+v", transaction)
+	}
+	if len(transaction.Tags) != 1 || transaction.Tags[0] != "online" {
+		t.Fatalf("tags adicionadas por uma regra não deveriam disparar outra: %v", transaction.Tags)
+	}
+}
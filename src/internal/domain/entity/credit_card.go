@@ -0,0 +1,81 @@
+package entity
+
+import "time"
+
+// CreditCard guarda o ciclo de fatura de uma conta de cartão de crédito. O saldo da conta fica negativo
+// enquanto há compras em aberto, e os pagamentos de fatura são transferências para a conta do cartão.
+type CreditCard struct {
+	ClosingDay  int   `bson:"closing_day"` // dias inexistentes no mês caem no último dia
+	DueDay      int   `bson:"due_day"`
+	CreditLimit Money `bson:"credit_limit"`
+}
+
+// IsValid exige dias entre 1 e 31 e limite não negativo
+func (c CreditCard) IsValid() bool {
+	return c.ClosingDay >= 1 && c.ClosingDay <= 31 && c.DueDay >= 1 && c.DueDay <= 31 && !c.CreditLimit.IsNegative()
+}
+
+// AvailableCredit desconta do limite o saldo devedor; saldo positivo (pagamento a maior) aumenta o disponível
+func (c CreditCard) AvailableCredit(balance Money) Money {
+	return c.CreditLimit.Add(balance)
+}
+
+// Statement é uma fatura: reúne as compras de Start (inclusive) até ClosingDate (exclusive)
+type Statement struct {
+	Start       time.Time
+	ClosingDate time.Time
+	DueDate     time.Time
+}
+
+// Contains indica se uma transação feita em at entra nesta fatura
+func (s Statement) Contains(at time.Time) bool {
+	return !at.Before(s.Start) && at.Before(s.ClosingDate)
+}
+
+// StatementFor devolve a fatura em que entra uma compra feita em at; compras a partir do dia de fechamento
+// vão para a fatura seguinte
+func (c CreditCard) StatementFor(at time.Time) Statement {
+	at = at.UTC()
+	closing := c.closingDate(at.Year(), at.Month(), 0)
+	if !at.Before(closing) {
+		closing = c.closingDate(at.Year(), at.Month(), 1)
+	}
+	return Statement{
+		Start:       c.closingDate(closing.Year(), closing.Month(), -1),
+		ClosingDate: closing,
+		DueDate:     c.dueDate(closing),
+	}
+}
+
+// NextStatement devolve a fatura seguinte a s
+func (c CreditCard) NextStatement(s Statement) Statement {
+	return c.StatementFor(s.ClosingDate)
+}
+
+// PreviousStatement devolve a fatura anterior a s
+func (c CreditCard) PreviousStatement(s Statement) Statement {
+	return c.StatementFor(s.Start.AddDate(0, 0, -1))
+}
+
+// closingDate devolve o fechamento do mês deslocado em offset meses
+func (c CreditCard) closingDate(year int, month time.Month, offset int) time.Time {
+	return dayOfMonth(year, month+time.Month(offset), c.ClosingDay)
+}
+
+// dueDate devolve o primeiro dia de vencimento depois do fechamento
+func (c CreditCard) dueDate(closing time.Time) time.Time {
+	due := dayOfMonth(closing.Year(), closing.Month(), c.DueDay)
+	if !due.After(closing) {
+		due = dayOfMonth(closing.Year(), closing.Month()+1, c.DueDay)
+	}
+	return due
+}
+
+// dayOfMonth normaliza o mês (13 vira janeiro do ano seguinte) e limita o dia ao último dia do mês
+func dayOfMonth(year int, month time.Month, day int) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	if last := daysIn(first.Year(), first.Month()); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCreditCardStatementFor(t *testing.T) {
+	card := CreditCard{ClosingDay: 25, DueDay: 5, CreditLimit: MoneyFromInt(5000)}
+
+	statement := card.StatementFor(time.Date(2024, 3, 24, 23, 0, 0, 0, time.UTC))
+	if !statement.Start.Equal(date(2024, 2, 25)) || !statement.ClosingDate.Equal(date(2024, 3, 25)) || !statement.DueDate.Equal(date(2024, 4, 5)) {
+		t.Fatalf("fatura inesperada: %+v", statement)
+	}
+
+	// Compras no dia do fechamento já entram na fatura seguinte
+	next := card.StatementFor(date(2024, 3, 25))
+	if !next.Start.Equal(date(2024, 3, 25)) || !next.ClosingDate.Equal(date(2024, 4, 25)) || next != card.NextStatement(statement) {
+		t.Fatalf("fatura seguinte inesperada: %+v", next)
+	}
+	if card.PreviousStatement(next) != statement {
+		t.Fatalf("fatura anterior deveria ser a de março: %+v", card.PreviousStatement(next))
+	}
+
+	// Virada de ano
+	december := card.StatementFor(date(2024, 12, 30))
+	if !december.ClosingDate.Equal(date(2025, 1, 25)) || !december.DueDate.Equal(date(2025, 2, 5)) {
+		t.Fatalf("fatura de dezembro inesperada: %+v", december)
+	}
+}
+
+func TestCreditCardStatementDiaInexistente(t *testing.T) {
+	card := CreditCard{ClosingDay: 31, DueDay: 10}
+
+	statement := card.StatementFor(date(2024, 2, 15))
+	if !statement.Start.Equal(date(2024, 1, 31)) || !statement.ClosingDate.Equal(date(2024, 2, 29)) || !statement.DueDate.Equal(date(2024, 3, 10)) {
+		t.Fatalf("fechamento deveria cair no último dia de fevereiro: %+v", statement)
+	}
+	if !statement.Contains(date(2024, 1, 31)) || statement.Contains(date(2024, 2, 29)) {
+		t.Fatalf("período da fatura deveria incluir o início e excluir o fechamento")
+	}
+
+	// Vencimento no mesmo mês quando o dia é posterior ao fechamento
+	sameMonth := CreditCard{ClosingDay: 3, DueDay: 10}.StatementFor(date(2024, 5, 1))
+	if !sameMonth.DueDate.Equal(date(2024, 5, 10)) {
+		t.Fatalf("vencimento inesperado: %v", sameMonth.DueDate)
+	}
+}
+
+func TestCreditCardAvailableCredit(t *testing.T) {
+	card := CreditCard{ClosingDay: 1, DueDay: 10, CreditLimit: MoneyFromInt(1000)}
+	if available := card.AvailableCredit(MoneyFromInt(-300)); available.Cmp(MoneyFromInt(700)) != 0 {
+		t.Fatalf("limite disponível inesperado: %s", available)
+	}
+	if !card.IsValid() || (CreditCard{ClosingDay: 32, DueDay: 1}).IsValid() || (CreditCard{ClosingDay: 1, DueDay: 1, CreditLimit: MoneyFromInt(-1)}).IsValid() {
+		t.Fatalf("validação do cartão inesperada")
+	}
+}
@@ -2,7 +2,9 @@ package entity
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 )
 
 type Currency string
@@ -15,7 +17,36 @@ const (
 	CurrencyBRL Currency = "BRL" // Real Brasileiro
 )
 
-// SupportedCurrencies lista todas as moedas suportadas pelo sistema
+const (
+	LocaleEnglish    = "en"
+	LocalePortuguese = "pt-BR"
+)
+
+// CurrencyInfo descreve uma moeda do catálogo ISO 4217
+type CurrencyInfo struct {
+	Code       Currency
+	Numeric    string
+	MinorUnits int
+	Symbol     string
+	Names      map[string]string
+}
+
+// LocalizedName devolve o nome no idioma pedido (en, pt-BR), com inglês como padrão
+func (i CurrencyInfo) LocalizedName(locale string) string {
+	if name, ok := i.Names[locale]; ok {
+		return name
+	}
+	if strings.HasPrefix(strings.ToLower(locale), "pt") {
+		return i.Names[LocalePortuguese]
+	}
+	return i.Names[LocaleEnglish]
+}
+
+func localizedNames(english string, portuguese string) map[string]string {
+	return map[string]string{LocaleEnglish: english, LocalePortuguese: portuguese}
+}
+
+// SupportedCurrencies lista as moedas habilitadas por padrão quando a configuração não define outras
 var SupportedCurrencies = []Currency{
 	CurrencyUSD,
 	CurrencyEUR,
@@ -24,26 +55,95 @@ var SupportedCurrencies = []Currency{
 	CurrencyBRL,
 }
 
-// SupportedCurrencyCodes retorna os códigos das moedas suportadas como slice de strings
+var (
+	isoCurrencyIndex = indexCurrencies(isoCurrencies)
+
+	enabledMu         sync.RWMutex
+	enabledCurrencies = SupportedCurrencies
+)
+
+func indexCurrencies(catalog []CurrencyInfo) map[Currency]CurrencyInfo {
+	index := make(map[Currency]CurrencyInfo, len(catalog))
+	for _, info := range catalog {
+		index[info.Code] = info
+	}
+	return index
+}
+
+// ISOCurrencies devolve o catálogo ISO 4217 completo ordenado por código
+func ISOCurrencies() []CurrencyInfo {
+	catalog := make([]CurrencyInfo, len(isoCurrencies))
+	copy(catalog, isoCurrencies)
+	sort.Slice(catalog, func(i, j int) bool { return catalog[i].Code < catalog[j].Code })
+	return catalog
+}
+
+// LookupCurrency busca a moeda no catálogo ISO 4217
+func LookupCurrency(currencyCode string) (CurrencyInfo, bool) {
+	info, ok := isoCurrencyIndex[Currency(currencyCode)]
+	return info, ok
+}
+
+// IsISOCurrency verifica se o código existe no catálogo ISO 4217, habilitado ou não
+func IsISOCurrency(currencyCode string) bool {
+	_, ok := LookupCurrency(currencyCode)
+	return ok
+}
+
+// SetEnabledCurrencies define as moedas aceitas pela aplicação (configuração currencies.enabled).
+// Lista vazia restaura SupportedCurrencies; códigos fora do catálogo ISO 4217 são rejeitados.
+func SetEnabledCurrencies(currencyCodes []string) error {
+	enabled := make([]Currency, 0, len(currencyCodes))
+	seen := map[Currency]bool{}
+	for _, code := range currencyCodes {
+		currency := Currency(strings.ToUpper(strings.TrimSpace(code)))
+		if !IsISOCurrency(string(currency)) {
+			return fmt.Errorf("currency '%s' is not an ISO 4217 code", code)
+		}
+		if !seen[currency] {
+			seen[currency] = true
+			enabled = append(enabled, currency)
+		}
+	}
+	if len(enabled) == 0 {
+		enabled = SupportedCurrencies
+	}
+
+	enabledMu.Lock()
+	defer enabledMu.Unlock()
+	enabledCurrencies = enabled
+	return nil
+}
+
+// EnabledCurrencies devolve as moedas habilitadas na ordem configurada
+func EnabledCurrencies() []Currency {
+	enabledMu.RLock()
+	defer enabledMu.RUnlock()
+	currencies := make([]Currency, len(enabledCurrencies))
+	copy(currencies, enabledCurrencies)
+	return currencies
+}
+
+// SupportedCurrencyCodes retorna os códigos das moedas habilitadas como slice de strings
 func SupportedCurrencyCodes() []string {
-	codes := make([]string, len(SupportedCurrencies))
-	for i, currency := range SupportedCurrencies {
+	currencies := EnabledCurrencies()
+	codes := make([]string, len(currencies))
+	for i, currency := range currencies {
 		codes[i] = string(currency)
 	}
 	return codes
 }
 
-// SupportedCurrencyCodesString retorna os códigos das moedas suportadas como string separada por espaços
-// Usado para validação Gin binding (oneof=USD EUR CHF GBP BRL)
+// SupportedCurrencyCodesString retorna os códigos das moedas habilitadas como string separada por espaços
 func SupportedCurrencyCodesString() string {
 	return strings.Join(SupportedCurrencyCodes(), " ")
 }
 
-// IsValidCurrency verifica se uma string representa uma moeda válida
+// IsValidCurrency verifica se uma string representa uma moeda habilitada
 func IsValidCurrency(currencyCode string) bool {
 	currency := Currency(currencyCode)
-	for _, supported := range SupportedCurrencies {
-		if currency == supported {
+	for _, enabled := range EnabledCurrencies() {
+		if currency == enabled {
 			return true
 		}
 	}
@@ -63,20 +163,23 @@ func (c Currency) String() string {
 	return string(c)
 }
 
-// GetCurrencyName retorna o nome completo da moeda
+// Info devolve os metadados ISO 4217 da moeda
+func (c Currency) Info() (CurrencyInfo, bool) {
+	return LookupCurrency(string(c))
+}
+
+// MinorUnits devolve a quantidade de casas decimais da moeda (2 quando desconhecida)
+func (c Currency) MinorUnits() int {
+	if info, ok := c.Info(); ok {
+		return info.MinorUnits
+	}
+	return 2
+}
+
+// GetCurrencyName retorna o nome completo da moeda em inglês
 func (c Currency) GetCurrencyName() string {
-	switch c {
-	case CurrencyUSD:
-		return "United States Dollar"
-	case CurrencyEUR:
-		return "Euro"
-	case CurrencyCHF:
-		return "Swiss Franc"
-	case CurrencyGBP:
-		return "Pound Sterling"
-	case CurrencyBRL:
-		return "Brazilian Real"
-	default:
-		return "Unknown Currency"
+	if info, ok := c.Info(); ok {
+		return info.LocalizedName(LocaleEnglish)
 	}
+	return "Unknown Currency"
 }
@@ -0,0 +1,164 @@
+package entity
+
+// isoCurrencies é o catálogo ISO 4217 de moedas em circulação (exclui metais, fundos e códigos de teste,
+// com exceção das unidades de conta CLF e UYW). Nomes em inglês seguem a norma; símbolos são os de uso local.
+var isoCurrencies = []CurrencyInfo{
+	{Code: "AED", Numeric: "784", MinorUnits: 2, Symbol: "د.إ", Names: localizedNames("UAE Dirham", "Dirham dos Emirados Árabes Unidos")},
+	{Code: "AFN", Numeric: "971", MinorUnits: 2, Symbol: "؋", Names: localizedNames("Afghani", "Afegane afegão")},
+	{Code: "ALL", Numeric: "008", MinorUnits: 2, Symbol: "L", Names: localizedNames("Lek", "Lek albanês")},
+	{Code: "AMD", Numeric: "051", MinorUnits: 2, Symbol: "֏", Names: localizedNames("Armenian Dram", "Dram armênio")},
+	{Code: "AOA", Numeric: "973", MinorUnits: 2, Symbol: "Kz", Names: localizedNames("Kwanza", "Kwanza angolano")},
+	{Code: "ARS", Numeric: "032", MinorUnits: 2, Symbol: "$", Names: localizedNames("Argentine Peso", "Peso argentino")},
+	{Code: "AUD", Numeric: "036", MinorUnits: 2, Symbol: "A$", Names: localizedNames("Australian Dollar", "Dólar australiano")},
+	{Code: "AWG", Numeric: "533", MinorUnits: 2, Symbol: "ƒ", Names: localizedNames("Aruban Florin", "Florim arubano")},
+	{Code: "AZN", Numeric: "944", MinorUnits: 2, Symbol: "₼", Names: localizedNames("Azerbaijan Manat", "Manat azeri")},
+	{Code: "BAM", Numeric: "977", MinorUnits: 2, Symbol: "KM", Names: localizedNames("Convertible Mark", "Marco conversível")},
+	{Code: "BBD", Numeric: "052", MinorUnits: 2, Symbol: "Bds$", Names: localizedNames("Barbados Dollar", "Dólar de Barbados")},
+	{Code: "BDT", Numeric: "050", MinorUnits: 2, Symbol: "৳", Names: localizedNames("Taka", "Taka de Bangladesh")},
+	{Code: "BGN", Numeric: "975", MinorUnits: 2, Symbol: "лв", Names: localizedNames("Bulgarian Lev", "Lev búlgaro")},
+	{Code: "BHD", Numeric: "048", MinorUnits: 3, Symbol: "BD", Names: localizedNames("Bahraini Dinar", "Dinar bareinita")},
+	{Code: "BIF", Numeric: "108", MinorUnits: 0, Symbol: "FBu", Names: localizedNames("Burundi Franc", "Franco do Burundi")},
+	{Code: "BMD", Numeric: "060", MinorUnits: 2, Symbol: "$", Names: localizedNames("Bermudian Dollar", "Dólar bermudense")},
+	{Code: "BND", Numeric: "096", MinorUnits: 2, Symbol: "B$", Names: localizedNames("Brunei Dollar", "Dólar de Brunei")},
+	{Code: "BOB", Numeric: "068", MinorUnits: 2, Symbol: "Bs.", Names: localizedNames("Boliviano", "Boliviano")},
+	{Code: "BRL", Numeric: "986", MinorUnits: 2, Symbol: "R$", Names: localizedNames("Brazilian Real", "Real brasileiro")},
+	{Code: "BSD", Numeric: "044", MinorUnits: 2, Symbol: "B$", Names: localizedNames("Bahamian Dollar", "Dólar bahamense")},
+	{Code: "BTN", Numeric: "064", MinorUnits: 2, Symbol: "Nu.", Names: localizedNames("Ngultrum", "Ngultrum butanês")},
+	{Code: "BWP", Numeric: "072", MinorUnits: 2, Symbol: "P", Names: localizedNames("Pula", "Pula de Botsuana")},
+	{Code: "BYN", Numeric: "933", MinorUnits: 2, Symbol: "Br", Names: localizedNames("Belarusian Ruble", "Rublo bielorrusso")},
+	{Code: "BZD", Numeric: "084", MinorUnits: 2, Symbol: "BZ$", Names: localizedNames("Belize Dollar", "Dólar de Belize")},
+	{Code: "CAD", Numeric: "124", MinorUnits: 2, Symbol: "C$", Names: localizedNames("Canadian Dollar", "Dólar canadense")},
+	{Code: "CDF", Numeric: "976", MinorUnits: 2, Symbol: "FC", Names: localizedNames("Congolese Franc", "Franco congolês")},
+	{Code: "CHF", Numeric: "756", MinorUnits: 2, Symbol: "CHF", Names: localizedNames("Swiss Franc", "Franco suíço")},
+	{Code: "CLF", Numeric: "990", MinorUnits: 4, Symbol: "UF", Names: localizedNames("Unidad de Fomento", "Unidade de fomento chilena")},
+	{Code: "CLP", Numeric: "152", MinorUnits: 0, Symbol: "$", Names: localizedNames("Chilean Peso", "Peso chileno")},
+	{Code: "CNY", Numeric: "156", MinorUnits: 2, Symbol: "¥", Names: localizedNames("Yuan Renminbi", "Yuan chinês")},
+	{Code: "COP", Numeric: "170", MinorUnits: 2, Symbol: "$", Names: localizedNames("Colombian Peso", "Peso colombiano")},
+	{Code: "CRC", Numeric: "188", MinorUnits: 2, Symbol: "₡", Names: localizedNames("Costa Rican Colon", "Colón costarriquenho")},
+	{Code: "CUP", Numeric: "192", MinorUnits: 2, Symbol: "$", Names: localizedNames("Cuban Peso", "Peso cubano")},
+	{Code: "CVE", Numeric: "132", MinorUnits: 2, Symbol: "Esc", Names: localizedNames("Cabo Verde Escudo", "Escudo cabo-verdiano")},
+	{Code: "CZK", Numeric: "203", MinorUnits: 2, Symbol: "Kč", Names: localizedNames("Czech Koruna", "Coroa tcheca")},
+	{Code: "DJF", Numeric: "262", MinorUnits: 0, Symbol: "Fdj", Names: localizedNames("Djibouti Franc", "Franco do Djibuti")},
+	{Code: "DKK", Numeric: "208", MinorUnits: 2, Symbol: "kr", Names: localizedNames("Danish Krone", "Coroa dinamarquesa")},
+	{Code: "DOP", Numeric: "214", MinorUnits: 2, Symbol: "RD$", Names: localizedNames("Dominican Peso", "Peso dominicano")},
+	{Code: "DZD", Numeric: "012", MinorUnits: 2, Symbol: "DA", Names: localizedNames("Algerian Dinar", "Dinar argelino")},
+	{Code: "EGP", Numeric: "818", MinorUnits: 2, Symbol: "E£", Names: localizedNames("Egyptian Pound", "Libra egípcia")},
+	{Code: "ERN", Numeric: "232", MinorUnits: 2, Symbol: "Nfk", Names: localizedNames("Nakfa", "Nakfa eritreia")},
+	{Code: "ETB", Numeric: "230", MinorUnits: 2, Symbol: "Br", Names: localizedNames("Ethiopian Birr", "Birr etíope")},
+	{Code: "EUR", Numeric: "978", MinorUnits: 2, Symbol: "€", Names: localizedNames("Euro", "Euro")},
+	{Code: "FJD", Numeric: "242", MinorUnits: 2, Symbol: "FJ$", Names: localizedNames("Fiji Dollar", "Dólar fijiano")},
+	{Code: "FKP", Numeric: "238", MinorUnits: 2, Symbol: "£", Names: localizedNames("Falkland Islands Pound", "Libra das Malvinas")},
+	{Code: "GBP", Numeric: "826", MinorUnits: 2, Symbol: "£", Names: localizedNames("Pound Sterling", "Libra esterlina")},
+	{Code: "GEL", Numeric: "981", MinorUnits: 2, Symbol: "₾", Names: localizedNames("Lari", "Lari georgiano")},
+	{Code: "GHS", Numeric: "936", MinorUnits: 2, Symbol: "GH₵", Names: localizedNames("Ghana Cedi", "Cedi ganês")},
+	{Code: "GIP", Numeric: "292", MinorUnits: 2, Symbol: "£", Names: localizedNames("Gibraltar Pound", "Libra de Gibraltar")},
+	{Code: "GMD", Numeric: "270", MinorUnits: 2, Symbol: "D", Names: localizedNames("Dalasi", "Dalasi gambiano")},
+	{Code: "GNF", Numeric: "324", MinorUnits: 0, Symbol: "FG", Names: localizedNames("Guinean Franc", "Franco guineense")},
+	{Code: "GTQ", Numeric: "320", MinorUnits: 2, Symbol: "Q", Names: localizedNames("Quetzal", "Quetzal guatemalteco")},
+	{Code: "GYD", Numeric: "328", MinorUnits: 2, Symbol: "G$", Names: localizedNames("Guyana Dollar", "Dólar guianense")},
+	{Code: "HKD", Numeric: "344", MinorUnits: 2, Symbol: "HK$", Names: localizedNames("Hong Kong Dollar", "Dólar de Hong Kong")},
+	{Code: "HNL", Numeric: "340", MinorUnits: 2, Symbol: "L", Names: localizedNames("Lempira", "Lempira hondurenha")},
+	{Code: "HTG", Numeric: "332", MinorUnits: 2, Symbol: "G", Names: localizedNames("Gourde", "Gourde haitiano")},
+	{Code: "HUF", Numeric: "348", MinorUnits: 2, Symbol: "Ft", Names: localizedNames("Forint", "Florim húngaro")},
+	{Code: "IDR", Numeric: "360", MinorUnits: 2, Symbol: "Rp", Names: localizedNames("Rupiah", "Rupia indonésia")},
+	{Code: "ILS", Numeric: "376", MinorUnits: 2, Symbol: "₪", Names: localizedNames("New Israeli Sheqel", "Novo shekel israelense")},
+	{Code: "INR", Numeric: "356", MinorUnits: 2, Symbol: "₹", Names: localizedNames("Indian Rupee", "Rupia indiana")},
+	{Code: "IQD", Numeric: "368", MinorUnits: 3, Symbol: "ID", Names: localizedNames("Iraqi Dinar", "Dinar iraquiano")},
+	{Code: "IRR", Numeric: "364", MinorUnits: 2, Symbol: "﷼", Names: localizedNames("Iranian Rial", "Rial iraniano")},
+	{Code: "ISK", Numeric: "352", MinorUnits: 0, Symbol: "kr", Names: localizedNames("Iceland Krona", "Coroa islandesa")},
+	{Code: "JMD", Numeric: "388", MinorUnits: 2, Symbol: "J$", Names: localizedNames("Jamaican Dollar", "Dólar jamaicano")},
+	{Code: "JOD", Numeric: "400", MinorUnits: 3, Symbol: "JD", Names: localizedNames("Jordanian Dinar", "Dinar jordaniano")},
+	{Code: "JPY", Numeric: "392", MinorUnits: 0, Symbol: "¥", Names: localizedNames("Yen", "Iene japonês")},
+	{Code: "KES", Numeric: "404", MinorUnits: 2, Symbol: "KSh", Names: localizedNames("Kenyan Shilling", "Xelim queniano")},
+	{Code: "KGS", Numeric: "417", MinorUnits: 2, Symbol: "с", Names: localizedNames("Som", "Som quirguiz")},
+	{Code: "KHR", Numeric: "116", MinorUnits: 2, Symbol: "៛", Names: localizedNames("Riel", "Riel cambojano")},
+	{Code: "KMF", Numeric: "174", MinorUnits: 0, Symbol: "CF", Names: localizedNames("Comorian Franc", "Franco comorense")},
+	{Code: "KPW", Numeric: "408", MinorUnits: 2, Symbol: "₩", Names: localizedNames("North Korean Won", "Won norte-coreano")},
+	{Code: "KRW", Numeric: "410", MinorUnits: 0, Symbol: "₩", Names: localizedNames("Won", "Won sul-coreano")},
+	{Code: "KWD", Numeric: "414", MinorUnits: 3, Symbol: "KD", Names: localizedNames("Kuwaiti Dinar", "Dinar kuwaitiano")},
+	{Code: "KYD", Numeric: "136", MinorUnits: 2, Symbol: "CI$", Names: localizedNames("Cayman Islands Dollar", "Dólar das Ilhas Cayman")},
+	{Code: "KZT", Numeric: "398", MinorUnits: 2, Symbol: "₸", Names: localizedNames("Tenge", "Tenge cazaque")},
+	{Code: "LAK", Numeric: "418", MinorUnits: 2, Symbol: "₭", Names: localizedNames("Lao Kip", "Kip laosiano")},
+	{Code: "LBP", Numeric: "422", MinorUnits: 2, Symbol: "LL", Names: localizedNames("Lebanese Pound", "Libra libanesa")},
+	{Code: "LKR", Numeric: "144", MinorUnits: 2, Symbol: "Rs", Names: localizedNames("Sri Lanka Rupee", "Rupia cingalesa")},
+	{Code: "LRD", Numeric: "430", MinorUnits: 2, Symbol: "L$", Names: localizedNames("Liberian Dollar", "Dólar liberiano")},
+	{Code: "LSL", Numeric: "426", MinorUnits: 2, Symbol: "L", Names: localizedNames("Loti", "Loti do Lesoto")},
+	{Code: "LYD", Numeric: "434", MinorUnits: 3, Symbol: "LD", Names: localizedNames("Libyan Dinar", "Dinar líbio")},
+	{Code: "MAD", Numeric: "504", MinorUnits: 2, Symbol: "DH", Names: localizedNames("Moroccan Dirham", "Dirham marroquino")},
+	{Code: "MDL", Numeric: "498", MinorUnits: 2, Symbol: "L", Names: localizedNames("Moldovan Leu", "Leu moldávio")},
+	{Code: "MGA", Numeric: "969", MinorUnits: 2, Symbol: "Ar", Names: localizedNames("Malagasy Ariary", "Ariary malgaxe")},
+	{Code: "MKD", Numeric: "807", MinorUnits: 2, Symbol: "ден", Names: localizedNames("Denar", "Dinar macedônio")},
+	{Code: "MMK", Numeric: "104", MinorUnits: 2, Symbol: "K", Names: localizedNames("Kyat", "Quiate birmanês")},
+	{Code: "MNT", Numeric: "496", MinorUnits: 2, Symbol: "₮", Names: localizedNames("Tugrik", "Tugrik mongol")},
+	{Code: "MOP", Numeric: "446", MinorUnits: 2, Symbol: "MOP$", Names: localizedNames("Pataca", "Pataca macaense")},
+	{Code: "MRU", Numeric: "929", MinorUnits: 2, Symbol: "UM", Names: localizedNames("Ouguiya", "Uguia mauritana")},
+	{Code: "MUR", Numeric: "480", MinorUnits: 2, Symbol: "Rs", Names: localizedNames("Mauritius Rupee", "Rupia mauriciana")},
+	{Code: "MVR", Numeric: "462", MinorUnits: 2, Symbol: "Rf", Names: localizedNames("Rufiyaa", "Rupia maldívia")},
+	{Code: "MWK", Numeric: "454", MinorUnits: 2, Symbol: "MK", Names: localizedNames("Malawi Kwacha", "Kwacha malauiano")},
+	{Code: "MXN", Numeric: "484", MinorUnits: 2, Symbol: "$", Names: localizedNames("Mexican Peso", "Peso mexicano")},
+	{Code: "MYR", Numeric: "458", MinorUnits: 2, Symbol: "RM", Names: localizedNames("Malaysian Ringgit", "Ringgit malaio")},
+	{Code: "MZN", Numeric: "943", MinorUnits: 2, Symbol: "MT", Names: localizedNames("Mozambique Metical", "Metical moçambicano")},
+	{Code: "NAD", Numeric: "516", MinorUnits: 2, Symbol: "N$", Names: localizedNames("Namibia Dollar", "Dólar namibiano")},
+	{Code: "NGN", Numeric: "566", MinorUnits: 2, Symbol: "₦", Names: localizedNames("Naira", "Naira nigeriana")},
+	{Code: "NIO", Numeric: "558", MinorUnits: 2, Symbol: "C$", Names: localizedNames("Cordoba Oro", "Córdoba nicaraguense")},
+	{Code: "NOK", Numeric: "578", MinorUnits: 2, Symbol: "kr", Names: localizedNames("Norwegian Krone", "Coroa norueguesa")},
+	{Code: "NPR", Numeric: "524", MinorUnits: 2, Symbol: "Rs", Names: localizedNames("Nepalese Rupee", "Rupia nepalesa")},
+	{Code: "NZD", Numeric: "554", MinorUnits: 2, Symbol: "NZ$", Names: localizedNames("New Zealand Dollar", "Dólar neozelandês")},
+	{Code: "OMR", Numeric: "512", MinorUnits: 3, Symbol: "RO", Names: localizedNames("Rial Omani", "Rial omanense")},
+	{Code: "PAB", Numeric: "590", MinorUnits: 2, Symbol: "B/.", Names: localizedNames("Balboa", "Balboa panamenho")},
+	{Code: "PEN", Numeric: "604", MinorUnits: 2, Symbol: "S/", Names: localizedNames("Sol", "Sol peruano")},
+	{Code: "PGK", Numeric: "598", MinorUnits: 2, Symbol: "K", Names: localizedNames("Kina", "Kina papuásia")},
+	{Code: "PHP", Numeric: "608", MinorUnits: 2, Symbol: "₱", Names: localizedNames("Philippine Peso", "Peso filipino")},
+	{Code: "PKR", Numeric: "586", MinorUnits: 2, Symbol: "Rs", Names: localizedNames("Pakistan Rupee", "Rupia paquistanesa")},
+	{Code: "PLN", Numeric: "985", MinorUnits: 2, Symbol: "zł", Names: localizedNames("Zloty", "Zlóti polonês")},
+	{Code: "PYG", Numeric: "600", MinorUnits: 0, Symbol: "₲", Names: localizedNames("Guarani", "Guarani paraguaio")},
+	{Code: "QAR", Numeric: "634", MinorUnits: 2, Symbol: "QR", Names: localizedNames("Qatari Rial", "Rial catariano")},
+	{Code: "RON", Numeric: "946", MinorUnits: 2, Symbol: "lei", Names: localizedNames("Romanian Leu", "Leu romeno")},
+	{Code: "RSD", Numeric: "941", MinorUnits: 2, Symbol: "дин.", Names: localizedNames("Serbian Dinar", "Dinar sérvio")},
+	{Code: "RUB", Numeric: "643", MinorUnits: 2, Symbol: "₽", Names: localizedNames("Russian Ruble", "Rublo russo")},
+	{Code: "RWF", Numeric: "646", MinorUnits: 0, Symbol: "FRw", Names: localizedNames("Rwanda Franc", "Franco ruandês")},
+	{Code: "SAR", Numeric: "682", MinorUnits: 2, Symbol: "SR", Names: localizedNames("Saudi Riyal", "Rial saudita")},
+	{Code: "SBD", Numeric: "090", MinorUnits: 2, Symbol: "SI$", Names: localizedNames("Solomon Islands Dollar", "Dólar das Ilhas Salomão")},
+	{Code: "SCR", Numeric: "690", MinorUnits: 2, Symbol: "SR", Names: localizedNames("Seychelles Rupee", "Rupia seichelense")},
+	{Code: "SDG", Numeric: "938", MinorUnits: 2, Symbol: "SDG", Names: localizedNames("Sudanese Pound", "Libra sudanesa")},
+	{Code: "SEK", Numeric: "752", MinorUnits: 2, Symbol: "kr", Names: localizedNames("Swedish Krona", "Coroa sueca")},
+	{Code: "SGD", Numeric: "702", MinorUnits: 2, Symbol: "S$", Names: localizedNames("Singapore Dollar", "Dólar de Singapura")},
+	{Code: "SHP", Numeric: "654", MinorUnits: 2, Symbol: "£", Names: localizedNames("Saint Helena Pound", "Libra de Santa Helena")},
+	{Code: "SLE", Numeric: "925", MinorUnits: 2, Symbol: "Le", Names: localizedNames("Leone", "Leone serra-leonês")},
+	{Code: "SOS", Numeric: "706", MinorUnits: 2, Symbol: "Sh", Names: localizedNames("Somali Shilling", "Xelim somaliano")},
+	{Code: "SRD", Numeric: "968", MinorUnits: 2, Symbol: "$", Names: localizedNames("Surinam Dollar", "Dólar surinamês")},
+	{Code: "SSP", Numeric: "728", MinorUnits: 2, Symbol: "£", Names: localizedNames("South Sudanese Pound", "Libra sul-sudanesa")},
+	{Code: "STN", Numeric: "930", MinorUnits: 2, Symbol: "Db", Names: localizedNames("Dobra", "Dobra são-tomense")},
+	{Code: "SVC", Numeric: "222", MinorUnits: 2, Symbol: "₡", Names: localizedNames("El Salvador Colon", "Colón salvadorenho")},
+	{Code: "SYP", Numeric: "760", MinorUnits: 2, Symbol: "£S", Names: localizedNames("Syrian Pound", "Libra síria")},
+	{Code: "SZL", Numeric: "748", MinorUnits: 2, Symbol: "E", Names: localizedNames("Lilangeni", "Lilangeni suazi")},
+	{Code: "THB", Numeric: "764", MinorUnits: 2, Symbol: "฿", Names: localizedNames("Baht", "Baht tailandês")},
+	{Code: "TJS", Numeric: "972", MinorUnits: 2, Symbol: "SM", Names: localizedNames("Somoni", "Somoni tadjique")},
+	{Code: "TMT", Numeric: "934", MinorUnits: 2, Symbol: "m", Names: localizedNames("Turkmenistan New Manat", "Manat turcomeno")},
+	{Code: "TND", Numeric: "788", MinorUnits: 3, Symbol: "DT", Names: localizedNames("Tunisian Dinar", "Dinar tunisiano")},
+	{Code: "TOP", Numeric: "776", MinorUnits: 2, Symbol: "T$", Names: localizedNames("Pa'anga", "Paanga tonganesa")},
+	{Code: "TRY", Numeric: "949", MinorUnits: 2, Symbol: "₺", Names: localizedNames("Turkish Lira", "Lira turca")},
+	{Code: "TTD", Numeric: "780", MinorUnits: 2, Symbol: "TT$", Names: localizedNames("Trinidad and Tobago Dollar", "Dólar de Trinidad e Tobago")},
+	{Code: "TWD", Numeric: "901", MinorUnits: 2, Symbol: "NT$", Names: localizedNames("New Taiwan Dollar", "Novo dólar taiwanês")},
+	{Code: "TZS", Numeric: "834", MinorUnits: 2, Symbol: "TSh", Names: localizedNames("Tanzanian Shilling", "Xelim tanzaniano")},
+	{Code: "UAH", Numeric: "980", MinorUnits: 2, Symbol: "₴", Names: localizedNames("Hryvnia", "Grívnia ucraniana")},
+	{Code: "UGX", Numeric: "800", MinorUnits: 0, Symbol: "USh", Names: localizedNames("Uganda Shilling", "Xelim ugandense")},
+	{Code: "USD", Numeric: "840", MinorUnits: 2, Symbol: "$", Names: localizedNames("United States Dollar", "Dólar americano")},
+	{Code: "UYU", Numeric: "858", MinorUnits: 2, Symbol: "$U", Names: localizedNames("Peso Uruguayo", "Peso uruguaio")},
+	{Code: "UYW", Numeric: "927", MinorUnits: 4, Symbol: "UP", Names: localizedNames("Unidad Previsional", "Unidade previsional uruguaia")},
+	{Code: "UZS", Numeric: "860", MinorUnits: 2, Symbol: "soʻm", Names: localizedNames("Uzbekistan Sum", "Som uzbeque")},
+	{Code: "VED", Numeric: "926", MinorUnits: 2, Symbol: "Bs.D", Names: localizedNames("Bolívar Soberano (digital)", "Bolívar soberano digital")},
+	{Code: "VES", Numeric: "928", MinorUnits: 2, Symbol: "Bs.S", Names: localizedNames("Bolívar Soberano", "Bolívar soberano")},
+	{Code: "VND", Numeric: "704", MinorUnits: 0, Symbol: "₫", Names: localizedNames("Dong", "Dongue vietnamita")},
+	{Code: "VUV", Numeric: "548", MinorUnits: 0, Symbol: "VT", Names: localizedNames("Vatu", "Vatu de Vanuatu")},
+	{Code: "WST", Numeric: "882", MinorUnits: 2, Symbol: "WS$", Names: localizedNames("Tala", "Tala samoano")},
+	{Code: "XAF", Numeric: "950", MinorUnits: 0, Symbol: "FCFA", Names: localizedNames("CFA Franc BEAC", "Franco CFA BEAC")},
+	{Code: "XCD", Numeric: "951", MinorUnits: 2, Symbol: "EC$", Names: localizedNames("East Caribbean Dollar", "Dólar do Caribe Oriental")},
+	{Code: "XCG", Numeric: "532", MinorUnits: 2, Symbol: "Cg", Names: localizedNames("Caribbean Guilder", "Florim caribenho")},
+	{Code: "XOF", Numeric: "952", MinorUnits: 0, Symbol: "CFA", Names: localizedNames("CFA Franc BCEAO", "Franco CFA BCEAO")},
+	{Code: "XPF", Numeric: "953", MinorUnits: 0, Symbol: "₣", Names: localizedNames("CFP Franc", "Franco CFP")},
+	{Code: "YER", Numeric: "886", MinorUnits: 2, Symbol: "﷼", Names: localizedNames("Yemeni Rial", "Rial iemenita")},
+	{Code: "ZAR", Numeric: "710", MinorUnits: 2, Symbol: "R", Names: localizedNames("Rand", "Rand sul-africano")},
+	{Code: "ZMW", Numeric: "967", MinorUnits: 2, Symbol: "ZK", Names: localizedNames("Zambian Kwacha", "Kwacha zambiano")},
+	{Code: "ZWG", Numeric: "924", MinorUnits: 2, Symbol: "ZiG", Names: localizedNames("Zimbabwe Gold", "Ouro do Zimbábue")},
+}
@@ -129,6 +129,70 @@ func TestGetCurrencyName(t *testing.T) {
 	}
 }
 
+func TestCurrencyCatalogMetadata(t *testing.T) {
+	tests := []struct {
+		code       string
+		numeric    string
+		minorUnits int
+	}{
+		{"USD", "840", 2},
+		{"JPY", "392", 0},
+		{"KWD", "414", 3},
+		{"CLF", "990", 4},
+		{"BRL", "986", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			info, ok := LookupCurrency(tt.code)
+			if !ok {
+				t.Fatalf("moeda %s deveria existir no catálogo", tt.code)
+			}
+			if info.Numeric != tt.numeric || info.MinorUnits != tt.minorUnits {
+				t.Errorf("metadados inesperados para %s: %+v", tt.code, info)
+			}
+			if info.LocalizedName(LocalePortuguese) == "" || info.LocalizedName(LocaleEnglish) == "" {
+				t.Errorf("moeda %s sem nome localizado", tt.code)
+			}
+		})
+	}
+
+	if IsISOCurrency("XYZ") {
+		t.Error("XYZ não deveria existir no catálogo")
+	}
+	if Currency("XYZ").MinorUnits() != 2 {
+		t.Error("moeda desconhecida deveria usar 2 casas decimais")
+	}
+}
+
+func TestSetEnabledCurrencies(t *testing.T) {
+	t.Cleanup(func() { _ = SetEnabledCurrencies(nil) })
+
+	if err := SetEnabledCurrencies([]string{"jpy", "USD", "JPY"}); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if got := SupportedCurrencyCodesString(); got != "JPY USD" {
+		t.Errorf("moedas habilitadas inesperadas: %s", got)
+	}
+	if !IsValidCurrency("JPY") || IsValidCurrency("BRL") {
+		t.Error("validação deveria seguir as moedas habilitadas")
+	}
+
+	if err := SetEnabledCurrencies([]string{"XYZ"}); err == nil {
+		t.Error("esperava erro para código fora do ISO 4217")
+	}
+	if got := SupportedCurrencyCodesString(); got != "JPY USD" {
+		t.Errorf("configuração inválida não deveria alterar as moedas habilitadas: %s", got)
+	}
+
+	if err := SetEnabledCurrencies(nil); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if got := SupportedCurrencyCodesString(); got != "USD EUR CHF GBP BRL" {
+		t.Errorf("lista vazia deveria restaurar o padrão, obtido %s", got)
+	}
+}
+
 // Função auxiliar para verificar se uma string contém outra
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr ||
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// DuplicateDateWindow é a distância máxima entre as datas de duas transações suspeitas de duplicidade;
+// bancos costumam lançar a mesma compra com um ou dois dias de diferença entre autorização e compensação
+const DuplicateDateWindow = 3 * 24 * time.Hour
+
+// DuplicatePolicy define o que fazer ao gravar uma transação suspeita de duplicidade
+type DuplicatePolicy string
+
+const (
+	DuplicatePolicyFlag   DuplicatePolicy = "flag"   // grava e marca para revisão (padrão)
+	DuplicatePolicyReject DuplicatePolicy = "reject" // recusa com ErrConflict
+	DuplicatePolicyAllow  DuplicatePolicy = "allow"  // não verifica (ex.: recorrências, já identificadas pela ExternalRef)
+)
+
+var removeAccents = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// NormalizeDescription deixa a descrição comparável: minúsculas, sem acentos, sem pontuação e com espaços simples
+func NormalizeDescription(description string) string {
+	plain, _, err := transform.String(removeAccents, description)
+	if err != nil {
+		plain = description
+	}
+	fields := strings.FieldsFunc(strings.ToLower(plain), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, " ")
+}
+
+// IsSuspectedDuplicateOf indica se t parece ser a mesma movimentação que other: mesma conta, tipo e
+// valor, datas dentro de DuplicateDateWindow e mesma descrição normalizada
+func (t *Transaction) IsSuspectedDuplicateOf(other *Transaction) bool {
+	if t.ID == other.ID || other.Status == TransactionStatusVoided {
+		return false
+	}
+	if t.AccountID != other.AccountID || t.Type != other.Type || t.Amount.Cmp(other.Amount) != 0 {
+		return false
+	}
+	distance := t.OccurredAt.Sub(other.OccurredAt)
+	if distance < 0 {
+		distance = -distance
+	}
+	if distance > DuplicateDateWindow {
+		return false
+	}
+	return NormalizeDescription(t.Description) == NormalizeDescription(other.Description)
+}
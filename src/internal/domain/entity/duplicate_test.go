@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNormalizeDescription(t *testing.T) {
+	tests := map[string]string{
+		"Padaria São João":      "padaria sao joao",
+		"  PADARIA   SAO-JOAO.": "padaria sao joao",
+		"Pão de Açúcar #123":    "pao de acucar 123",
+		"":                      "",
+	}
+	for input, expected := range tests {
+		if got := NormalizeDescription(input); got != expected {
+			t.Errorf("NormalizeDescription(%q) = %q, esperado %q", input, got, expected)
+		}
+	}
+}
+
+func TestTransactionIsSuspectedDuplicateOf(t *testing.T) {
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	original := &Transaction{ID: "a", AccountID: "acc", Type: TransactionTypeExpense, Amount: MoneyFromInt(50), Description: "Farmácia", OccurredAt: day}
+
+	candidate := *original
+	candidate.ID = "b"
+	candidate.Description = "FARMACIA"
+	candidate.OccurredAt = day.AddDate(0, 0, 3)
+	if !candidate.IsSuspectedDuplicateOf(original) {
+		t.Fatalf("esperava duplicata dentro da janela de 3 dias")
+	}
+
+	candidate.OccurredAt = day.AddDate(0, 0, 4)
+	if candidate.IsSuspectedDuplicateOf(original) {
+		t.Fatalf("não esperava duplicata fora da janela")
+	}
+
+	candidate.OccurredAt = day
+	candidate.Amount = MoneyFromInt(51)
+	if candidate.IsSuspectedDuplicateOf(original) {
+		t.Fatalf("não esperava duplicata com valor diferente")
+	}
+
+	candidate.Amount = original.Amount
+	voided := *original
+	voided.Status = TransactionStatusVoided
+	if candidate.IsSuspectedDuplicateOf(&voided) {
+		t.Fatalf("transação anulada não deveria ser considerada")
+	}
+}
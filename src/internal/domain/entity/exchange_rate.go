@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// ExchangeRate é a cotação diária de uma moeda: 1 BaseCurrency vale Rate QuoteCurrency
+type ExchangeRate struct {
+	ID            string    `bson:"_id"`
+	BaseCurrency  Currency  `bson:"base_currency"`
+	QuoteCurrency Currency  `bson:"quote_currency"`
+	Rate          float64   `bson:"rate"`
+	Date          time.Time `bson:"date"`
+	Source        string    `bson:"source"`
+	CreatedAt     time.Time `bson:"created_at"`
+	UpdatedAt     time.Time `bson:"updated_at"`
+}
+
+// ExchangeRateDate normaliza o instante para o dia (UTC) ao qual a cotação se refere
+func ExchangeRateDate(timestamp time.Time) time.Time {
+	utc := timestamp.UTC()
+	return time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -14,8 +14,8 @@ type Goal struct {
 	ID            string     `bson:"_id"`
 	UserID        string     `bson:"user_id"`
 	Name          string     `bson:"name"`
-	TargetAmount  float64    `bson:"target_amount"`
-	CurrentAmount float64    `bson:"current_amount"`
+	TargetAmount  Money      `bson:"target_amount"`
+	CurrentAmount Money      `bson:"current_amount"`
 	Currency      Currency   `bson:"currency"`
 	Deadline      time.Time  `bson:"deadline"`
 	Status        GoalStatus `bson:"status"`
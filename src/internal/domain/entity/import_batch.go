@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+)
+
+type ImportBatchStatus string
+
+const (
+	ImportBatchStatusPreview   ImportBatchStatus = "preview"
+	ImportBatchStatusCommitted ImportBatchStatus = "committed"
+)
+
+type ImportRowStatus string
+
+const (
+	ImportRowStatusPending   ImportRowStatus = "pending"
+	ImportRowStatusInvalid   ImportRowStatus = "invalid"
+	ImportRowStatusImported  ImportRowStatus = "imported"
+	ImportRowStatusSkipped   ImportRowStatus = "skipped"
+	ImportRowStatusDuplicate ImportRowStatus = "duplicate"           // já gravada por uma importação anterior (extratos sobrepostos)
+	ImportRowStatusSuspected ImportRowStatus = "suspected_duplicate" // parecida com uma transação existente; só é gravada se selecionada
+)
+
+const (
+	ImportSourceCSV = "csv"
+	ImportSourceOFX = "ofx"
+)
+
+// ImportRow é uma linha do extrato já interpretada; Amount é sempre positivo e Type indica o efeito
+type ImportRow struct {
+	Line          int             `bson:"line"`
+	OccurredAt    time.Time       `bson:"occurred_at"`
+	Description   string          `bson:"description"`
+	Amount        Money           `bson:"amount"`
+	Type          TransactionType `bson:"type,omitempty"`
+	Status        ImportRowStatus `bson:"status"`
+	Error         string          `bson:"error,omitempty"`
+	TransactionID string          `bson:"transaction_id,omitempty"`
+	ExternalRef   string          `bson:"external_ref,omitempty"`
+}
+
+// ImportBatch guarda a prévia de um extrato até a confirmação; prévias não confirmadas expiram em ExpiresAt
+type ImportBatch struct {
+	ID          string            `bson:"_id"`
+	UserID      string            `bson:"user_id"`
+	AccountID   string            `bson:"account_id"`
+	ProfileID   string            `bson:"profile_id,omitempty"`
+	Source      string            `bson:"source"`
+	FileName    string            `bson:"file_name"`
+	Currency    Currency          `bson:"currency"`
+	Status      ImportBatchStatus `bson:"status"`
+	Rows        []ImportRow       `bson:"rows"`
+	CreatedAt   time.Time         `bson:"created_at"`
+	UpdatedAt   time.Time         `bson:"updated_at"`
+	CommittedAt *time.Time        `bson:"committed_at,omitempty"`
+	ExpiresAt   *time.Time        `bson:"expires_at,omitempty"`
+}
+
+// RowExternalRef identifica a linha de forma determinística para que confirmar o lote de novo não duplique transações.
+// Linhas com identificador do banco (FITID do OFX) usam esse identificador, estável entre arquivos.
+func (b *ImportBatch) RowExternalRef(row ImportRow) string {
+	if row.ExternalRef != "" {
+		return row.ExternalRef
+	}
+	return "import:" + b.ID + ":" + strconv.Itoa(row.Line)
+}
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// ImportSignConvention define como o sinal do valor no extrato vira receita ou despesa
+type ImportSignConvention string
+
+const (
+	ImportSignNegativeExpense    ImportSignConvention = "negative_expense"     // extrato de conta: valores negativos são despesas
+	ImportSignPositiveExpense    ImportSignConvention = "positive_expense"     // fatura de cartão: valores positivos são despesas
+	ImportSignDebitCreditColumns ImportSignConvention = "debit_credit_columns" // colunas separadas de débito e crédito
+)
+
+// ImportEncoding é a codificação de caracteres do arquivo exportado pelo banco
+type ImportEncoding string
+
+const (
+	ImportEncodingUTF8        ImportEncoding = "utf-8"
+	ImportEncodingLatin1      ImportEncoding = "latin-1"
+	ImportEncodingWindows1252 ImportEncoding = "windows-1252"
+)
+
+// ImportProfile guarda o mapeamento de colunas de um extrato CSV de um banco específico.
+// Os índices de coluna começam em zero.
+type ImportProfile struct {
+	ID                string               `bson:"_id"`
+	UserID            string               `bson:"user_id"`
+	Name              string               `bson:"name"`
+	Delimiter         string               `bson:"delimiter"`
+	Encoding          ImportEncoding       `bson:"encoding"`
+	SkipRows          int                  `bson:"skip_rows"`
+	DateColumn        int                  `bson:"date_column"`
+	DescriptionColumn int                  `bson:"description_column"`
+	AmountColumn      *int                 `bson:"amount_column,omitempty"`
+	DebitColumn       *int                 `bson:"debit_column,omitempty"`
+	CreditColumn      *int                 `bson:"credit_column,omitempty"`
+	DateFormat        string               `bson:"date_format"`
+	DecimalSeparator  string               `bson:"decimal_separator"`
+	SignConvention    ImportSignConvention `bson:"sign_convention"`
+	CreatedAt         time.Time            `bson:"created_at"`
+	UpdatedAt         time.Time            `bson:"updated_at"`
+}
+
+var importDateTokens = strings.NewReplacer("YYYY", "2006", "YY", "06", "MM", "01", "DD", "02")
+
+// DateLayout converte o formato de data do perfil (ex.: DD/MM/YYYY) no layout do pacote time
+func (p *ImportProfile) DateLayout() string {
+	return importDateTokens.Replace(p.DateFormat)
+}
+
+// IsValid verifica se o perfil tem as colunas exigidas pela convenção de sinal e separadores coerentes
+func (p *ImportProfile) IsValid() bool {
+	if len([]rune(p.Delimiter)) != 1 || (p.DecimalSeparator != "," && p.DecimalSeparator != ".") {
+		return false
+	}
+	if p.SkipRows < 0 || p.DateColumn < 0 || p.DescriptionColumn < 0 {
+		return false
+	}
+	if !strings.Contains(p.DateLayout(), "2006") && !strings.Contains(p.DateLayout(), "06") {
+		return false
+	}
+	switch p.Encoding {
+	case ImportEncodingUTF8, ImportEncodingLatin1, ImportEncodingWindows1252:
+	default:
+		return false
+	}
+
+	switch p.SignConvention {
+	case ImportSignNegativeExpense, ImportSignPositiveExpense:
+		return p.AmountColumn != nil && *p.AmountColumn >= 0
+	case ImportSignDebitCreditColumns:
+		return p.DebitColumn != nil && *p.DebitColumn >= 0 && p.CreditColumn != nil && *p.CreditColumn >= 0
+	default:
+		return false
+	}
+}
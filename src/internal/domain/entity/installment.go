@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// InstallmentMetadataKey guarda nos metadados da transação a parcela no formato "k/N"
+const InstallmentMetadataKey = "installment"
+
+// MaxInstallments limita a quantidade de parcelas de uma compra
+const MaxInstallments = 48
+
+// Installment identifica uma parcela de compra parcelada; as parcelas da mesma compra compartilham GroupID
+type Installment struct {
+	GroupID string `bson:"group_id"`
+	Number  int    `bson:"number"`
+	Count   int    `bson:"count"`
+}
+
+// Label devolve a parcela no formato "k/N"
+func (i Installment) Label() string {
+	return strconv.Itoa(i.Number) + "/" + strconv.Itoa(i.Count)
+}
+
+// SplitInstallments divide total em count parcelas cortadas em decimals casas; a sobra fica na primeira parcela
+// para que a soma seja exatamente total. Como as parcelas são cortadas, e não arredondadas, a sobra nunca é
+// negativa; com CanSplitInstallments nenhuma parcela fica zerada
+func SplitInstallments(total Money, count int, decimals int) []Money {
+	if count <= 1 {
+		return []Money{total}
+	}
+	share := Money{units: total.units / int64(count)}.Truncate(decimals)
+	amounts := make([]Money, count)
+	amounts[0] = total.Sub(share.MulInt(int64(count - 1)))
+	for i := 1; i < count; i++ {
+		amounts[i] = share
+	}
+	return amounts
+}
+
+// CanSplitInstallments indica se total rende ao menos a menor unidade da moeda (decimals casas) por parcela
+func CanSplitInstallments(total Money, count int, decimals int) bool {
+	if decimals > MoneyScale || decimals < 0 {
+		decimals = MoneyScale
+	}
+	minorUnit := int64(math.Pow10(MoneyScale - decimals))
+	return total.units >= minorUnit*int64(count)
+}
+
+// InstallmentDate devolve a data da parcela number (1 é a data da compra): mesmo dia nos meses seguintes,
+// limitado ao último dia do mês, mantendo o horário
+func InstallmentDate(purchase time.Time, number int) time.Time {
+	first := time.Date(purchase.Year(), purchase.Month()+time.Month(number-1), 1, 0, 0, 0, 0, purchase.Location())
+	day := purchase.Day()
+	if last := daysIn(first.Year(), first.Month()); day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, purchase.Hour(), purchase.Minute(), purchase.Second(), purchase.Nanosecond(), purchase.Location())
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSplitInstallments(t *testing.T) {
+	amounts := SplitInstallments(MustParseMoney("100.00"), 3, 2)
+	if len(amounts) != 3 {
+		t.Fatalf("esperava 3 parcelas, obtido %d", len(amounts))
+	}
+	if amounts[0] != MustParseMoney("33.34") || amounts[1] != MustParseMoney("33.33") || amounts[2] != MustParseMoney("33.33") {
+		t.Fatalf("diferença do arredondamento deveria ficar na primeira parcela: %v", amounts)
+	}
+
+	total := ZeroMoney
+	for _, amount := range SplitInstallments(MustParseMoney("1999.90"), 12, 2) {
+		total = total.Add(amount)
+	}
+	if total != MustParseMoney("1999.90") {
+		t.Fatalf("parcelas deveriam somar o total, obtido %s", total)
+	}
+}
+
+// TestSplitInstallmentsValoresPequenos garante que a sobra da divisão nunca deixa a primeira parcela zerada ou negativa
+func TestSplitInstallmentsValoresPequenos(t *testing.T) {
+	amounts := SplitInstallments(MustParseMoney("1.20"), 48, 2)
+	if amounts[0] != MustParseMoney("0.26") || amounts[1] != MustParseMoney("0.02") || amounts[47] != MustParseMoney("0.02") {
+		t.Fatalf("parcelas inesperadas: primeira %s, demais %s", amounts[0], amounts[1])
+	}
+	total := ZeroMoney
+	for _, amount := range amounts {
+		if !amount.IsPositive() {
+			t.Fatalf("nenhuma parcela deveria ser zero ou negativa: %v", amounts)
+		}
+		total = total.Add(amount)
+	}
+	if total != MustParseMoney("1.20") {
+		t.Fatalf("parcelas deveriam somar o total, obtido %s", total)
+	}
+
+	if yen := SplitInstallments(MustParseMoney("1000"), 3, 0); yen[0] != MustParseMoney("334") || yen[1] != MustParseMoney("333") {
+		t.Fatalf("parcelas sem casas decimais inesperadas: %v", yen)
+	}
+
+	cases := []struct {
+		total    string
+		count    int
+		decimals int
+		want     bool
+	}{
+		{"0.02", 3, 2, false},
+		{"0.03", 3, 2, true},
+		{"1.20", 48, 2, true},
+		{"0.47", 48, 2, false},
+		{"2", 3, 0, false},
+		{"3", 3, 0, true},
+	}
+	for _, tc := range cases {
+		if got := CanSplitInstallments(MustParseMoney(tc.total), tc.count, tc.decimals); got != tc.want {
+			t.Errorf("%s em %d parcelas com %d casas: esperado %v, obtido %v", tc.total, tc.count, tc.decimals, tc.want, got)
+		}
+	}
+}
+
+func TestInstallmentDate(t *testing.T) {
+	purchase := time.Date(2024, 1, 31, 15, 30, 0, 0, time.UTC)
+	cases := map[int]time.Time{
+		1:  purchase,
+		2:  time.Date(2024, 2, 29, 15, 30, 0, 0, time.UTC),
+		3:  time.Date(2024, 3, 31, 15, 30, 0, 0, time.UTC),
+		13: time.Date(2025, 1, 31, 15, 30, 0, 0, time.UTC),
+	}
+	for number, expected := range cases {
+		if got := InstallmentDate(purchase, number); !got.Equal(expected) {
+			t.Errorf("parcela %d: esperado %v, obtido %v", number, expected, got)
+		}
+	}
+	if label := (Installment{Number: 3, Count: 10}).Label(); label != "3/10" {
+		t.Fatalf("rótulo inesperado: %s", label)
+	}
+}
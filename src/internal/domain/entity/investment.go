@@ -0,0 +1,212 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+// Quantity é uma quantidade de cotas, com a mesma precisão exata de Money (4 casas decimais)
+type Quantity = Money
+
+// CostBasisMethod define como o custo das cotas vendidas é apurado
+type CostBasisMethod string
+
+const (
+	CostBasisFIFO    CostBasisMethod = "fifo"    // as cotas mais antigas são vendidas primeiro
+	CostBasisAverage CostBasisMethod = "average" // custo médio ponderado da posição
+)
+
+func (m CostBasisMethod) IsValid() bool {
+	return m == CostBasisFIFO || m == CostBasisAverage
+}
+
+// Investment guarda as configurações de uma conta de investimento. O saldo da conta é o caixa disponível na
+// corretora; o valor de mercado das posições é apurado a partir das operações e do histórico de preços
+type Investment struct {
+	CostBasis CostBasisMethod `bson:"cost_basis"`
+}
+
+// CostBasisMethod devolve o método configurado ou FIFO quando não há configuração
+func (a *Account) CostBasisMethod() CostBasisMethod {
+	if a.Investment == nil || !a.Investment.CostBasis.IsValid() {
+		return CostBasisFIFO
+	}
+	return a.Investment.CostBasis
+}
+
+// Security é um ativo negociado (ação, fundo, título) cadastrado pelo usuário
+type Security struct {
+	ID        string    `bson:"_id"`
+	UserID    string    `bson:"user_id"`
+	Symbol    string    `bson:"symbol"`
+	Name      string    `bson:"name"`
+	Currency  Currency  `bson:"currency"`
+	CreatedAt time.Time `bson:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+type PriceSource string
+
+const (
+	PriceSourceManual PriceSource = "manual"
+	PriceSourceFile   PriceSource = "file"
+)
+
+// SecurityPrice é o preço de fechamento do ativo em um dia
+type SecurityPrice struct {
+	ID         string      `bson:"_id"`
+	UserID     string      `bson:"user_id"`
+	SecurityID string      `bson:"security_id"`
+	Date       time.Time   `bson:"date"` // meia-noite UTC do dia
+	Price      Money       `bson:"price"`
+	Source     PriceSource `bson:"source"`
+	UpdatedAt  time.Time   `bson:"updated_at"`
+}
+
+// SecurityPriceID identifica o preço pelo ativo e pelo dia, para que gravar de novo substitua o anterior
+func SecurityPriceID(securityID string, date time.Time) string {
+	return securityID + ":" + StartOfDay(date).Format("2006-01-02")
+}
+
+type TradeType string
+
+const (
+	TradeTypeBuy      TradeType = "buy"
+	TradeTypeSell     TradeType = "sell"
+	TradeTypeDividend TradeType = "dividend"
+)
+
+func (t TradeType) IsValid() bool {
+	return t == TradeTypeBuy || t == TradeTypeSell || t == TradeTypeDividend
+}
+
+// InvestmentTrade é uma operação em conta de investimento. O efeito no caixa é gravado como uma transação
+// comum da conta (TransactionID), para que saldo, histórico e relatórios continuem vindo das transações
+type InvestmentTrade struct {
+	ID            string    `bson:"_id"`
+	UserID        string    `bson:"user_id"`
+	AccountID     string    `bson:"account_id"`
+	SecurityID    string    `bson:"security_id"`
+	Type          TradeType `bson:"type"`
+	Quantity      Quantity  `bson:"quantity"` // zero em proventos
+	Price         Money     `bson:"price"`    // preço unitário; em proventos, o valor bruto recebido
+	Fees          Money     `bson:"fees"`
+	TransactionID string    `bson:"transaction_id"`
+	OccurredAt    time.Time `bson:"occurred_at"`
+	CreatedAt     time.Time `bson:"created_at"`
+}
+
+// Gross devolve o valor da operação antes das taxas
+func (t *InvestmentTrade) Gross() Money {
+	if t.Type == TradeTypeDividend {
+		return t.Price
+	}
+	return t.Price.MulQuantity(t.Quantity)
+}
+
+// CashAmount devolve quanto sai do caixa na compra (valor mais taxas) ou entra na venda e no provento (valor menos taxas)
+func (t *InvestmentTrade) CashAmount() Money {
+	if t.Type == TradeTypeBuy {
+		return t.Gross().Add(t.Fees)
+	}
+	return t.Gross().Sub(t.Fees)
+}
+
+// Lot é uma compra ainda (parcialmente) em carteira; Cost inclui as taxas da compra
+type Lot struct {
+	TradeID    string
+	AcquiredAt time.Time
+	Quantity   Quantity
+	Cost       Money
+}
+
+// Holding é a posição em um ativo apurada a partir das operações
+type Holding struct {
+	SecurityID   string
+	Quantity     Quantity
+	CostBasis    Money
+	RealizedGain Money // resultado das vendas, já descontadas as taxas
+	Dividends    Money // proventos líquidos de taxas
+	LastPrice    Money // preço da última compra ou venda, usado quando não há preço no histórico
+	LastPriceAt  time.Time
+	Lots         []Lot // só no método FIFO
+}
+
+// BuildHoldings reproduz as operações em ordem de data e apura posição, custo e resultado de cada ativo.
+// Devolve false quando uma venda supera a quantidade em carteira naquele momento
+func BuildHoldings(trades []*InvestmentTrade, method CostBasisMethod) (map[string]*Holding, bool) {
+	ordered := append([]*InvestmentTrade(nil), trades...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if !ordered[i].OccurredAt.Equal(ordered[j].OccurredAt) {
+			return ordered[i].OccurredAt.Before(ordered[j].OccurredAt)
+		}
+		return ordered[i].CreatedAt.Before(ordered[j].CreatedAt)
+	})
+
+	holdings := map[string]*Holding{}
+	for _, trade := range ordered {
+		holding := holdings[trade.SecurityID]
+		if holding == nil {
+			holding = &Holding{SecurityID: trade.SecurityID}
+			holdings[trade.SecurityID] = holding
+		}
+
+		switch trade.Type {
+		case TradeTypeDividend:
+			holding.Dividends = holding.Dividends.Add(trade.CashAmount())
+			continue
+		case TradeTypeBuy:
+			cost := trade.CashAmount()
+			holding.Quantity = holding.Quantity.Add(trade.Quantity)
+			holding.CostBasis = holding.CostBasis.Add(cost)
+			if method == CostBasisFIFO {
+				holding.Lots = append(holding.Lots, Lot{TradeID: trade.ID, AcquiredAt: trade.OccurredAt, Quantity: trade.Quantity, Cost: cost})
+			}
+		case TradeTypeSell:
+			if trade.Quantity.Cmp(holding.Quantity) > 0 {
+				return nil, false
+			}
+			soldCost := holding.sell(trade.Quantity, method)
+			holding.Quantity = holding.Quantity.Sub(trade.Quantity)
+			holding.CostBasis = holding.CostBasis.Sub(soldCost)
+			holding.RealizedGain = holding.RealizedGain.Add(trade.CashAmount().Sub(soldCost))
+		}
+		holding.LastPrice = trade.Price
+		holding.LastPriceAt = trade.OccurredAt
+	}
+	return holdings, true
+}
+
+// sell devolve o custo das cotas vendidas: pelo custo médio ou consumindo os lotes mais antigos primeiro
+func (h *Holding) sell(quantity Quantity, method CostBasisMethod) Money {
+	if quantity.Cmp(h.Quantity) == 0 {
+		h.Lots = nil
+		return h.CostBasis
+	}
+	if method != CostBasisFIFO {
+		return h.CostBasis.MulRatio(quantity, h.Quantity)
+	}
+
+	cost := ZeroMoney
+	remaining := quantity
+	for len(h.Lots) > 0 && remaining.IsPositive() {
+		lot := &h.Lots[0]
+		if lot.Quantity.Cmp(remaining) <= 0 {
+			cost = cost.Add(lot.Cost)
+			remaining = remaining.Sub(lot.Quantity)
+			h.Lots = h.Lots[1:]
+			continue
+		}
+		partial := lot.Cost.MulRatio(remaining, lot.Quantity)
+		cost = cost.Add(partial)
+		lot.Cost = lot.Cost.Sub(partial)
+		lot.Quantity = lot.Quantity.Sub(remaining)
+		remaining = ZeroMoney
+	}
+	return cost
+}
+
+// MarketValue devolve o valor da posição ao preço informado
+func (h *Holding) MarketValue(price Money) Money {
+	return price.MulQuantity(h.Quantity)
+}
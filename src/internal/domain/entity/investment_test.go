@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func investmentTrades() []*InvestmentTrade {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	return []*InvestmentTrade{
+		{ID: "buy-1", SecurityID: "abc", Type: TradeTypeBuy, Quantity: MoneyFromInt(10), Price: MoneyFromInt(10), Fees: MoneyFromInt(1), OccurredAt: day(2)},
+		{ID: "buy-2", SecurityID: "abc", Type: TradeTypeBuy, Quantity: MoneyFromInt(10), Price: MoneyFromInt(20), OccurredAt: day(5)},
+		{ID: "dividend", SecurityID: "abc", Type: TradeTypeDividend, Price: MoneyFromInt(8), Fees: MoneyFromInt(2), OccurredAt: day(6)},
+		{ID: "sell", SecurityID: "abc", Type: TradeTypeSell, Quantity: MoneyFromInt(15), Price: MoneyFromInt(25), Fees: MoneyFromInt(5), OccurredAt: day(10)},
+	}
+}
+
+// TestBuildHoldingsFIFO garante que a venda consome primeiro o lote mais antigo, com as taxas no custo e no resultado
+func TestBuildHoldingsFIFO(t *testing.T) {
+	holdings, ok := BuildHoldings(investmentTrades(), CostBasisFIFO)
+	if !ok {
+		t.Fatalf("não esperava venda a descoberto")
+	}
+	holding := holdings["abc"]
+	// Custo vendido: lote 1 inteiro (101) + 5 cotas do lote 2 (100); venda líquida 370
+	if holding.Quantity.Cmp(MoneyFromInt(5)) != 0 || holding.CostBasis.Cmp(MoneyFromInt(100)) != 0 {
+		t.Fatalf("posição inesperada: %s cotas, custo %s", holding.Quantity, holding.CostBasis)
+	}
+	if holding.RealizedGain.Cmp(MoneyFromInt(169)) != 0 || holding.Dividends.Cmp(MoneyFromInt(6)) != 0 {
+		t.Fatalf("resultado inesperado: realizado %s, proventos %s", holding.RealizedGain, holding.Dividends)
+	}
+	if len(holding.Lots) != 1 || holding.Lots[0].TradeID != "buy-2" || holding.Lots[0].Quantity.Cmp(MoneyFromInt(5)) != 0 {
+		t.Fatalf("lotes inesperados: %+v", holding.Lots)
+	}
+	if holding.MarketValue(MoneyFromInt(30)).Cmp(MoneyFromInt(150)) != 0 {
+		t.Fatalf("valor de mercado inesperado: %s", holding.MarketValue(MoneyFromInt(30)))
+	}
+}
+
+// TestBuildHoldingsAverageCost garante o custo médio ponderado e a recusa de venda acima da posição
+func TestBuildHoldingsAverageCost(t *testing.T) {
+	holdings, ok := BuildHoldings(investmentTrades(), CostBasisAverage)
+	if !ok {
+		t.Fatalf("não esperava venda a descoberto")
+	}
+	holding := holdings["abc"]
+	// Custo médio 301/20 = 15.05; 15 cotas vendidas custam 225.75
+	if holding.CostBasis.Cmp(MustParseMoney("75.25")) != 0 || holding.RealizedGain.Cmp(MustParseMoney("144.25")) != 0 {
+		t.Fatalf("custo médio inesperado: custo %s, realizado %s", holding.CostBasis, holding.RealizedGain)
+	}
+	if len(holding.Lots) != 0 {
+		t.Fatalf("custo médio não deveria manter lotes")
+	}
+
+	oversold := append(investmentTrades(), &InvestmentTrade{ID: "sell-2", SecurityID: "abc", Type: TradeTypeSell, Quantity: MoneyFromInt(6), Price: MoneyFromInt(25), OccurredAt: time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC)})
+	if _, ok := BuildHoldings(oversold, CostBasisAverage); ok {
+		t.Fatalf("venda acima da posição deveria ser recusada")
+	}
+}
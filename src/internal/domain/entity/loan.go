@@ -0,0 +1,241 @@
+package entity
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// AmortizationSystem define como as parcelas de um empréstimo são calculadas
+type AmortizationSystem string
+
+const (
+	AmortizationPrice AmortizationSystem = "price" // tabela Price: parcelas iguais, amortização crescente
+	AmortizationSAC   AmortizationSystem = "sac"   // amortização constante, parcelas decrescentes
+)
+
+func (s AmortizationSystem) IsValid() bool {
+	return s == AmortizationPrice || s == AmortizationSAC
+}
+
+// PrepaymentMode define o que uma amortização extraordinária reduz
+type PrepaymentMode string
+
+const (
+	PrepaymentReduceTerm    PrepaymentMode = "reduce_term"    // mantém o valor da parcela e encurta o prazo
+	PrepaymentReducePayment PrepaymentMode = "reduce_payment" // mantém o prazo e reduz as parcelas
+)
+
+func (m PrepaymentMode) IsValid() bool {
+	return m == PrepaymentReduceTerm || m == PrepaymentReducePayment
+}
+
+// MaxLoanTermMonths limita o prazo a 35 anos, o máximo usual em financiamento imobiliário
+const MaxLoanTermMonths = 420
+
+// Loan guarda os termos e a situação de uma conta de empréstimo ou financiamento. O saldo da conta fica negativo
+// pelo saldo devedor: cada pagamento é uma transferência para a conta, e os juros da parcela são uma despesa
+// lançada na própria conta, de modo que o saldo só cai pelo valor amortizado
+type Loan struct {
+	Principal             Money              `bson:"principal"`   // valor financiado
+	AnnualRate            float64            `bson:"annual_rate"` // taxa efetiva ao ano, em percentual (ex.: 10.5)
+	TermMonths            int                `bson:"term_months"`
+	FirstDueDate          time.Time          `bson:"first_due_date"`
+	System                AmortizationSystem `bson:"system"`
+	Outstanding           Money              `bson:"outstanding"` // saldo devedor
+	Level                 Money              `bson:"level"`       // parcela fixa (Price) ou amortização fixa (SAC) vigente
+	PaidInstallments      int                `bson:"paid_installments"`
+	RemainingInstallments int                `bson:"remaining_installments"`
+	Payments              []LoanPaymentEntry `bson:"payments,omitempty"` // do mais antigo para o mais recente
+}
+
+// LoanPaymentEntry registra um pagamento com o estado do empréstimo de antes dele, para que o pagamento mais
+// recente possa ser estornado
+type LoanPaymentEntry struct {
+	PaymentID             string   `bson:"payment_id"`
+	TransactionIDs        []string `bson:"transaction_ids"`
+	Outstanding           Money    `bson:"outstanding"`
+	Level                 Money    `bson:"level"`
+	PaidInstallments      int      `bson:"paid_installments"`
+	RemainingInstallments int      `bson:"remaining_installments"`
+}
+
+// NewLoan devolve o empréstimo ainda sem pagamentos, com a parcela arredondada para as casas decimais da moeda
+func NewLoan(principal Money, annualRate float64, termMonths int, firstDueDate time.Time, system AmortizationSystem, decimals int) *Loan {
+	loan := &Loan{
+		Principal:             principal,
+		AnnualRate:            annualRate,
+		TermMonths:            termMonths,
+		FirstDueDate:          firstDueDate,
+		System:                system,
+		Outstanding:           principal,
+		RemainingInstallments: termMonths,
+	}
+	loan.resetLevel(decimals)
+	return loan
+}
+
+// resetLevel recalcula a parcela (Price) ou a amortização (SAC) para quitar o saldo nas parcelas restantes
+func (l *Loan) resetLevel(decimals int) {
+	if l.RemainingInstallments <= 0 {
+		l.Level = ZeroMoney
+		return
+	}
+	if l.System == AmortizationSAC {
+		l.Level = l.Outstanding.Div(float64(l.RemainingInstallments)).Round(decimals)
+		return
+	}
+	l.Level = pricePayment(l.Outstanding, l.MonthlyRate(), l.RemainingInstallments).Round(decimals)
+}
+
+// IsValid exige valor positivo, taxa não negativa, prazo entre 1 mês e MaxLoanTermMonths e sistema conhecido
+func (l Loan) IsValid() bool {
+	return l.Principal.IsPositive() && l.AnnualRate >= 0 && l.TermMonths >= 1 && l.TermMonths <= MaxLoanTermMonths &&
+		!l.FirstDueDate.IsZero() && l.System.IsValid()
+}
+
+// IsPaidOff indica que não há mais saldo devedor
+func (l Loan) IsPaidOff() bool {
+	return !l.Outstanding.IsPositive() || l.RemainingInstallments <= 0
+}
+
+// MonthlyRate converte a taxa efetiva anual na taxa mensal equivalente: (1 + a)^(1/12) - 1
+func (l Loan) MonthlyRate() float64 {
+	return math.Pow(1+l.AnnualRate/100, 1.0/12) - 1
+}
+
+// AmortizationRow é uma linha do cronograma; Extra é a amortização extraordinária feita junto com a parcela
+type AmortizationRow struct {
+	Number    int
+	DueDate   time.Time
+	Payment   Money // parcela: amortização mais juros, sem o extra
+	Principal Money
+	Interest  Money
+	Extra     Money
+	Balance   Money // saldo devedor depois da parcela e do extra
+}
+
+// NextInstallment calcula a próxima parcela: juros do mês sobre o saldo devedor, arredondados para as casas
+// decimais da moeda, e amortização pelo nível vigente. A última parcela quita o saldo que sobrar do arredondamento
+func (l Loan) NextInstallment(decimals int) AmortizationRow {
+	number := l.PaidInstallments + 1
+	row := AmortizationRow{Number: number, DueDate: InstallmentDate(l.FirstDueDate, number)}
+	row.Interest = l.Outstanding.Mul(l.MonthlyRate()).Round(decimals)
+
+	switch {
+	case l.RemainingInstallments <= 1:
+		row.Principal = l.Outstanding
+	case l.System == AmortizationSAC:
+		row.Principal = l.Level
+	default:
+		row.Principal = l.Level.Sub(row.Interest)
+	}
+	if row.Principal.Cmp(l.Outstanding) > 0 {
+		row.Principal = l.Outstanding
+	}
+	row.Payment = row.Principal.Add(row.Interest)
+	row.Balance = l.Outstanding.Sub(row.Principal)
+	return row
+}
+
+// PayInstallment registra a próxima parcela e devolve a linha paga
+func (l *Loan) PayInstallment(decimals int) AmortizationRow {
+	row := l.NextInstallment(decimals)
+	l.Outstanding = row.Balance
+	l.PaidInstallments++
+	l.RemainingInstallments--
+	if !l.Outstanding.IsPositive() {
+		l.RemainingInstallments = 0
+	}
+	return row
+}
+
+// RecordPayment guarda no histórico o pagamento paymentID, com previous como o estado de antes dele
+func (l *Loan) RecordPayment(previous Loan, paymentID string, transactionIDs []string) {
+	l.Payments = append(slices.Clip(previous.Payments), LoanPaymentEntry{
+		PaymentID:             paymentID,
+		TransactionIDs:        transactionIDs,
+		Outstanding:           previous.Outstanding,
+		Level:                 previous.Level,
+		PaidInstallments:      previous.PaidInstallments,
+		RemainingInstallments: previous.RemainingInstallments,
+	})
+}
+
+// LastPayment devolve o pagamento mais recente, o único que pode ser estornado, ou nil sem histórico
+func (l Loan) LastPayment() *LoanPaymentEntry {
+	if len(l.Payments) == 0 {
+		return nil
+	}
+	return &l.Payments[len(l.Payments)-1]
+}
+
+// RevertLastPayment volta o empréstimo ao estado de antes do pagamento mais recente e o tira do histórico
+func (l *Loan) RevertLastPayment() {
+	last := l.LastPayment()
+	if last == nil {
+		return
+	}
+	l.Outstanding = last.Outstanding
+	l.Level = last.Level
+	l.PaidInstallments = last.PaidInstallments
+	l.RemainingInstallments = last.RemainingInstallments
+	l.Payments = slices.Clip(l.Payments[:len(l.Payments)-1])
+}
+
+// Prepay abate amount do saldo devedor. Para reduzir o prazo, mantém a parcela (Price) ou a amortização (SAC)
+// vigente e recalcula quantas parcelas faltam; para reduzir a parcela, recalcula o nível sobre as parcelas restantes
+func (l *Loan) Prepay(amount Money, mode PrepaymentMode, decimals int) {
+	if amount.Cmp(l.Outstanding) >= 0 {
+		l.Outstanding = ZeroMoney
+		l.RemainingInstallments = 0
+		l.Level = ZeroMoney
+		return
+	}
+	l.Outstanding = l.Outstanding.Sub(amount)
+	if mode != PrepaymentReduceTerm {
+		l.resetLevel(decimals)
+		return
+	}
+
+	rate := l.MonthlyRate()
+	var remaining float64
+	if l.System == AmortizationSAC || rate == 0 {
+		remaining = l.Outstanding.Float64() / l.Level.Float64()
+	} else {
+		// Número de parcelas de valor Level que quitam o saldo: n = -ln(1 - B·i/P) / ln(1 + i)
+		remaining = -math.Log(1-l.Outstanding.Float64()*rate/l.Level.Float64()) / math.Log(1+rate)
+	}
+	// Tolera o erro de ponto flutuante para não criar uma parcela extra de centavos
+	if n := int(math.Ceil(remaining - 1e-9)); n < l.RemainingInstallments {
+		l.RemainingInstallments = max(n, 1)
+	}
+}
+
+// Project devolve o cronograma das parcelas restantes. monthlyExtra é abatido depois de cada parcela, no modo
+// informado; zero devolve o cronograma contratado
+func (l Loan) Project(monthlyExtra Money, mode PrepaymentMode, decimals int) []AmortizationRow {
+	projected := l
+	rows := make([]AmortizationRow, 0, max(l.RemainingInstallments, 0))
+	for !projected.IsPaidOff() {
+		row := projected.PayInstallment(decimals)
+		if monthlyExtra.IsPositive() && !projected.IsPaidOff() {
+			row.Extra = monthlyExtra
+			if row.Extra.Cmp(projected.Outstanding) > 0 {
+				row.Extra = projected.Outstanding
+			}
+			projected.Prepay(row.Extra, mode, decimals)
+			row.Balance = projected.Outstanding
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// pricePayment é a parcela constante da tabela Price: B·i / (1 - (1 + i)^-n)
+func pricePayment(balance Money, rate float64, installments int) Money {
+	if rate == 0 {
+		return balance.Div(float64(installments))
+	}
+	return balance.Mul(rate / (1 - math.Pow(1+rate, -float64(installments))))
+}
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"math"
+	"testing"
+)
+
+// onePercentMonthly é a taxa anual equivalente a 1% ao mês
+var onePercentMonthly = (math.Pow(1.01, 12) - 1) * 100
+
+func TestLoanPriceSchedule(t *testing.T) {
+	loan := NewLoan(MoneyFromInt(100000), onePercentMonthly, 12, date(2024, 1, 31), AmortizationPrice, 2)
+
+	rows := loan.Project(ZeroMoney, PrepaymentReduceTerm, 2)
+	if len(rows) != 12 {
+		t.Fatalf("esperava 12 parcelas, obtive %d", len(rows))
+	}
+	if rows[0].Payment.String() != "8884.88" || rows[0].Interest.String() != "1000.00" {
+		t.Fatalf("primeira parcela inesperada: %s (juros %s)", rows[0].Payment, rows[0].Interest)
+	}
+	principal := ZeroMoney
+	for _, row := range rows[:11] {
+		if row.Payment.String() != "8884.88" {
+			t.Fatalf("parcela %d deveria ser constante, obtive %s", row.Number, row.Payment)
+		}
+		principal = principal.Add(row.Principal)
+	}
+	principal = principal.Add(rows[11].Principal)
+	if principal.Cmp(MoneyFromInt(100000)) != 0 || !rows[11].Balance.IsZero() {
+		t.Fatalf("amortização deveria quitar o valor financiado: %s (saldo final %s)", principal, rows[11].Balance)
+	}
+	if !rows[1].DueDate.Equal(date(2024, 2, 29)) {
+		t.Fatalf("vencimento deveria cair no último dia de fevereiro: %s", rows[1].DueDate)
+	}
+}
+
+func TestLoanSACSchedule(t *testing.T) {
+	loan := NewLoan(MoneyFromInt(100000), onePercentMonthly, 10, date(2024, 1, 10), AmortizationSAC, 2)
+
+	rows := loan.Project(ZeroMoney, PrepaymentReduceTerm, 2)
+	if len(rows) != 10 || rows[0].Payment.String() != "11000.00" || rows[9].Payment.String() != "10100.00" {
+		t.Fatalf("cronograma SAC inesperado: %d parcelas, primeira %s", len(rows), rows[0].Payment)
+	}
+	interest := ZeroMoney
+	for _, row := range rows {
+		if row.Principal.String() != "10000.00" {
+			t.Fatalf("amortização deveria ser constante, parcela %d amortizou %s", row.Number, row.Principal)
+		}
+		interest = interest.Add(row.Interest)
+	}
+	if interest.String() != "5500.00" {
+		t.Fatalf("juros totais inesperados: %s", interest)
+	}
+}
+
+func TestLoanPrepay(t *testing.T) {
+	base := NewLoan(MoneyFromInt(100000), onePercentMonthly, 10, date(2024, 1, 10), AmortizationSAC, 2)
+	base.PayInstallment(2)
+
+	reduceTerm := *base
+	reduceTerm.Prepay(MoneyFromInt(20000), PrepaymentReduceTerm, 2)
+	if reduceTerm.Outstanding.String() != "70000.00" || reduceTerm.RemainingInstallments != 7 {
+		t.Fatalf("reduzir prazo deveria manter a amortização de 10000: %s em %d parcelas", reduceTerm.Outstanding, reduceTerm.RemainingInstallments)
+	}
+
+	reducePayment := *base
+	reducePayment.Prepay(MoneyFromInt(20000), PrepaymentReducePayment, 2)
+	if next := reducePayment.NextInstallment(2); reducePayment.RemainingInstallments != 9 || next.Principal.String() != "7777.78" || next.Number != 2 {
+		t.Fatalf("reduzir parcela deveria manter 9 parcelas: %d, amortização %s", reducePayment.RemainingInstallments, next.Principal)
+	}
+
+	price := NewLoan(MoneyFromInt(100000), onePercentMonthly, 12, date(2024, 1, 31), AmortizationPrice, 2)
+	before := price.NextInstallment(2)
+	price.Prepay(MoneyFromInt(30000), PrepaymentReduceTerm, 2)
+	after := price.NextInstallment(2)
+	if price.RemainingInstallments >= 12 || after.Payment.Cmp(before.Payment) > 0 {
+		t.Fatalf("reduzir prazo na Price deveria encurtar o prazo sem subir a parcela: %d parcelas de %s", price.RemainingInstallments, after.Payment)
+	}
+
+	payoff := *base
+	payoff.Prepay(MoneyFromInt(200000), PrepaymentReduceTerm, 2)
+	if !payoff.IsPaidOff() || !payoff.Outstanding.IsZero() {
+		t.Fatalf("extra acima do saldo deveria quitar o empréstimo: %+v", payoff)
+	}
+}
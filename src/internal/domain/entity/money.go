@@ -0,0 +1,326 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// MoneyScale é a quantidade de casas decimais guardadas por Money; 4 casas cobrem todas as moedas ISO 4217
+const MoneyScale = 4
+
+const moneyUnitsPerWhole int64 = 10000
+
+// Money é um valor monetário exato guardado em inteiros de 1/10000 da unidade.
+// É persistido como Decimal128 no MongoDB e serializado como string nos DTOs JSON.
+type Money struct {
+	units int64
+}
+
+// ZeroMoney é o valor monetário zero
+var ZeroMoney = Money{}
+
+// MoneyFromUnits cria um valor a partir de unidades de 1/10000
+func MoneyFromUnits(units int64) Money {
+	return Money{units: units}
+}
+
+// MoneyFromInt cria um valor inteiro (ex.: MoneyFromInt(100) representa 100.00)
+func MoneyFromInt(whole int64) Money {
+	return Money{units: whole * moneyUnitsPerWhole}
+}
+
+// MoneyFromFloat converte valores legados em ponto flutuante arredondando para MoneyScale casas
+func MoneyFromFloat(value float64) Money {
+	return Money{units: int64(math.Round(value * float64(moneyUnitsPerWhole)))}
+}
+
+// ParseMoney interpreta uma string decimal como "123.45" ou "-0.5" sem passar por ponto flutuante.
+// Valores com mais de MoneyScale casas decimais significativas são rejeitados.
+func ParseMoney(value string) (Money, error) {
+	text := strings.TrimSpace(value)
+	if text == "" {
+		return Money{}, fmt.Errorf("invalid money value %q", value)
+	}
+
+	rat, ok := new(big.Rat).SetString(text)
+	if !ok {
+		return Money{}, fmt.Errorf("invalid money value %q", value)
+	}
+	return moneyFromRat(rat, value)
+}
+
+// MustParseMoney é como ParseMoney mas entra em pânico em caso de erro; uso restrito a constantes e testes
+func MustParseMoney(value string) Money {
+	money, err := ParseMoney(value)
+	if err != nil {
+		panic(err)
+	}
+	return money
+}
+
+func moneyFromRat(rat *big.Rat, original string) (Money, error) {
+	scaled := new(big.Rat).Mul(rat, new(big.Rat).SetInt64(moneyUnitsPerWhole))
+	if !scaled.IsInt() {
+		return Money{}, fmt.Errorf("money value %q has more than %d decimal places", original, MoneyScale)
+	}
+	units := scaled.Num()
+	if !units.IsInt64() {
+		return Money{}, fmt.Errorf("money value %q is out of range", original)
+	}
+	return Money{units: units.Int64()}, nil
+}
+
+// Units devolve o valor em unidades de 1/10000
+func (m Money) Units() int64 {
+	return m.units
+}
+
+func (m Money) Add(other Money) Money {
+	return Money{units: m.units + other.units}
+}
+
+func (m Money) Sub(other Money) Money {
+	return Money{units: m.units - other.units}
+}
+
+func (m Money) Neg() Money {
+	return Money{units: -m.units}
+}
+
+func (m Money) Abs() Money {
+	if m.units < 0 {
+		return m.Neg()
+	}
+	return m
+}
+
+// MulInt multiplica o valor por um inteiro, usado para quantidades
+func (m Money) MulInt(factor int64) Money {
+	return Money{units: m.units * factor}
+}
+
+// Mul multiplica o valor por um fator (taxa de câmbio, juros) arredondando para MoneyScale casas
+func (m Money) Mul(factor float64) Money {
+	result := new(big.Rat).Mul(new(big.Rat).SetInt64(m.units), new(big.Rat).SetFloat64(factor))
+	return Money{units: roundRatHalfAwayFromZero(result)}
+}
+
+// MulQuantity multiplica o valor por uma quantidade fracionária (ex.: preço × cotas) sem passar por ponto flutuante,
+// arredondando para MoneyScale casas
+func (m Money) MulQuantity(quantity Money) Money {
+	return m.MulRatio(quantity, MoneyFromInt(1))
+}
+
+// MulRatio multiplica o valor por numerator/denominator sem passar por ponto flutuante, arredondando para
+// MoneyScale casas; usado para ratear custo proporcionalmente à quantidade
+func (m Money) MulRatio(numerator Money, denominator Money) Money {
+	if denominator.units == 0 {
+		return Money{}
+	}
+	result := new(big.Rat).SetFrac(
+		new(big.Int).Mul(big.NewInt(m.units), big.NewInt(numerator.units)),
+		big.NewInt(denominator.units),
+	)
+	return Money{units: roundRatHalfAwayFromZero(result)}
+}
+
+// Div divide o valor por um fator arredondando para MoneyScale casas
+func (m Money) Div(divisor float64) Money {
+	if divisor == 0 {
+		return Money{}
+	}
+	result := new(big.Rat).Quo(new(big.Rat).SetInt64(m.units), new(big.Rat).SetFloat64(divisor))
+	return Money{units: roundRatHalfAwayFromZero(result)}
+}
+
+// Round arredonda o valor para a quantidade de casas decimais informada (meio para longe do zero)
+func (m Money) Round(decimals int) Money {
+	if decimals >= MoneyScale || decimals < 0 {
+		return m
+	}
+	step := int64(math.Pow10(MoneyScale - decimals))
+	remainder := m.units % step
+	units := m.units - remainder
+	if remainder*2 >= step {
+		units += step
+	} else if remainder*2 <= -step {
+		units -= step
+	}
+	return Money{units: units}
+}
+
+// Truncate corta o valor na quantidade de casas decimais informada, em direção ao zero
+func (m Money) Truncate(decimals int) Money {
+	if decimals >= MoneyScale || decimals < 0 {
+		return m
+	}
+	step := int64(math.Pow10(MoneyScale - decimals))
+	return Money{units: m.units - m.units%step}
+}
+
+// FitsDecimals indica se o valor não tem casas decimais além das informadas
+func (m Money) FitsDecimals(decimals int) bool {
+	return m.Truncate(decimals) == m
+}
+
+// Ratio devolve m/other como float64, útil para percentuais
+func (m Money) Ratio(other Money) float64 {
+	if other.units == 0 {
+		return 0
+	}
+	return float64(m.units) / float64(other.units)
+}
+
+// Float64 devolve uma aproximação em ponto flutuante; não usar para cálculos monetários
+func (m Money) Float64() float64 {
+	return float64(m.units) / float64(moneyUnitsPerWhole)
+}
+
+func (m Money) Cmp(other Money) int {
+	switch {
+	case m.units < other.units:
+		return -1
+	case m.units > other.units:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (m Money) IsZero() bool {
+	return m.units == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.units > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.units < 0
+}
+
+// String formata o valor com no mínimo duas casas decimais, sem zeros excedentes (ex.: "10.50", "0.0125")
+func (m Money) String() string {
+	sign := ""
+	units := m.units
+	if units < 0 {
+		sign = "-"
+	}
+	abs := uint64(units)
+	if units < 0 {
+		abs = uint64(-units)
+	}
+	whole := abs / uint64(moneyUnitsPerWhole)
+	fraction := fmt.Sprintf("%04d", abs%uint64(moneyUnitsPerWhole))
+	fraction = strings.TrimRight(fraction, "0")
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+	return sign + strconv.FormatUint(whole, 10) + "." + fraction
+}
+
+// MarshalJSON serializa o valor como string para não perder precisão em clientes JavaScript
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON aceita string ("12.34") ou número (12.34) para manter compatibilidade com clientes antigos
+func (m *Money) UnmarshalJSON(data []byte) error {
+	text := strings.TrimSpace(string(data))
+	if text == "null" {
+		*m = Money{}
+		return nil
+	}
+	if strings.HasPrefix(text, "\"") {
+		var raw string
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		text = raw
+	}
+	parsed, err := ParseMoney(text)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// MarshalBSONValue grava o valor como Decimal128
+func (m Money) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	decimal, err := primitive.ParseDecimal128(m.String())
+	if err != nil {
+		return 0, nil, err
+	}
+	return bsontype.Decimal128, bsoncore.AppendDecimal128(nil, decimal), nil
+}
+
+// UnmarshalBSONValue lê Decimal128 e também os formatos legados double/int gravados antes da migração
+func (m *Money) UnmarshalBSONValue(valueType bsontype.Type, data []byte) error {
+	value := bsoncore.Value{Type: valueType, Data: data}
+	switch valueType {
+	case bsontype.Decimal128:
+		decimal, ok := value.Decimal128OK()
+		if !ok {
+			return fmt.Errorf("invalid decimal128 money value")
+		}
+		coefficient, exponent, err := decimal.BigInt()
+		if err != nil {
+			return err
+		}
+		rat := new(big.Rat).SetInt(coefficient)
+		scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(absInt(exponent))), nil))
+		if exponent < 0 {
+			rat.Quo(rat, scale)
+		} else {
+			rat.Mul(rat, scale)
+		}
+		*m = Money{units: roundRatHalfAwayFromZero(rat.Mul(rat, new(big.Rat).SetInt64(moneyUnitsPerWhole)))}
+		return nil
+	case bsontype.Double:
+		*m = MoneyFromFloat(value.Double())
+		return nil
+	case bsontype.Int32:
+		*m = MoneyFromInt(int64(value.Int32()))
+		return nil
+	case bsontype.Int64:
+		*m = MoneyFromInt(value.Int64())
+		return nil
+	case bsontype.Null, bsontype.Undefined:
+		*m = Money{}
+		return nil
+	default:
+		return fmt.Errorf("cannot decode bson %s into Money", valueType)
+	}
+}
+
+func roundRatHalfAwayFromZero(value *big.Rat) int64 {
+	numerator := new(big.Int).Set(value.Num())
+	denominator := value.Denom()
+	negative := numerator.Sign() < 0
+	numerator.Abs(numerator)
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if new(big.Int).Mul(remainder, big.NewInt(2)).Cmp(denominator) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if negative {
+		quotient.Neg(quotient)
+	}
+	return quotient.Int64()
+}
+
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    int64
+		shouldError bool
+	}{
+		{"123.45", 1234500, false},
+		{"-0.5", -5000, false},
+		{"0.0001", 1, false},
+		{"10", 100000, false},
+		{"1.23450", 12345, false},
+		{"1.00001", 0, true},
+		{"abc", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			money, err := ParseMoney(tt.input)
+			if (err != nil) != tt.shouldError {
+				t.Fatalf("ParseMoney(%q) erro = %v, esperado erro = %v", tt.input, err, tt.shouldError)
+			}
+			if !tt.shouldError && money.Units() != tt.expected {
+				t.Errorf("ParseMoney(%q) = %d, esperado %d", tt.input, money.Units(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestMoneySomaExata garante que somas repetidas não acumulam erro de ponto flutuante
+func TestMoneySomaExata(t *testing.T) {
+	total := ZeroMoney
+	for i := 0; i < 10; i++ {
+		total = total.Add(MustParseMoney("0.1"))
+	}
+	if total != MoneyFromInt(1) {
+		t.Fatalf("esperava 1.00, obtido %s", total)
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := map[string]Money{
+		"10.50":  MustParseMoney("10.5"),
+		"0.0125": MustParseMoney("0.0125"),
+		"-3.00":  MoneyFromInt(-3),
+		"0.00":   ZeroMoney,
+	}
+	for expected, money := range tests {
+		if money.String() != expected {
+			t.Errorf("String() = %s, esperado %s", money.String(), expected)
+		}
+	}
+}
+
+func TestMoneyRoundEMul(t *testing.T) {
+	if got := MustParseMoney("10").Mul(5.255).Round(2); got != MustParseMoney("52.55") {
+		t.Errorf("Mul/Round = %s, esperado 52.55", got)
+	}
+	if got := MustParseMoney("-1.005").Round(2); got != MustParseMoney("-1.01") {
+		t.Errorf("Round negativo = %s, esperado -1.01", got)
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	body, err := json.Marshal(struct {
+		Amount Money `json:"amount"`
+	}{Amount: MustParseMoney("99.9")})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if string(body) != `{"amount":"99.90"}` {
+		t.Fatalf("JSON inesperado: %s", body)
+	}
+
+	var decoded struct {
+		Text   Money `json:"text"`
+		Number Money `json:"number"`
+	}
+	if err := json.Unmarshal([]byte(`{"text":"12.34","number":12.34}`), &decoded); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if decoded.Text != MustParseMoney("12.34") || decoded.Number != MustParseMoney("12.34") {
+		t.Fatalf("valores decodificados inesperados: %s %s", decoded.Text, decoded.Number)
+	}
+}
+
+// TestMoneyBSON garante gravação em Decimal128 e leitura de documentos legados em double
+func TestMoneyBSON(t *testing.T) {
+	type document struct {
+		Amount Money `bson:"amount"`
+	}
+
+	raw, err := bson.Marshal(document{Amount: MustParseMoney("1234.5678")})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if bson.Raw(raw).Lookup("amount").Type != bson.TypeDecimal128 {
+		t.Fatalf("esperava Decimal128, obtido %s", bson.Raw(raw).Lookup("amount").Type)
+	}
+	var decoded document
+	if err := bson.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if decoded.Amount != MustParseMoney("1234.5678") {
+		t.Fatalf("valor inesperado após ida e volta: %s", decoded.Amount)
+	}
+
+	legacy, _ := bson.Marshal(bson.M{"amount": 99.99999999})
+	if err := bson.Unmarshal(legacy, &decoded); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if decoded.Amount != MoneyFromInt(100) {
+		t.Fatalf("double legado deveria ser arredondado para 4 casas, obtido %s", decoded.Amount)
+	}
+}
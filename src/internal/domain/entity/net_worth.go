@@ -0,0 +1,28 @@
+package entity
+
+// NetWorth soma os saldos das contas já convertidos para uma única moeda. Liabilities é o valor devido,
+// positivo; o saldo de uma conta de passivo com crédito a favor reduz o valor devido
+type NetWorth struct {
+	Assets      Money
+	Liabilities Money
+	ByType      map[AccountType]Money
+}
+
+func NewNetWorth() *NetWorth {
+	return &NetWorth{ByType: map[AccountType]Money{}}
+}
+
+// Add acumula o saldo convertido de uma conta do tipo informado
+func (n *NetWorth) Add(accountType AccountType, balance Money) {
+	n.ByType[accountType] = n.ByType[accountType].Add(balance)
+	if accountType.IsLiability() {
+		n.Liabilities = n.Liabilities.Sub(balance)
+		return
+	}
+	n.Assets = n.Assets.Add(balance)
+}
+
+// Total devolve o patrimônio líquido: ativos menos passivos
+func (n *NetWorth) Total() Money {
+	return n.Assets.Sub(n.Liabilities)
+}
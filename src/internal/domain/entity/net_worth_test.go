@@ -0,0 +1,22 @@
+package entity
+
+import "testing"
+
+// TestNetWorthSeparatesAssetsAndLiabilities garante que o saldo negativo do cartão entra como valor devido
+func TestNetWorthSeparatesAssetsAndLiabilities(t *testing.T) {
+	netWorth := NewNetWorth()
+	netWorth.Add(AccountTypeChecking, MoneyFromInt(1500))
+	netWorth.Add(AccountTypeSavings, MoneyFromInt(500))
+	netWorth.Add(AccountTypeCredit, MoneyFromInt(-300))
+	netWorth.Add(AccountTypeCredit, MoneyFromInt(-100))
+
+	if netWorth.Assets.Cmp(MoneyFromInt(2000)) != 0 || netWorth.Liabilities.Cmp(MoneyFromInt(400)) != 0 {
+		t.Fatalf("ativos e passivos inesperados: %s e %s", netWorth.Assets, netWorth.Liabilities)
+	}
+	if netWorth.Total().Cmp(MoneyFromInt(1600)) != 0 {
+		t.Fatalf("patrimônio líquido inesperado: %s", netWorth.Total())
+	}
+	if netWorth.ByType[AccountTypeCredit].Cmp(MoneyFromInt(-400)) != 0 {
+		t.Fatalf("saldo por tipo inesperado: %v", netWorth.ByType)
+	}
+}
@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+type OutboxStatus string
+
+const (
+	OutboxStatusPending OutboxStatus = "pending"
+	OutboxStatusSent    OutboxStatus = "sent"
+	OutboxStatusFailed  OutboxStatus = "failed"
+)
+
+// OutboxEvent é um evento gravado na mesma escrita da transação e publicado depois pelo relay
+type OutboxEvent struct {
+	ID            string            `bson:"_id"`
+	EventType     string            `bson:"event_type"`
+	QueueName     string            `bson:"queue_name"`
+	AggregateID   string            `bson:"aggregate_id"`
+	Payload       []byte            `bson:"payload"`
+	Attributes    map[string]string `bson:"attributes,omitempty"`
+	Status        OutboxStatus      `bson:"status"`
+	Attempts      int               `bson:"attempts"`
+	LastError     string            `bson:"last_error,omitempty"`
+	NextAttemptAt time.Time         `bson:"next_attempt_at"`
+	LockedUntil   *time.Time        `bson:"locked_until,omitempty"`
+	CreatedAt     time.Time         `bson:"created_at"`
+	SentAt        *time.Time        `bson:"sent_at,omitempty"`
+}
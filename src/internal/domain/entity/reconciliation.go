@@ -0,0 +1,40 @@
+package entity
+
+import "time"
+
+type ReconciliationStatus string
+
+const (
+	ReconciliationStatusOpen      ReconciliationStatus = "open"
+	ReconciliationStatusCompleted ReconciliationStatus = "completed"
+)
+
+// Reconciliation confere o saldo da conta contra o extrato do banco: o usuário marca as transações que aparecem
+// no extrato até StatementDate e a conciliação só pode ser concluída quando o saldo conferido bate com StatementBalance.
+// Há no máximo uma conciliação aberta por conta.
+type Reconciliation struct {
+	ID               string               `bson:"_id"`
+	UserID           string               `bson:"user_id"`
+	AccountID        string               `bson:"account_id"`
+	StatementDate    time.Time            `bson:"statement_date"`
+	StatementBalance Money                `bson:"statement_balance"`
+	Status           ReconciliationStatus `bson:"status"`
+	TransactionIDs   []string             `bson:"transaction_ids,omitempty"` // transações travadas ao concluir
+	CreatedAt        time.Time            `bson:"created_at"`
+	UpdatedAt        time.Time            `bson:"updated_at"`
+	CompletedAt      *time.Time           `bson:"completed_at,omitempty"`
+}
+
+// ClearedBalance calcula o saldo conferido a partir do saldo atual da conta, descontando o efeito das transações
+// ainda não conferidas; assim o saldo de abertura da conta entra no cálculo sem precisar ser gravado. Registros
+// antigos sem tipo têm de ser resolvidos pela categoria antes
+func ClearedBalance(balance Money, transactions []*Transaction) Money {
+	cleared := balance
+	for _, transaction := range transactions {
+		if transaction.Cleared || transaction.Status == TransactionStatusVoided {
+			continue
+		}
+		cleared = cleared.Sub(transaction.Type.BalanceEffect(transaction.Amount))
+	}
+	return cleared
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "testing"
+
+func TestClearedBalance(t *testing.T) {
+	transactions := []*Transaction{
+		{Type: TransactionTypeIncome, Amount: MustParseMoney("500.00"), Cleared: true},
+		{Type: TransactionTypeExpense, Amount: MustParseMoney("120.00"), Cleared: true},
+		{Type: TransactionTypeExpense, Amount: MustParseMoney("80.00")},
+		{Type: TransactionTypeTransferIn, Amount: MustParseMoney("50.00")},
+		{Type: TransactionTypeExpense, Amount: MustParseMoney("999.00"), Status: TransactionStatusVoided},
+	}
+
+	// Saldo atual 1350 = abertura 1000 + 500 - 120 - 80 + 50; sem as não conferidas sobram 1380
+	cleared := ClearedBalance(MustParseMoney("1350.00"), transactions)
+	if cleared.Cmp(MustParseMoney("1380.00")) != 0 {
+		t.Fatalf("saldo conferido inesperado: %s", cleared)
+	}
+}
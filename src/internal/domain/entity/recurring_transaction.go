@@ -0,0 +1,157 @@
+package entity
+
+import "time"
+
+// RecurrenceFrequency define como as ocorrências de uma transação recorrente se repetem
+type RecurrenceFrequency string
+
+const (
+	RecurrenceDaily           RecurrenceFrequency = "daily"
+	RecurrenceWeekly          RecurrenceFrequency = "weekly"
+	RecurrenceMonthly         RecurrenceFrequency = "monthly"
+	RecurrenceLastBusinessDay RecurrenceFrequency = "last_business_day"
+)
+
+type RecurringStatus string
+
+const (
+	RecurringStatusActive RecurringStatus = "active"
+	RecurringStatusPaused RecurringStatus = "paused"
+	RecurringStatusEnded  RecurringStatus = "ended"
+)
+
+// maxRecurrenceIterations limita a busca da próxima ocorrência (cerca de 30 anos de ocorrências diárias)
+const maxRecurrenceIterations = 11000
+
+// RecurrenceRule é um subconjunto do RRULE: a cada Interval dias/semanas/meses a partir da data inicial.
+// Weekday vale para weekly e DayOfMonth para monthly (dias inexistentes caem no último dia do mês).
+type RecurrenceRule struct {
+	Frequency  RecurrenceFrequency `bson:"frequency"`
+	Interval   int                 `bson:"interval"`
+	Weekday    time.Weekday        `bson:"weekday"`
+	DayOfMonth int                 `bson:"day_of_month"`
+}
+
+// IsValid verifica se a regra tem frequência conhecida e parâmetros coerentes
+func (r RecurrenceRule) IsValid() bool {
+	if r.Interval < 0 {
+		return false
+	}
+	switch r.Frequency {
+	case RecurrenceDaily, RecurrenceLastBusinessDay:
+		return true
+	case RecurrenceWeekly:
+		return r.Weekday >= time.Sunday && r.Weekday <= time.Saturday
+	case RecurrenceMonthly:
+		return r.DayOfMonth >= 1 && r.DayOfMonth <= 31
+	default:
+		return false
+	}
+}
+
+func (r RecurrenceRule) interval() int {
+	if r.Interval <= 0 {
+		return 1
+	}
+	return r.Interval
+}
+
+// occurrence devolve a n-ésima data candidata da regra a partir de start (pode ser anterior a start)
+func (r RecurrenceRule) occurrence(start time.Time, n int) time.Time {
+	step := n * r.interval()
+	switch r.Frequency {
+	case RecurrenceDaily:
+		return start.AddDate(0, 0, step)
+	case RecurrenceWeekly:
+		offset := (int(r.Weekday) - int(start.Weekday()) + 7) % 7
+		return start.AddDate(0, 0, offset+7*step)
+	case RecurrenceMonthly:
+		year, month := addMonths(start.Year(), start.Month(), step)
+		day := r.DayOfMonth
+		if last := daysIn(year, month); day > last {
+			day = last
+		}
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	default:
+		year, month := addMonths(start.Year(), start.Month(), step)
+		return lastBusinessDay(year, month)
+	}
+}
+
+// NextOccurrence devolve a primeira ocorrência da regra no dia from ou depois, sem anteceder start.
+// Retorna false quando não há ocorrência dentro do limite de busca.
+func (r RecurrenceRule) NextOccurrence(start time.Time, from time.Time) (time.Time, bool) {
+	start = RecurrenceDate(start)
+	from = RecurrenceDate(from)
+	if from.Before(start) {
+		from = start
+	}
+	for n := 0; n < maxRecurrenceIterations; n++ {
+		candidate := r.occurrence(start, n)
+		if !candidate.Before(from) {
+			return candidate, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// RecurrenceDate normaliza o instante para o dia (UTC) usado no agendamento
+func RecurrenceDate(timestamp time.Time) time.Time {
+	utc := timestamp.UTC()
+	return time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func addMonths(year int, month time.Month, months int) (int, time.Month) {
+	total := int(month) - 1 + months
+	return year + total/12, time.Month(total%12 + 1)
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func lastBusinessDay(year int, month time.Month) time.Time {
+	day := time.Date(year, month, daysIn(year, month), 0, 0, 0, 0, time.UTC)
+	for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		day = day.AddDate(0, 0, -1)
+	}
+	return day
+}
+
+// RecurringTransaction gera transações automaticamente (aluguel, salário, assinaturas)
+type RecurringTransaction struct {
+	ID             string          `bson:"_id"`
+	UserID         string          `bson:"user_id"`
+	AccountID      string          `bson:"account_id"`
+	CategoryID     string          `bson:"category_id"`
+	Amount         Money           `bson:"amount"`
+	Currency       Currency        `bson:"currency"`
+	Description    string          `bson:"description"`
+	Tags           []string        `bson:"tags"`
+	Rule           RecurrenceRule  `bson:"rule"`
+	StartDate      time.Time       `bson:"start_date"`
+	EndDate        *time.Time      `bson:"end_date,omitempty"`
+	NextOccurrence time.Time       `bson:"next_occurrence"`
+	LastOccurrence *time.Time      `bson:"last_occurrence,omitempty"`
+	Status         RecurringStatus `bson:"status"`
+	LastError      string          `bson:"last_error,omitempty"`
+	FailureCount   int             `bson:"failure_count,omitempty"` // falhas seguidas ao lançar a próxima ocorrência
+	CreatedAt      time.Time       `bson:"created_at"`
+	UpdatedAt      time.Time       `bson:"updated_at"`
+}
+
+// ScheduleFrom posiciona NextOccurrence na primeira ocorrência a partir de from e encerra a
+// recorrência quando ela passa da data final
+func (r *RecurringTransaction) ScheduleFrom(from time.Time) {
+	next, ok := r.Rule.NextOccurrence(r.StartDate, from)
+	if !ok || (r.EndDate != nil && next.After(RecurrenceDate(*r.EndDate))) {
+		r.Status = RecurringStatusEnded
+		return
+	}
+	r.NextOccurrence = next
+}
+
+// ExternalRef identifica a ocorrência de forma determinística para gravar a transação uma única vez
+func (r *RecurringTransaction) ExternalRef(occurrence time.Time) string {
+	return "recurring:" + r.ID + ":" + occurrence.Format("2006-01-02")
+}
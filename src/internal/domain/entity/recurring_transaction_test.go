@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecurrenceRuleNextOccurrence(t *testing.T) {
+	start := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		rule     RecurrenceRule
+		from     time.Time
+		expected time.Time
+	}{
+		{"diária a cada 3 dias", RecurrenceRule{Frequency: RecurrenceDaily, Interval: 3}, time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)},
+		{"semanal na segunda", RecurrenceRule{Frequency: RecurrenceWeekly, Weekday: time.Monday}, start, time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)},
+		{"quinzenal na segunda", RecurrenceRule{Frequency: RecurrenceWeekly, Interval: 2, Weekday: time.Monday}, time.Date(2024, 2, 6, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 19, 0, 0, 0, 0, time.UTC)},
+		{"mensal dia 31 em fevereiro", RecurrenceRule{Frequency: RecurrenceMonthly, DayOfMonth: 31}, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"mensal dia 5 começa no mês seguinte", RecurrenceRule{Frequency: RecurrenceMonthly, DayOfMonth: 5}, start, time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)},
+		{"último dia útil com fim de semana", RecurrenceRule{Frequency: RecurrenceLastBusinessDay}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC)},
+		{"último dia útil trimestral", RecurrenceRule{Frequency: RecurrenceLastBusinessDay, Interval: 3}, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, ok := tt.rule.NextOccurrence(start, tt.from)
+			if !ok || !next.Equal(tt.expected) {
+				t.Errorf("NextOccurrence() = %v, esperado %v", next, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRecurringTransactionScheduleFromEncerraNaDataFinal(t *testing.T) {
+	endDate := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	recurring := &RecurringTransaction{
+		ID:        "rec",
+		Rule:      RecurrenceRule{Frequency: RecurrenceDaily, Interval: 1},
+		StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   &endDate,
+		Status:    RecurringStatusActive,
+	}
+
+	recurring.ScheduleFrom(endDate)
+	if recurring.Status != RecurringStatusActive || !recurring.NextOccurrence.Equal(endDate) {
+		t.Fatalf("a data final deveria ser a última ocorrência, obteve %v/%s", recurring.NextOccurrence, recurring.Status)
+	}
+	recurring.ScheduleFrom(endDate.AddDate(0, 0, 1))
+	if recurring.Status != RecurringStatusEnded {
+		t.Fatalf("esperava status ended após a data final, obteve %s", recurring.Status)
+	}
+	if ref := recurring.ExternalRef(endDate); ref != "recurring:rec:2024-01-10" {
+		t.Fatalf("ExternalRef inesperada: %s", ref)
+	}
+}
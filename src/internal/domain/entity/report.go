@@ -1,10 +1,19 @@
 package entity
 
 type SummaryReport struct {
-	TotalIncome        float64            `bson:"total_income"`
-	TotalExpense       float64            `bson:"total_expense"`
-	NetBalance         float64            `bson:"net_balance"`
-	SpendingByCategory map[string]float64 `bson:"spending_by_category"`
+	Currency           Currency           `bson:"currency"`
+	TotalIncome        Money              `bson:"total_income"`
+	TotalExpense       Money              `bson:"total_expense"`
+	NetBalance         Money              `bson:"net_balance"`
+	SpendingByCategory map[string]Money   `bson:"spending_by_category"`
 	BudgetUsage        map[string]float64 `bson:"budget_usage"`
 	GoalProgress       map[string]float64 `bson:"goal_progress"`
 }
+
+// SummaryData reúne os documentos do período usados para montar o SummaryReport
+type SummaryData struct {
+	Transactions []*Transaction
+	Categories   map[string]Category
+	Budgets      []*Budget
+	Goals        []*Goal
+}
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+// CompoundingConvention define como os juros da poupança são capitalizados dentro do mês
+type CompoundingConvention string
+
+const (
+	CompoundingDaily   CompoundingConvention = "daily"   // taxa/365 ao dia sobre o saldo do dia mais os juros já acumulados
+	CompoundingMonthly CompoundingConvention = "monthly" // taxa/12 ao mês sobre o saldo médio diário
+)
+
+func (c CompoundingConvention) IsValid() bool {
+	return c == CompoundingDaily || c == CompoundingMonthly
+}
+
+// SavingsInterest guarda o rendimento de uma conta poupança. Os juros de cada mês são apurados sobre o saldo de
+// fechamento de cada dia e lançados como receita no primeiro dia do mês seguinte
+type SavingsInterest struct {
+	AnnualRate     float64               `bson:"annual_rate"` // taxa nominal ao ano, em percentual (ex.: 6.17)
+	Compounding    CompoundingConvention `bson:"compounding"`
+	AccruedThrough time.Time             `bson:"accrued_through"` // meia-noite UTC do primeiro dia cujos juros ainda não foram lançados
+}
+
+// IsValid exige taxa não negativa, convenção conhecida e data de início do rendimento
+func (s SavingsInterest) IsValid() bool {
+	return s.AnnualRate >= 0 && s.Compounding.IsValid() && !s.AccruedThrough.IsZero()
+}
+
+// EffectiveAnnualRate é o rendimento em um ano com saldo constante, em percentual
+func (s SavingsInterest) EffectiveAnnualRate() float64 {
+	periods := 12.0
+	if s.Compounding == CompoundingDaily {
+		periods = 365
+	}
+	return (math.Pow(1+s.AnnualRate/100/periods, periods) - 1) * 100
+}
+
+// InterestPeriodEnd devolve o fim do período de juros que contém start: o primeiro dia do mês seguinte, que é
+// também a data em que os juros do período são lançados
+func InterestPeriodEnd(start time.Time) time.Time {
+	start = start.UTC()
+	return time.Date(start.Year(), start.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// SavingsInterestRef identifica o lançamento de juros do período, para que rodar a rotina de novo não o duplique
+func SavingsInterestRef(accountID string, periodStart time.Time) string {
+	return "interest:" + accountID + ":" + StartOfDay(periodStart).Format("2006-01-02")
+}
+
+// PeriodInterest calcula os juros de um período a partir do saldo de fechamento de cada dia, começando em start.
+// Saldos negativos não rendem. O resultado é arredondado para as casas decimais da moeda
+func (s SavingsInterest) PeriodInterest(start time.Time, closing []Money, decimals int) Money {
+	// Acumula em ponto flutuante para não arredondar a cada dia
+	accrued := 0.0
+	for i, balance := range closing {
+		value := math.Max(balance.Float64(), 0)
+		if s.Compounding == CompoundingDaily {
+			accrued += (value + accrued) * s.AnnualRate / 100 / 365
+			continue
+		}
+		day := start.AddDate(0, 0, i)
+		accrued += value * s.AnnualRate / 100 / 12 / float64(daysIn(day.Year(), day.Month()))
+	}
+	return MoneyFromFloat(accrued).Round(decimals)
+}
+
+// SavingsProjectionRow é o saldo projetado logo depois do lançamento de juros de Date
+type SavingsProjectionRow struct {
+	Date     time.Time
+	Interest Money
+	Balance  Money
+}
+
+// Project projeta o saldo pelos próximos months lançamentos de juros, supondo o saldo constante e a taxa atual.
+// O primeiro período começa em AccruedThrough, então inclui os dias do mês ainda não lançados
+func (s SavingsInterest) Project(balance Money, months int, decimals int) []SavingsProjectionRow {
+	rows := make([]SavingsProjectionRow, 0, max(months, 0))
+	start := s.AccruedThrough
+	for range months {
+		end := InterestPeriodEnd(start)
+		days := int(end.Sub(start).Hours() / 24)
+		closing := make([]Money, days)
+		for i := range closing {
+			closing[i] = balance
+		}
+		interest := s.PeriodInterest(start, closing, decimals)
+		balance = balance.Add(interest)
+		rows = append(rows, SavingsProjectionRow{Date: end, Interest: interest, Balance: balance})
+		start = end
+	}
+	return rows
+}
@@ -0,0 +1,47 @@
+package entity
+
+import "testing"
+
+func TestSavingsPeriodInterest(t *testing.T) {
+	closing := make([]Money, 30)
+	for i := range closing {
+		closing[i] = MoneyFromInt(10000)
+	}
+	// Metade do mês com saldo negativo não rende
+	half := append(make([]Money, 0, 30), closing[:15]...)
+	for range 15 {
+		half = append(half, MoneyFromInt(-500))
+	}
+
+	monthly := SavingsInterest{AnnualRate: 12, Compounding: CompoundingMonthly, AccruedThrough: date(2024, 4, 1)}
+	if interest := monthly.PeriodInterest(date(2024, 4, 1), closing, 2); interest.String() != "100.00" {
+		t.Fatalf("mês inteiro a 1%% deveria render 100.00, obtive %s", interest)
+	}
+	if interest := monthly.PeriodInterest(date(2024, 4, 1), half, 2); interest.String() != "50.00" {
+		t.Fatalf("saldo negativo não deveria render, obtive %s", interest)
+	}
+
+	daily := SavingsInterest{AnnualRate: 12, Compounding: CompoundingDaily, AccruedThrough: date(2024, 4, 1)}
+	interest := daily.PeriodInterest(date(2024, 4, 1), closing, 2)
+	if interest.String() != "99.10" {
+		t.Fatalf("capitalização diária inesperada: %s", interest)
+	}
+}
+
+func TestSavingsProject(t *testing.T) {
+	savings := SavingsInterest{AnnualRate: 12, Compounding: CompoundingMonthly, AccruedThrough: date(2024, 4, 16)}
+
+	rows := savings.Project(MoneyFromInt(10000), 3, 2)
+	if len(rows) != 3 || !rows[0].Date.Equal(date(2024, 5, 1)) || !rows[2].Date.Equal(date(2024, 7, 1)) {
+		t.Fatalf("datas de lançamento inesperadas: %+v", rows)
+	}
+	if rows[0].Interest.String() != "50.00" || rows[1].Interest.String() != "100.50" || rows[2].Balance.String() != "10252.01" {
+		t.Fatalf("projeção inesperada: %+v", rows)
+	}
+	if rate := savings.EffectiveAnnualRate(); rate < 12.68 || rate > 12.69 {
+		t.Fatalf("taxa efetiva de 12%% ao ano capitalizada ao mês deveria ser 12.68%%, obtive %f", rate)
+	}
+	if !InterestPeriodEnd(date(2024, 12, 31)).Equal(date(2025, 1, 1)) {
+		t.Fatalf("período de dezembro deveria terminar em janeiro do ano seguinte")
+	}
+}
@@ -8,23 +8,131 @@ const (
 	TransactionStatusPending   TransactionStatus = "pending"
 	TransactionStatusCompleted TransactionStatus = "completed"
 	TransactionStatusFailed    TransactionStatus = "failed"
+	TransactionStatusVoided    TransactionStatus = "voided"
 )
 
+// TransactionType define o efeito da transação sobre o saldo da conta
+type TransactionType string
+
+const (
+	TransactionTypeIncome      TransactionType = "income"
+	TransactionTypeExpense     TransactionType = "expense"
+	TransactionTypeTransferOut TransactionType = "transfer_out"
+	TransactionTypeTransferIn  TransactionType = "transfer_in"
+)
+
+// TransferTransactionTypes lista os tipos que representam pernas de transferência entre contas
+var TransferTransactionTypes = []TransactionType{
+	TransactionTypeTransferOut,
+	TransactionTypeTransferIn,
+}
+
+// IsTransfer indica se o tipo representa uma perna de transferência (não afeta receitas/despesas)
+func (t TransactionType) IsTransfer() bool {
+	return t == TransactionTypeTransferOut || t == TransactionTypeTransferIn
+}
+
+// TransactionTypeFromCategory converte o tipo da categoria no tipo de transação equivalente
+func TransactionTypeFromCategory(categoryType CategoryType) TransactionType {
+	if categoryType == CategoryTypeIncome {
+		return TransactionTypeIncome
+	}
+	return TransactionTypeExpense
+}
+
+// BalanceEffect retorna o valor assinado que o tipo aplica sobre o saldo da conta
+func (t TransactionType) BalanceEffect(amount Money) Money {
+	switch t {
+	case TransactionTypeIncome, TransactionTypeTransferIn:
+		return amount
+	default:
+		return amount.Neg()
+	}
+}
+
 type Transaction struct {
-	ID            string            `bson:"_id"`
-	UserID        string            `bson:"user_id"`
-	AccountID     string            `bson:"account_id"`
-	CategoryID    string            `bson:"category_id"`
-	Amount        float64           `bson:"amount"`
-	Currency      Currency          `bson:"currency"`
-	Description   string            `bson:"description"`
-	OccurredAt    time.Time         `bson:"occurred_at"`
-	Status        TransactionStatus `bson:"status"`
-	Notes         string            `bson:"notes"`
-	ReceiptObject *string           `bson:"receipt_object,omitempty"`
-	Tags          []string          `bson:"tags"`
-	CreatedAt     time.Time         `bson:"created_at"`
-	UpdatedAt     time.Time         `bson:"updated_at"`
-	ExternalRef   string            `bson:"external_ref"`
-	Metadata      map[string]string `bson:"metadata"`
+	ID                  string             `bson:"_id"`
+	UserID              string             `bson:"user_id"`
+	AccountID           string             `bson:"account_id"`
+	CategoryID          string             `bson:"category_id"`
+	Type                TransactionType    `bson:"type,omitempty"`
+	Amount              Money              `bson:"amount"`
+	Currency            Currency           `bson:"currency"`
+	Description         string             `bson:"description"`
+	OccurredAt          time.Time          `bson:"occurred_at"`
+	Status              TransactionStatus  `bson:"status"`
+	Notes               string             `bson:"notes"`
+	ReceiptObject       *string            `bson:"receipt_object,omitempty"`
+	Tags                []string           `bson:"tags"`
+	CreatedAt           time.Time          `bson:"created_at"`
+	UpdatedAt           time.Time          `bson:"updated_at"`
+	ExternalRef         string             `bson:"external_ref"`
+	Metadata            map[string]string  `bson:"metadata"`
+	TransferID          string             `bson:"transfer_id,omitempty"`
+	LinkedTransactionID string             `bson:"linked_transaction_id,omitempty"`
+	VoidedAt            *time.Time         `bson:"voided_at,omitempty"`
+	VoidReason          string             `bson:"void_reason,omitempty"`
+	DuplicateOf         string             `bson:"duplicate_of,omitempty"`        // transação original quando marcada como possível duplicata
+	Splits              []TransactionSplit `bson:"splits,omitempty"`              // rateio entre categorias; vazio quando a transação inteira é de CategoryID
+	Installment         *Installment       `bson:"installment,omitempty"`         // parcela de uma compra parcelada no cartão
+	Cleared             bool               `bson:"cleared,omitempty"`             // conferida contra o extrato do banco
+	ReconciliationID    string             `bson:"reconciliation_id,omitempty"`   // conciliação concluída que travou a transação
+	InvestmentTradeID   string             `bson:"investment_trade_id,omitempty"` // operação de investimento que gerou o lançamento; só muda pela operação
+	LoanPaymentID       string             `bson:"loan_payment_id,omitempty"`     // pagamento de empréstimo que gerou o lançamento
+}
+
+// IsReconciled indica se a transação pertence a uma conciliação concluída e, portanto, não pode mais ser alterada
+func (t *Transaction) IsReconciled() bool {
+	return t.ReconciliationID != ""
+}
+
+// IsGenerated indica se a transação foi gerada por uma operação de investimento ou por um pagamento de empréstimo;
+// valor, data e conta dela acompanham a origem e não podem ser alterados nem anulados diretamente
+func (t *Transaction) IsGenerated() bool {
+	return t.InvestmentTradeID != "" || t.LoanPaymentID != ""
+}
+
+// TransactionSplit é uma linha do rateio de uma transação entre categorias
+type TransactionSplit struct {
+	CategoryID string `bson:"category_id"`
+	Amount     Money  `bson:"amount"`
+	Note       string `bson:"note,omitempty"`
+}
+
+// SplitsValid exige ao menos duas linhas, cada uma com categoria e valor positivo, somando o valor da transação.
+// Transações sem rateio são válidas.
+func (t *Transaction) SplitsValid() bool {
+	if len(t.Splits) == 0 {
+		return true
+	}
+	if len(t.Splits) < 2 {
+		return false
+	}
+	total := ZeroMoney
+	for _, split := range t.Splits {
+		if split.CategoryID == "" || !split.Amount.IsPositive() {
+			return false
+		}
+		total = total.Add(split.Amount)
+	}
+	return total.Cmp(t.Amount) == 0
+}
+
+// CategoryAmounts devolve quanto da transação cabe a cada categoria: as linhas do rateio ou o valor inteiro em CategoryID
+func (t *Transaction) CategoryAmounts() []TransactionSplit {
+	if len(t.Splits) > 0 {
+		return t.Splits
+	}
+	return []TransactionSplit{{CategoryID: t.CategoryID, Amount: t.Amount}}
+}
+
+// SplitCategoryIDs lista as categorias distintas do rateio, na ordem das linhas
+func (t *Transaction) SplitCategoryIDs() []string {
+	var ids []string
+	for _, split := range t.Splits {
+		if !containsString(ids, split.CategoryID) {
+			ids = append(ids, split.CategoryID)
+		}
+	}
+	return ids
 }
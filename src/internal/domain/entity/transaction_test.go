@@ -0,0 +1,52 @@
+package entity
+
+import "testing"
+
+func TestTransactionSplitsValid(t *testing.T) {
+	transaction := &Transaction{CategoryID: "groceries", Amount: MustParseMoney("150.00")}
+	if !transaction.SplitsValid() {
+		t.Fatalf("transação sem rateio deveria ser válida")
+	}
+
+	transaction.Splits = []TransactionSplit{
+		{CategoryID: "groceries", Amount: MustParseMoney("100.00")},
+		{CategoryID: "household", Amount: MustParseMoney("30.00")},
+		{CategoryID: "pharmacy", Amount: MustParseMoney("20.00"), Note: "vitaminas"},
+	}
+	if !transaction.SplitsValid() {
+		t.Fatalf("rateio que soma o total deveria ser válido")
+	}
+
+	invalid := map[string][]TransactionSplit{
+		"soma diferente": {{CategoryID: "groceries", Amount: MustParseMoney("100.00")}, {CategoryID: "household", Amount: MustParseMoney("49.99")}},
+		"uma linha":      {{CategoryID: "groceries", Amount: MustParseMoney("150.00")}},
+		"sem categoria":  {{CategoryID: "groceries", Amount: MustParseMoney("100.00")}, {Amount: MustParseMoney("50.00")}},
+		"valor zero":     {{CategoryID: "groceries", Amount: MustParseMoney("150.00")}, {CategoryID: "household", Amount: ZeroMoney}},
+	}
+	for name, splits := range invalid {
+		transaction.Splits = splits
+		if transaction.SplitsValid() {
+			t.Errorf("%s: rateio deveria ser inválido", name)
+		}
+	}
+}
+
+func TestTransactionCategoryAmounts(t *testing.T) {
+	transaction := &Transaction{CategoryID: "groceries", Amount: MoneyFromInt(150)}
+	amounts := transaction.CategoryAmounts()
+	if len(amounts) != 1 || amounts[0].CategoryID != "groceries" || amounts[0].Amount.Cmp(MoneyFromInt(150)) != 0 {
+		t.Fatalf("sem rateio o valor inteiro deveria ir para CategoryID: %+v", amounts)
+	}
+
+	transaction.Splits = []TransactionSplit{
+		{CategoryID: "groceries", Amount: MoneyFromInt(100)},
+		{CategoryID: "pharmacy", Amount: MoneyFromInt(30)},
+		{CategoryID: "groceries", Amount: MoneyFromInt(20)},
+	}
+	if amounts := transaction.CategoryAmounts(); len(amounts) != 3 || amounts[1].CategoryID != "pharmacy" {
+		t.Fatalf("com rateio cada linha deveria ir para a sua categoria: %+v", amounts)
+	}
+	if ids := transaction.SplitCategoryIDs(); len(ids) != 2 || ids[0] != "groceries" || ids[1] != "pharmacy" {
+		t.Fatalf("categorias distintas inesperadas: %v", ids)
+	}
+}
@@ -9,4 +9,13 @@ var (
 	ErrConflict        = errors.New("resource conflict")
 	ErrInternal        = errors.New("internal error")
 	ErrPayloadTooLarge = errors.New("payload too large")
+	ErrRateNotFound    = errors.New("exchange rate not found")
+	// ErrAccountClosed recusa lançamentos em contas encerradas; é distinto de ErrConflict para que rotinas
+	// idempotentes não confundam a recusa com "já gravado por outra instância"
+	ErrAccountClosed = errors.New("account closed")
 )
+
+// Is repassa para errors.Is, para que os pacotes que importam este como errors não precisem do pacote padrão
+func Is(err error, target error) bool {
+	return errors.Is(err, target)
+}
@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+// RateProvider é a fonte externa de cotações históricas (arquivo local, API de banco central etc.)
+type RateProvider interface {
+	// FetchRates devolve as cotações entre from e to; datas zeradas significam sem limite
+	FetchRates(ctx context.Context, from time.Time, to time.Time) ([]entity.ExchangeRate, error)
+}
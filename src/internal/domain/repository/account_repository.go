@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
 )
@@ -11,6 +12,17 @@ type AccountRepository interface {
 	Update(ctx context.Context, account *entity.Account) error
 	Delete(ctx context.Context, id string, userID string) error
 	GetByID(ctx context.Context, id string, userID string) (*entity.Account, error)
-	List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Account, error)
-	AdjustBalance(ctx context.Context, id string, userID string, amount float64) error
+	// List e ListAfter só devolvem contas encerradas com includeClosed
+	List(ctx context.Context, userID string, includeClosed bool, limit int64, offset int64) ([]*entity.Account, error)
+	// ListAfter lista por chave, dos mais recentes para os mais antigos, a partir do cursor (nil para a primeira página)
+	ListAfter(ctx context.Context, userID string, includeClosed bool, after *PageCursor, limit int64) ([]*entity.Account, error)
+	AdjustBalance(ctx context.Context, id string, userID string, amount entity.Money) error
+	// UpdateLoan grava o novo estado do empréstimo só se as parcelas pagas e o saldo devedor ainda forem os de
+	// expected; caso contrário devolve ErrConflict
+	UpdateLoan(ctx context.Context, id string, userID string, expected entity.Loan, loan *entity.Loan) error
+	// AdvanceSavingsAccrual move o início dos juros ainda não lançados de previous para next, sem tocar nos demais
+	// campos da conta; devolve ErrConflict se o valor gravado já não for previous
+	AdvanceSavingsAccrual(ctx context.Context, id string, userID string, previous time.Time, next time.Time) error
+	// ListAll percorre as contas de todos os usuários em ordem de ID, a partir de afterID (vazio para o início)
+	ListAll(ctx context.Context, afterID string, limit int64) ([]*entity.Account, error)
 }
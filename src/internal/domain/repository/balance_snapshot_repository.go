@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type BalanceSnapshotRepository interface {
+	// Upsert grava o snapshot substituindo o do mesmo dia
+	Upsert(ctx context.Context, snapshot *entity.BalanceSnapshot) error
+	// FindFrom devolve o snapshot mais antigo da conta com data a partir de date, se houver
+	FindFrom(ctx context.Context, userID string, accountID string, date time.Time) (*entity.BalanceSnapshot, error)
+	// DeleteFrom descarta os snapshots da conta com data a partir de date
+	DeleteFrom(ctx context.Context, userID string, accountID string, date time.Time) error
+}
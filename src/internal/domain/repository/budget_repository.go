@@ -12,6 +12,8 @@ type BudgetRepository interface {
 	Update(ctx context.Context, budget *entity.Budget) error
 	GetByID(ctx context.Context, id string, userID string) (*entity.Budget, error)
 	List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Budget, error)
-	UpdateSpent(ctx context.Context, id string, userID string, spent float64) error
+	// ListAfter lista por chave, dos mais recentes para os mais antigos, a partir do cursor (nil para a primeira página)
+	ListAfter(ctx context.Context, userID string, after *PageCursor, limit int64) ([]*entity.Budget, error)
+	UpdateSpent(ctx context.Context, id string, userID string, spent entity.Money) error
 	FindActiveByCategory(ctx context.Context, userID string, categoryID string, timestamp time.Time) ([]*entity.Budget, error)
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type CategorizationRuleRepository interface {
+	Create(ctx context.Context, rule *entity.CategorizationRule) error
+	Update(ctx context.Context, rule *entity.CategorizationRule) error
+	Delete(ctx context.Context, id string, userID string) error
+	GetByID(ctx context.Context, id string, userID string) (*entity.CategorizationRule, error)
+	List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.CategorizationRule, error)
+	// ListEnabled devolve as regras habilitadas do usuário em ordem de prioridade
+	ListEnabled(ctx context.Context, userID string) ([]*entity.CategorizationRule, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type ExchangeRateRepository interface {
+	Upsert(ctx context.Context, rate *entity.ExchangeRate) error
+	// FindOnOrBefore devolve a cotação mais recente do par com data menor ou igual a date (nil quando não há)
+	FindOnOrBefore(ctx context.Context, base entity.Currency, quote entity.Currency, date time.Time) (*entity.ExchangeRate, error)
+}
@@ -11,5 +11,7 @@ type GoalRepository interface {
 	Update(ctx context.Context, goal *entity.Goal) error
 	GetByID(ctx context.Context, id string, userID string) (*entity.Goal, error)
 	List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Goal, error)
-	UpdateProgress(ctx context.Context, id string, userID string, amount float64) error
+	// ListAfter lista por chave, dos mais recentes para os mais antigos, a partir do cursor (nil para a primeira página)
+	ListAfter(ctx context.Context, userID string, after *PageCursor, limit int64) ([]*entity.Goal, error)
+	UpdateProgress(ctx context.Context, id string, userID string, amount entity.Money) error
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type ImportBatchRepository interface {
+	Create(ctx context.Context, batch *entity.ImportBatch) error
+	Update(ctx context.Context, batch *entity.ImportBatch) error
+	GetByID(ctx context.Context, id string, userID string) (*entity.ImportBatch, error)
+	// DeleteByAccount remove todos os lotes de importação da conta
+	DeleteByAccount(ctx context.Context, userID string, accountID string) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type ImportProfileRepository interface {
+	Create(ctx context.Context, profile *entity.ImportProfile) error
+	Delete(ctx context.Context, id string, userID string) error
+	GetByID(ctx context.Context, id string, userID string) (*entity.ImportProfile, error)
+	List(ctx context.Context, userID string) ([]*entity.ImportProfile, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type InvestmentTradeRepository interface {
+	Create(ctx context.Context, trade *entity.InvestmentTrade) error
+	Delete(ctx context.Context, id string, userID string) error
+	GetByID(ctx context.Context, id string, userID string) (*entity.InvestmentTrade, error)
+	// ListByAccount devolve todas as operações da conta em ordem de data
+	ListByAccount(ctx context.Context, userID string, accountID string) ([]*entity.InvestmentTrade, error)
+	CountBySecurity(ctx context.Context, userID string, securityID string) (int64, error)
+	DeleteByAccount(ctx context.Context, userID string, accountID string) error
+}
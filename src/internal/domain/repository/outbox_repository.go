@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type OutboxRepository interface {
+	Create(ctx context.Context, event *entity.OutboxEvent) error
+	// ClaimPending reserva até limit eventos pendentes, em ordem de criação, pelo tempo de lease
+	ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.OutboxEvent, error)
+	MarkSent(ctx context.Context, id string, sentAt time.Time) error
+	MarkRetry(ctx context.Context, id string, attempts int, nextAttemptAt time.Time, lastError string) error
+	MarkFailed(ctx context.Context, id string, attempts int, lastError string) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import "time"
+
+// PageCursor identifica o último item de uma página na paginação por chave (keyset). Value é o valor do campo
+// de ordenação desse item e ID o seu _id, que desempata registros com o mesmo valor; Sort guarda a ordenação
+// em que o cursor foi gerado, já que ele não vale para outra.
+type PageCursor struct {
+	Sort  string `json:"s,omitempty"`
+	Value string `json:"v"`
+	ID    string `json:"id"`
+}
+
+// NewTimeCursor cria o cursor de listagens ordenadas por data
+func NewTimeCursor(sort string, value time.Time, id string) PageCursor {
+	return PageCursor{Sort: sort, Value: value.UTC().Format(time.RFC3339Nano), ID: id}
+}
+
+// Time interpreta o valor de um cursor criado por NewTimeCursor
+func (c PageCursor) Time() (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, c.Value)
+}
@@ -6,6 +6,6 @@ import (
 )
 
 type ProcessedTransactionRepository interface {
-	MarkProcessed(ctx context.Context, transactionID string, userID string, txnType string, processedAt time.Time) (bool, error)
-	Remove(ctx context.Context, transactionID string) error
+	MarkProcessed(ctx context.Context, eventID string, transactionID string, userID string, txnType string, processedAt time.Time) (bool, error)
+	Remove(ctx context.Context, eventID string) error
 }
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type ReconciliationRepository interface {
+	Create(ctx context.Context, reconciliation *entity.Reconciliation) error
+	Update(ctx context.Context, reconciliation *entity.Reconciliation) error
+	Delete(ctx context.Context, id string, userID string) error
+	GetByID(ctx context.Context, id string, userID string) (*entity.Reconciliation, error)
+	// GetOpen devolve a conciliação aberta da conta, se houver
+	GetOpen(ctx context.Context, userID string, accountID string) (*entity.Reconciliation, error)
+	// List devolve as conciliações da conta da data de extrato mais recente para a mais antiga
+	List(ctx context.Context, userID string, accountID string, limit int64, offset int64) ([]*entity.Reconciliation, error)
+	// DeleteByAccount remove todas as conciliações da conta
+	DeleteByAccount(ctx context.Context, userID string, accountID string) error
+}
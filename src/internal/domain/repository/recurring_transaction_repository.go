@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type RecurringTransactionRepository interface {
+	Create(ctx context.Context, recurring *entity.RecurringTransaction) error
+	Update(ctx context.Context, recurring *entity.RecurringTransaction) error
+	Delete(ctx context.Context, id string, userID string) error
+	GetByID(ctx context.Context, id string, userID string) (*entity.RecurringTransaction, error)
+	List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.RecurringTransaction, error)
+	// ListDue devolve recorrências ativas de todos os usuários com próxima ocorrência até now
+	ListDue(ctx context.Context, now time.Time, limit int64) ([]*entity.RecurringTransaction, error)
+	// DeleteByAccount remove todas as recorrências da conta
+	DeleteByAccount(ctx context.Context, userID string, accountID string) error
+}
@@ -8,5 +8,6 @@ import (
 )
 
 type ReportRepository interface {
-	AggregateSummary(ctx context.Context, userID string, from time.Time, to time.Time) (*entity.SummaryReport, error)
+	// LoadSummaryData carrega transações efetivas do período, categorias, orçamentos e metas do usuário
+	LoadSummaryData(ctx context.Context, userID string, from time.Time, to time.Time) (*entity.SummaryData, error)
 }
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type SecurityRepository interface {
+	Create(ctx context.Context, security *entity.Security) error
+	Update(ctx context.Context, security *entity.Security) error
+	Delete(ctx context.Context, id string, userID string) error
+	GetByID(ctx context.Context, id string, userID string) (*entity.Security, error)
+	GetBySymbol(ctx context.Context, userID string, symbol string) (*entity.Security, error)
+	List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Security, error)
+}
+
+type SecurityPriceRepository interface {
+	// Upsert grava o preço substituindo o do mesmo dia
+	Upsert(ctx context.Context, price *entity.SecurityPrice) error
+	// FindOnOrBefore devolve o preço mais recente do ativo até a data informada, se houver
+	FindOnOrBefore(ctx context.Context, userID string, securityID string, date time.Time) (*entity.SecurityPrice, error)
+	// List devolve os preços do ativo no período, do mais antigo para o mais recente
+	List(ctx context.Context, userID string, securityID string, from time.Time, to time.Time) ([]*entity.SecurityPrice, error)
+	DeleteBySecurity(ctx context.Context, userID string, securityID string) error
+}
@@ -7,10 +7,60 @@ import (
 	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
 )
 
+// TransactionSortField é o campo usado para ordenar a listagem de transações
+type TransactionSortField string
+
+const (
+	TransactionSortOccurredAt  TransactionSortField = "occurredAt"
+	TransactionSortAmount      TransactionSortField = "amount"
+	TransactionSortCreatedAt   TransactionSortField = "createdAt"
+	TransactionSortDescription TransactionSortField = "description"
+)
+
+// TransactionFilter restringe e ordena a listagem de transações; campos vazios não filtram.
+// Sem Statuses, transações anuladas só entram com IncludeVoided.
+type TransactionFilter struct {
+	From           time.Time
+	To             time.Time
+	IncludeVoided  bool
+	AccountIDs     []string
+	CategoryIDs    []string
+	Tags           []string
+	MatchAllTags   bool
+	MinAmount      *entity.Money
+	MaxAmount      *entity.Money
+	Statuses       []entity.TransactionStatus
+	Currencies     []entity.Currency
+	Search         string // busca textual na descrição
+	SortBy         TransactionSortField
+	SortDescending bool
+	After          *PageCursor // paginação por chave; com After o offset é ignorado
+}
+
 type TransactionRepository interface {
 	Create(ctx context.Context, transaction *entity.Transaction) error
+	CreateMany(ctx context.Context, transactions []*entity.Transaction) error
 	Update(ctx context.Context, transaction *entity.Transaction) error
+	Void(ctx context.Context, id string, userID string, reason string, voidedAt time.Time) error
 	GetByID(ctx context.Context, id string, userID string) (*entity.Transaction, error)
-	List(ctx context.Context, userID string, from time.Time, to time.Time, limit int64, offset int64) ([]*entity.Transaction, error)
+	GetByExternalRef(ctx context.Context, userID string, externalRef string) (*entity.Transaction, error)
+	List(ctx context.Context, userID string, filter TransactionFilter, limit int64, offset int64) ([]*entity.Transaction, error)
 	ListByCategory(ctx context.Context, userID string, categoryID string, from time.Time, to time.Time) ([]*entity.Transaction, error)
+	// FindDuplicateCandidates devolve transações não anuladas da conta com o mesmo valor no intervalo
+	FindDuplicateCandidates(ctx context.Context, userID string, accountID string, amount entity.Money, from time.Time, to time.Time) ([]*entity.Transaction, error)
+	// ListDuplicates devolve as transações marcadas como possíveis duplicatas que ainda aguardam revisão
+	ListDuplicates(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Transaction, error)
+	SetDuplicateOf(ctx context.Context, id string, userID string, duplicateOf string) error
+	// ListInstallments devolve as parcelas de uma compra parcelada em ordem de parcela
+	ListInstallments(ctx context.Context, userID string, groupID string) ([]*entity.Transaction, error)
+	// ListUnreconciled devolve as transações não anuladas da conta que ainda não pertencem a uma conciliação concluída
+	ListUnreconciled(ctx context.Context, userID string, accountID string) ([]*entity.Transaction, error)
+	// SetCleared marca ou desmarca as transações como conferidas; transações já conciliadas não são alteradas
+	SetCleared(ctx context.Context, userID string, ids []string, cleared bool) error
+	// MarkReconciled trava as transações na conciliação concluída
+	MarkReconciled(ctx context.Context, userID string, ids []string, reconciliationID string) error
+	// CountByAccount conta as transações da conta, inclusive as anuladas
+	CountByAccount(ctx context.Context, userID string, accountID string) (int64, error)
+	// DeleteByAccount remove todas as transações da conta
+	DeleteByAccount(ctx context.Context, userID string, accountID string) error
 }
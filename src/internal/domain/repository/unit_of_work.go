@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// UnitOfWork executa um conjunto de escritas de forma atômica.
+// O contexto recebido por fn carrega a transação e deve ser repassado aos repositórios;
+// qualquer erro retornado por fn desfaz todas as escritas realizadas.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -34,6 +34,7 @@ func NewAccountRepository(client *Client) (*AccountRepository, error) {
 			Keys: bson.D{
 				{Key: "user_id", Value: 1},
 				{Key: "created_at", Value: -1},
+				{Key: "_id", Value: -1},
 			},
 		},
 	}
@@ -60,6 +61,11 @@ func (r *AccountRepository) Update(ctx context.Context, account *entity.Account)
 		"type":        account.Type,
 		"currency":    account.Currency,
 		"description": account.Description,
+		"credit_card": account.CreditCard,
+		"investment":  account.Investment,
+		"loan":        account.Loan,
+		"savings":     account.Savings,
+		"closed_at":   account.ClosedAt,
 		"updated_at":  account.UpdatedAt,
 	}})
 	if err != nil {
@@ -100,16 +106,39 @@ func (r *AccountRepository) GetByID(ctx context.Context, id string, userID strin
 	return &account, nil
 }
 
-func (r *AccountRepository) List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Account, error) {
-	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+func (r *AccountRepository) List(ctx context.Context, userID string, includeClosed bool, limit int64, offset int64) ([]*entity.Account, error) {
+	opts := options.Find().SetSort(newestFirst)
 	if limit > 0 {
 		opts.SetLimit(limit)
 	}
 	if offset > 0 {
 		opts.SetSkip(offset)
 	}
+	query := bson.M{"user_id": userID}
+	// closed_at nulo ou ausente: conta aberta
+	if !includeClosed {
+		query["closed_at"] = nil
+	}
+	return r.find(ctx, query, opts)
+}
+
+func (r *AccountRepository) ListAfter(ctx context.Context, userID string, includeClosed bool, after *repository.PageCursor, limit int64) ([]*entity.Account, error) {
+	query, err := createdAtPage(userID, after)
+	if err != nil {
+		return nil, err
+	}
+	if !includeClosed {
+		query["closed_at"] = nil
+	}
+	opts := options.Find().SetSort(newestFirst)
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	return r.find(ctx, query, opts)
+}
 
-	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+func (r *AccountRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.Account, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +155,58 @@ func (r *AccountRepository) List(ctx context.Context, userID string, limit int64
 	return accounts, nil
 }
 
-func (r *AccountRepository) AdjustBalance(ctx context.Context, id string, userID string, amount float64) error {
+func (r *AccountRepository) ListAll(ctx context.Context, afterID string, limit int64) ([]*entity.Account, error) {
+	query := bson.M{}
+	if afterID != "" {
+		query["_id"] = bson.M{"$gt": afterID}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	return r.find(ctx, query, opts)
+}
+
+func (r *AccountRepository) UpdateLoan(ctx context.Context, id string, userID string, expected entity.Loan, loan *entity.Loan) error {
+	result, err := r.collection.UpdateOne(ctx, bson.M{
+		"_id":                    id,
+		"user_id":                userID,
+		"loan.paid_installments": expected.PaidInstallments,
+		"loan.outstanding":       expected.Outstanding,
+	}, bson.M{"$set": bson.M{
+		"loan":       loan,
+		"updated_at": time.Now().UTC(),
+	}})
+	if err != nil {
+		return err
+	}
+	// Outro pagamento gravado desde a leitura do empréstimo
+	if result.MatchedCount == 0 {
+		return domainErrors.ErrConflict
+	}
+	return nil
+}
+
+func (r *AccountRepository) AdvanceSavingsAccrual(ctx context.Context, id string, userID string, previous time.Time, next time.Time) error {
+	result, err := r.collection.UpdateOne(ctx, bson.M{
+		"_id":                     id,
+		"user_id":                 userID,
+		"savings.accrued_through": previous,
+	}, bson.M{"$set": bson.M{
+		"savings.accrued_through": next,
+		"updated_at":              time.Now().UTC(),
+	}})
+	if err != nil {
+		return err
+	}
+	// Outra execução já lançou o período ou o rendimento foi removido desde a leitura da conta
+	if result.MatchedCount == 0 {
+		return domainErrors.ErrConflict
+	}
+	return nil
+}
+
+func (r *AccountRepository) AdjustBalance(ctx context.Context, id string, userID string, amount entity.Money) error {
 	result, err := r.collection.UpdateOne(ctx, bson.M{
 		"_id":     id,
 		"user_id": userID,
@@ -0,0 +1,71 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+type BalanceSnapshotRepository struct {
+	collection *mongo.Collection
+}
+
+var _ repository.BalanceSnapshotRepository = (*BalanceSnapshotRepository)(nil)
+
+func NewBalanceSnapshotRepository(client *Client) (*BalanceSnapshotRepository, error) {
+	col := client.Collection("balance_snapshots")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "account_id", Value: 1},
+				{Key: "date", Value: 1},
+			},
+		},
+	}
+	if _, err := col.Indexes().CreateMany(ctx, indexModels); err != nil {
+		return nil, err
+	}
+
+	return &BalanceSnapshotRepository{collection: col}, nil
+}
+
+func (r *BalanceSnapshotRepository) Upsert(ctx context.Context, snapshot *entity.BalanceSnapshot) error {
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": snapshot.ID}, snapshot, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (r *BalanceSnapshotRepository) FindFrom(ctx context.Context, userID string, accountID string, date time.Time) (*entity.BalanceSnapshot, error) {
+	var snapshot entity.BalanceSnapshot
+	err := r.collection.FindOne(ctx, bson.M{
+		"user_id":    userID,
+		"account_id": accountID,
+		"date":       bson.M{"$gte": date},
+	}, options.FindOne().SetSort(bson.D{{Key: "date", Value: 1}})).Decode(&snapshot)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+func (r *BalanceSnapshotRepository) DeleteFrom(ctx context.Context, userID string, accountID string, date time.Time) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{
+		"user_id":    userID,
+		"account_id": accountID,
+		"date":       bson.M{"$gte": date},
+	})
+	return err
+}
@@ -30,6 +30,7 @@ func NewBudgetRepository(client *Client) *BudgetRepository {
 			Keys: bson.D{
 				{Key: "user_id", Value: 1},
 				{Key: "created_at", Value: -1},
+				{Key: "_id", Value: -1},
 			},
 		},
 		{
@@ -75,15 +76,30 @@ func (r *BudgetRepository) GetByID(ctx context.Context, id string, userID string
 }
 
 func (r *BudgetRepository) List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Budget, error) {
-	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	opts := options.Find().SetSort(newestFirst)
 	if limit > 0 {
 		opts.SetLimit(limit)
 	}
 	if offset > 0 {
 		opts.SetSkip(offset)
 	}
+	return r.find(ctx, bson.M{"user_id": userID}, opts)
+}
+
+func (r *BudgetRepository) ListAfter(ctx context.Context, userID string, after *repository.PageCursor, limit int64) ([]*entity.Budget, error) {
+	query, err := createdAtPage(userID, after)
+	if err != nil {
+		return nil, err
+	}
+	opts := options.Find().SetSort(newestFirst)
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	return r.find(ctx, query, opts)
+}
 
-	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+func (r *BudgetRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.Budget, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +116,7 @@ func (r *BudgetRepository) List(ctx context.Context, userID string, limit int64,
 	return budgets, nil
 }
 
-func (r *BudgetRepository) UpdateSpent(ctx context.Context, id string, userID string, spent float64) error {
+func (r *BudgetRepository) UpdateSpent(ctx context.Context, id string, userID string, spent entity.Money) error {
 	result, err := r.collection.UpdateOne(ctx, bson.M{
 		"_id":     id,
 		"user_id": userID,
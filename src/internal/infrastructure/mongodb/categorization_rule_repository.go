@@ -0,0 +1,127 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+type CategorizationRuleRepository struct {
+	collection *mongo.Collection
+}
+
+var _ repository.CategorizationRuleRepository = (*CategorizationRuleRepository)(nil)
+
+func NewCategorizationRuleRepository(client *Client) (*CategorizationRuleRepository, error) {
+	col := client.Collection("categorization_rules")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "priority", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+		},
+	}
+	if _, err := col.Indexes().CreateMany(ctx, indexModels); err != nil {
+		return nil, err
+	}
+
+	return &CategorizationRuleRepository{collection: col}, nil
+}
+
+func (r *CategorizationRuleRepository) Create(ctx context.Context, rule *entity.CategorizationRule) error {
+	_, err := r.collection.InsertOne(ctx, rule)
+	return err
+}
+
+func (r *CategorizationRuleRepository) Update(ctx context.Context, rule *entity.CategorizationRule) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{
+		"_id":     rule.ID,
+		"user_id": rule.UserID,
+	}, rule)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domainErrors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *CategorizationRuleRepository) Delete(ctx context.Context, id string, userID string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domainErrors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *CategorizationRuleRepository) GetByID(ctx context.Context, id string, userID string) (*entity.CategorizationRule, error) {
+	var rule entity.CategorizationRule
+	err := r.collection.FindOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+	}).Decode(&rule)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *CategorizationRuleRepository) List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.CategorizationRule, error) {
+	opts := options.Find().SetSort(priorityOrder())
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	if offset > 0 {
+		opts.SetSkip(offset)
+	}
+	return r.find(ctx, bson.M{"user_id": userID}, opts)
+}
+
+func (r *CategorizationRuleRepository) ListEnabled(ctx context.Context, userID string) ([]*entity.CategorizationRule, error) {
+	return r.find(ctx, bson.M{"user_id": userID, "enabled": true}, options.Find().SetSort(priorityOrder()))
+}
+
+func priorityOrder() bson.D {
+	return bson.D{{Key: "priority", Value: 1}, {Key: "created_at", Value: 1}}
+}
+
+func (r *CategorizationRuleRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.CategorizationRule, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []*entity.CategorizationRule
+	for cursor.Next(ctx) {
+		var rule entity.CategorizationRule
+		if err := cursor.Decode(&rule); err != nil {
+			return nil, err
+		}
+		result = append(result, &rule)
+	}
+	return result, nil
+}
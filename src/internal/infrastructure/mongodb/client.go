@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -36,6 +37,26 @@ func (c *Client) Collection(name string) *mongo.Collection {
 	return c.database.Collection(name)
 }
 
+func (c *Client) StartSession() (mongo.Session, error) {
+	return c.client.StartSession()
+}
+
+// SupportsTransactions verifica se o servidor é membro de replica set ou mongos,
+// requisito do MongoDB para transações multi-documento
+func (c *Client) SupportsTransactions(ctx context.Context) bool {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var hello bson.M
+	if err := c.database.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return false
+	}
+	if _, ok := hello["setName"]; ok {
+		return true
+	}
+	return hello["msg"] == "isdbgrid"
+}
+
 func (c *Client) Close(ctx context.Context) error {
 	return c.client.Disconnect(ctx)
 }
@@ -0,0 +1,84 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+type ExchangeRateRepository struct {
+	collection *mongo.Collection
+}
+
+var _ repository.ExchangeRateRepository = (*ExchangeRateRepository)(nil)
+
+func NewExchangeRateRepository(client *Client) (*ExchangeRateRepository, error) {
+	col := client.Collection("exchange_rates")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	indexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "base_currency", Value: 1},
+			{Key: "quote_currency", Value: 1},
+			{Key: "date", Value: -1},
+		},
+		Options: options.Index().SetUnique(true),
+	}
+	if _, err := col.Indexes().CreateOne(ctx, indexModel); err != nil {
+		return nil, err
+	}
+
+	return &ExchangeRateRepository{collection: col}, nil
+}
+
+// Upsert grava a cotação do dia substituindo a anterior do mesmo par e data
+func (r *ExchangeRateRepository) Upsert(ctx context.Context, rate *entity.ExchangeRate) error {
+	now := time.Now().UTC()
+	id := rate.ID
+	if id == "" {
+		id = uuid.NewString()
+	}
+	filter := bson.M{
+		"base_currency":  rate.BaseCurrency,
+		"quote_currency": rate.QuoteCurrency,
+		"date":           entity.ExchangeRateDate(rate.Date),
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"rate":       rate.Rate,
+			"source":     rate.Source,
+			"updated_at": now,
+		},
+		"$setOnInsert": bson.M{
+			"_id":        id,
+			"created_at": now,
+		},
+	}
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+func (r *ExchangeRateRepository) FindOnOrBefore(ctx context.Context, base entity.Currency, quote entity.Currency, date time.Time) (*entity.ExchangeRate, error) {
+	var rate entity.ExchangeRate
+	err := r.collection.FindOne(ctx, bson.M{
+		"base_currency":  base,
+		"quote_currency": quote,
+		"date":           bson.M{"$lte": entity.ExchangeRateDate(date)},
+	}, options.FindOne().SetSort(bson.D{{Key: "date", Value: -1}})).Decode(&rate)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
@@ -30,6 +30,7 @@ func NewGoalRepository(client *Client) *GoalRepository {
 			Keys: bson.D{
 				{Key: "user_id", Value: 1},
 				{Key: "created_at", Value: -1},
+				{Key: "_id", Value: -1},
 			},
 		},
 	}
@@ -67,15 +68,30 @@ func (r *GoalRepository) GetByID(ctx context.Context, id string, userID string)
 }
 
 func (r *GoalRepository) List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Goal, error) {
-	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	opts := options.Find().SetSort(newestFirst)
 	if limit > 0 {
 		opts.SetLimit(limit)
 	}
 	if offset > 0 {
 		opts.SetSkip(offset)
 	}
+	return r.find(ctx, bson.M{"user_id": userID}, opts)
+}
+
+func (r *GoalRepository) ListAfter(ctx context.Context, userID string, after *repository.PageCursor, limit int64) ([]*entity.Goal, error) {
+	query, err := createdAtPage(userID, after)
+	if err != nil {
+		return nil, err
+	}
+	opts := options.Find().SetSort(newestFirst)
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	return r.find(ctx, query, opts)
+}
 
-	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+func (r *GoalRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.Goal, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +108,7 @@ func (r *GoalRepository) List(ctx context.Context, userID string, limit int64, o
 	return goals, nil
 }
 
-func (r *GoalRepository) UpdateProgress(ctx context.Context, id string, userID string, amount float64) error {
+func (r *GoalRepository) UpdateProgress(ctx context.Context, id string, userID string, amount entity.Money) error {
 	result, err := r.collection.UpdateOne(ctx, bson.M{
 		"_id":     id,
 		"user_id": userID,
@@ -0,0 +1,85 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+type ImportBatchRepository struct {
+	collection *mongo.Collection
+}
+
+var _ repository.ImportBatchRepository = (*ImportBatchRepository)(nil)
+
+func NewImportBatchRepository(client *Client) (*ImportBatchRepository, error) {
+	col := client.Collection("import_batches")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+		},
+		{
+			// Prévias não confirmadas são removidas pelo TTL; lotes confirmados não têm expires_at
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+	if _, err := col.Indexes().CreateMany(ctx, indexModels); err != nil {
+		return nil, err
+	}
+
+	return &ImportBatchRepository{collection: col}, nil
+}
+
+func (r *ImportBatchRepository) Create(ctx context.Context, batch *entity.ImportBatch) error {
+	_, err := r.collection.InsertOne(ctx, batch)
+	return err
+}
+
+func (r *ImportBatchRepository) Update(ctx context.Context, batch *entity.ImportBatch) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{
+		"_id":     batch.ID,
+		"user_id": batch.UserID,
+	}, batch)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domainErrors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *ImportBatchRepository) GetByID(ctx context.Context, id string, userID string) (*entity.ImportBatch, error) {
+	var batch entity.ImportBatch
+	err := r.collection.FindOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+	}).Decode(&batch)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &batch, nil
+}
+
+func (r *ImportBatchRepository) DeleteByAccount(ctx context.Context, userID string, accountID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID, "account_id": accountID})
+	return err
+}
@@ -0,0 +1,91 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+type ImportProfileRepository struct {
+	collection *mongo.Collection
+}
+
+var _ repository.ImportProfileRepository = (*ImportProfileRepository)(nil)
+
+func NewImportProfileRepository(client *Client) (*ImportProfileRepository, error) {
+	col := client.Collection("import_profiles")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	indexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "name", Value: 1},
+		},
+	}
+	if _, err := col.Indexes().CreateOne(ctx, indexModel); err != nil {
+		return nil, err
+	}
+
+	return &ImportProfileRepository{collection: col}, nil
+}
+
+func (r *ImportProfileRepository) Create(ctx context.Context, profile *entity.ImportProfile) error {
+	_, err := r.collection.InsertOne(ctx, profile)
+	return err
+}
+
+func (r *ImportProfileRepository) Delete(ctx context.Context, id string, userID string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domainErrors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *ImportProfileRepository) GetByID(ctx context.Context, id string, userID string) (*entity.ImportProfile, error) {
+	var profile entity.ImportProfile
+	err := r.collection.FindOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+	}).Decode(&profile)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *ImportProfileRepository) List(ctx context.Context, userID string) ([]*entity.ImportProfile, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []*entity.ImportProfile
+	for cursor.Next(ctx) {
+		var profile entity.ImportProfile
+		if err := cursor.Decode(&profile); err != nil {
+			return nil, err
+		}
+		result = append(result, &profile)
+	}
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+type InvestmentTradeRepository struct {
+	collection *mongo.Collection
+}
+
+var _ repository.InvestmentTradeRepository = (*InvestmentTradeRepository)(nil)
+
+func NewInvestmentTradeRepository(client *Client) (*InvestmentTradeRepository, error) {
+	col := client.Collection("investment_trades")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "account_id", Value: 1},
+				{Key: "occurred_at", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "security_id", Value: 1}},
+		},
+	}
+	if _, err := col.Indexes().CreateMany(ctx, indexModels); err != nil {
+		return nil, err
+	}
+	return &InvestmentTradeRepository{collection: col}, nil
+}
+
+func (r *InvestmentTradeRepository) Create(ctx context.Context, trade *entity.InvestmentTrade) error {
+	_, err := r.collection.InsertOne(ctx, trade)
+	return err
+}
+
+func (r *InvestmentTradeRepository) Delete(ctx context.Context, id string, userID string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domainErrors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *InvestmentTradeRepository) GetByID(ctx context.Context, id string, userID string) (*entity.InvestmentTrade, error) {
+	var trade entity.InvestmentTrade
+	err := r.collection.FindOne(ctx, bson.M{"_id": id, "user_id": userID}).Decode(&trade)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &trade, nil
+}
+
+func (r *InvestmentTradeRepository) ListByAccount(ctx context.Context, userID string, accountID string) ([]*entity.InvestmentTrade, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{
+		"user_id":    userID,
+		"account_id": accountID,
+	}, options.Find().SetSort(bson.D{{Key: "occurred_at", Value: 1}, {Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []*entity.InvestmentTrade
+	for cursor.Next(ctx) {
+		var trade entity.InvestmentTrade
+		if err := cursor.Decode(&trade); err != nil {
+			return nil, err
+		}
+		result = append(result, &trade)
+	}
+	return result, nil
+}
+
+func (r *InvestmentTradeRepository) CountBySecurity(ctx context.Context, userID string, securityID string) (int64, error) {
+	return r.collection.CountDocuments(ctx, bson.M{"user_id": userID, "security_id": securityID})
+}
+
+func (r *InvestmentTradeRepository) DeleteByAccount(ctx context.Context, userID string, accountID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID, "account_id": accountID})
+	return err
+}
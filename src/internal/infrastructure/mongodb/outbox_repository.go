@@ -0,0 +1,117 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+// sentEventRetention define por quanto tempo eventos já publicados ficam no outbox para auditoria
+const sentEventRetention = 7 * 24 * time.Hour
+
+type OutboxRepository struct {
+	collection *mongo.Collection
+}
+
+var _ repository.OutboxRepository = (*OutboxRepository)(nil)
+
+func NewOutboxRepository(client *Client) (*OutboxRepository, error) {
+	col := client.Collection("outbox")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "next_attempt_at", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+		},
+		{
+			Keys:    bson.D{{Key: "sent_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(sentEventRetention.Seconds())),
+		},
+	}
+	if _, err := col.Indexes().CreateMany(ctx, indexModels); err != nil {
+		return nil, err
+	}
+
+	return &OutboxRepository{collection: col}, nil
+}
+
+func (r *OutboxRepository) Create(ctx context.Context, event *entity.OutboxEvent) error {
+	_, err := r.collection.InsertOne(ctx, event)
+	return err
+}
+
+// ClaimPending reserva os eventos um a um com findOneAndUpdate para que várias instâncias
+// da API não publiquem o mesmo evento ao mesmo tempo
+func (r *OutboxRepository) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.OutboxEvent, error) {
+	filter := bson.M{
+		"status":          entity.OutboxStatusPending,
+		"next_attempt_at": bson.M{"$lte": now},
+		"$or": []bson.M{
+			{"locked_until": bson.M{"$exists": false}},
+			{"locked_until": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"locked_until": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	events := make([]*entity.OutboxEvent, 0, limit)
+	for len(events) < limit {
+		var event entity.OutboxEvent
+		err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&event)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			break
+		}
+		if err != nil {
+			return events, err
+		}
+		events = append(events, &event)
+	}
+	return events, nil
+}
+
+func (r *OutboxRepository) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	_, err := r.collection.UpdateByID(ctx, id, bson.M{
+		"$set":   bson.M{"status": entity.OutboxStatusSent, "sent_at": sentAt},
+		"$inc":   bson.M{"attempts": 1},
+		"$unset": bson.M{"locked_until": "", "last_error": ""},
+	})
+	return err
+}
+
+func (r *OutboxRepository) MarkRetry(ctx context.Context, id string, attempts int, nextAttemptAt time.Time, lastError string) error {
+	_, err := r.collection.UpdateByID(ctx, id, bson.M{
+		"$set": bson.M{
+			"attempts":        attempts,
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      lastError,
+		},
+		"$unset": bson.M{"locked_until": ""},
+	})
+	return err
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id string, attempts int, lastError string) error {
+	_, err := r.collection.UpdateByID(ctx, id, bson.M{
+		"$set": bson.M{
+			"status":     entity.OutboxStatusFailed,
+			"attempts":   attempts,
+			"last_error": lastError,
+		},
+		"$unset": bson.M{"locked_until": ""},
+	})
+	return err
+}
@@ -0,0 +1,39 @@
+package mongodb
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+// newestFirst ordena por created_at decrescente com _id como desempate, a ordem usada pela paginação por chave
+var newestFirst = bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}
+
+// keysetFilter devolve o critério que continua a listagem depois do item do cursor na ordenação (field, _id)
+func keysetFilter(field string, value interface{}, id string, descending bool) bson.M {
+	operator := "$gt"
+	if descending {
+		operator = "$lt"
+	}
+	return bson.M{"$or": bson.A{
+		bson.M{field: bson.M{operator: value}},
+		bson.M{field: value, "_id": bson.M{operator: id}},
+	}}
+}
+
+// createdAtPage monta a consulta das listagens por chave ordenadas por newestFirst
+func createdAtPage(userID string, after *repository.PageCursor) (bson.M, error) {
+	query := bson.M{"user_id": userID}
+	if after == nil {
+		return query, nil
+	}
+	createdAt, err := after.Time()
+	if err != nil {
+		return nil, domainErrors.ErrInvalidInput
+	}
+	for key, value := range keysetFilter("created_at", createdAt, after.ID, true) {
+		query[key] = value
+	}
+	return query, nil
+}
@@ -22,9 +22,11 @@ func NewProcessedTransactionRepository(client *Client) *ProcessedTransactionRepo
 	return &ProcessedTransactionRepository{collection: col}
 }
 
-func (r *ProcessedTransactionRepository) MarkProcessed(ctx context.Context, transactionID string, userID string, txnType string, processedAt time.Time) (bool, error) {
+// MarkProcessed registra o evento processado; o _id é o identificador do evento para que
+// estornos da mesma transação não sejam descartados como duplicados
+func (r *ProcessedTransactionRepository) MarkProcessed(ctx context.Context, eventID string, transactionID string, userID string, txnType string, processedAt time.Time) (bool, error) {
 	doc := entity.ProcessedTransaction{
-		ID:            transactionID,
+		ID:            eventID,
 		TransactionID: transactionID,
 		UserID:        userID,
 		Type:          txnType,
@@ -41,7 +43,7 @@ func (r *ProcessedTransactionRepository) MarkProcessed(ctx context.Context, tran
 	return true, nil
 }
 
-func (r *ProcessedTransactionRepository) Remove(ctx context.Context, transactionID string) error {
-	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": transactionID})
+func (r *ProcessedTransactionRepository) Remove(ctx context.Context, eventID string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": eventID})
 	return err
 }
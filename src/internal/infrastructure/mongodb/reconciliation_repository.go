@@ -0,0 +1,143 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+type ReconciliationRepository struct {
+	collection *mongo.Collection
+}
+
+var _ repository.ReconciliationRepository = (*ReconciliationRepository)(nil)
+
+func NewReconciliationRepository(client *Client) (*ReconciliationRepository, error) {
+	col := client.Collection("reconciliations")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "account_id", Value: 1},
+				{Key: "statement_date", Value: -1},
+			},
+		},
+		{
+			// No máximo uma conciliação aberta por conta
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "account_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"status": entity.ReconciliationStatusOpen}),
+		},
+	}
+	if _, err := col.Indexes().CreateMany(ctx, indexModels); err != nil {
+		return nil, err
+	}
+
+	return &ReconciliationRepository{collection: col}, nil
+}
+
+func (r *ReconciliationRepository) Create(ctx context.Context, reconciliation *entity.Reconciliation) error {
+	_, err := r.collection.InsertOne(ctx, reconciliation)
+	if mongo.IsDuplicateKeyError(err) {
+		return domainErrors.ErrConflict
+	}
+	return err
+}
+
+func (r *ReconciliationRepository) Update(ctx context.Context, reconciliation *entity.Reconciliation) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{
+		"_id":     reconciliation.ID,
+		"user_id": reconciliation.UserID,
+	}, reconciliation)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domainErrors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *ReconciliationRepository) Delete(ctx context.Context, id string, userID string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domainErrors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *ReconciliationRepository) GetByID(ctx context.Context, id string, userID string) (*entity.Reconciliation, error) {
+	return r.findOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+	})
+}
+
+func (r *ReconciliationRepository) GetOpen(ctx context.Context, userID string, accountID string) (*entity.Reconciliation, error) {
+	return r.findOne(ctx, bson.M{
+		"user_id":    userID,
+		"account_id": accountID,
+		"status":     entity.ReconciliationStatusOpen,
+	})
+}
+
+func (r *ReconciliationRepository) List(ctx context.Context, userID string, accountID string, limit int64, offset int64) ([]*entity.Reconciliation, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "statement_date", Value: -1}, {Key: "created_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	if offset > 0 {
+		opts.SetSkip(offset)
+	}
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID, "account_id": accountID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []*entity.Reconciliation
+	for cursor.Next(ctx) {
+		var reconciliation entity.Reconciliation
+		if err := cursor.Decode(&reconciliation); err != nil {
+			return nil, err
+		}
+		result = append(result, &reconciliation)
+	}
+	return result, nil
+}
+
+func (r *ReconciliationRepository) DeleteByAccount(ctx context.Context, userID string, accountID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID, "account_id": accountID})
+	return err
+}
+
+func (r *ReconciliationRepository) findOne(ctx context.Context, filter bson.M) (*entity.Reconciliation, error) {
+	var reconciliation entity.Reconciliation
+	err := r.collection.FindOne(ctx, filter).Decode(&reconciliation)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &reconciliation, nil
+}
@@ -0,0 +1,144 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+type RecurringTransactionRepository struct {
+	collection *mongo.Collection
+}
+
+var _ repository.RecurringTransactionRepository = (*RecurringTransactionRepository)(nil)
+
+func NewRecurringTransactionRepository(client *Client) (*RecurringTransactionRepository, error) {
+	col := client.Collection("recurring_transactions")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "next_occurrence", Value: 1},
+			},
+		},
+	}
+	if _, err := col.Indexes().CreateMany(ctx, indexModels); err != nil {
+		return nil, err
+	}
+
+	return &RecurringTransactionRepository{collection: col}, nil
+}
+
+func (r *RecurringTransactionRepository) Create(ctx context.Context, recurring *entity.RecurringTransaction) error {
+	_, err := r.collection.InsertOne(ctx, recurring)
+	return err
+}
+
+func (r *RecurringTransactionRepository) Update(ctx context.Context, recurring *entity.RecurringTransaction) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{
+		"_id":     recurring.ID,
+		"user_id": recurring.UserID,
+	}, recurring)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domainErrors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *RecurringTransactionRepository) Delete(ctx context.Context, id string, userID string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domainErrors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *RecurringTransactionRepository) GetByID(ctx context.Context, id string, userID string) (*entity.RecurringTransaction, error) {
+	var recurring entity.RecurringTransaction
+	err := r.collection.FindOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+	}).Decode(&recurring)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &recurring, nil
+}
+
+func (r *RecurringTransactionRepository) List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.RecurringTransaction, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	if offset > 0 {
+		opts.SetSkip(offset)
+	}
+	return r.find(ctx, bson.M{"user_id": userID}, opts)
+}
+
+func (r *RecurringTransactionRepository) ListDue(ctx context.Context, now time.Time, limit int64) ([]*entity.RecurringTransaction, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "next_occurrence", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	return r.find(ctx, bson.M{
+		"status":          entity.RecurringStatusActive,
+		"next_occurrence": bson.M{"$lte": now},
+	}, opts)
+}
+
+func (r *RecurringTransactionRepository) DeleteByAccount(ctx context.Context, userID string, accountID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID, "account_id": accountID})
+	return err
+}
+
+func (r *RecurringTransactionRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.RecurringTransaction, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []*entity.RecurringTransaction
+	for cursor.Next(ctx) {
+		var recurring entity.RecurringTransaction
+		if err := cursor.Decode(&recurring); err != nil {
+			return nil, err
+		}
+		result = append(result, &recurring)
+	}
+	// Um cursor interrompido no meio devolveria um lote incompleto como se fosse o resultado inteiro
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -20,7 +20,7 @@ func NewReportRepository(client *Client) *ReportRepository {
 	return &ReportRepository{client: client}
 }
 
-func (r *ReportRepository) AggregateSummary(ctx context.Context, userID string, from time.Time, to time.Time) (*entity.SummaryReport, error) {
+func (r *ReportRepository) LoadSummaryData(ctx context.Context, userID string, from time.Time, to time.Time) (*entity.SummaryData, error) {
 	transactions, err := r.fetchTransactions(ctx, userID, from, to)
 	if err != nil {
 		return nil, err
@@ -38,51 +38,12 @@ func (r *ReportRepository) AggregateSummary(ctx context.Context, userID string,
 		return nil, err
 	}
 
-	report := &entity.SummaryReport{
-		SpendingByCategory: map[string]float64{},
-		BudgetUsage:        map[string]float64{},
-		GoalProgress:       map[string]float64{},
-	}
-
-	for _, transaction := range transactions {
-		category := categories[transaction.CategoryID]
-		if category.Type == entity.CategoryTypeIncome {
-			report.TotalIncome += transaction.Amount
-		} else {
-			report.TotalExpense += transaction.Amount
-			name := category.Name
-			if name == "" {
-				name = transaction.CategoryID
-			}
-			report.SpendingByCategory[name] += transaction.Amount
-		}
-	}
-	report.NetBalance = report.TotalIncome - report.TotalExpense
-
-	for _, budget := range budgets {
-		if budget.Amount == 0 {
-			continue
-		}
-		category := categories[budget.CategoryID]
-		name := category.Name
-		if name == "" {
-			name = budget.CategoryID
-		}
-		report.BudgetUsage[name] = (budget.Spent / budget.Amount) * 100
-	}
-
-	for _, goal := range goals {
-		if goal.TargetAmount == 0 {
-			continue
-		}
-		name := goal.Name
-		if name == "" {
-			name = goal.ID
-		}
-		report.GoalProgress[name] = (goal.CurrentAmount / goal.TargetAmount) * 100
-	}
-
-	return report, nil
+	return &entity.SummaryData{
+		Transactions: transactions,
+		Categories:   categories,
+		Budgets:      budgets,
+		Goals:        goals,
+	}, nil
 }
 
 func (r *ReportRepository) fetchTransactions(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.Transaction, error) {
@@ -93,6 +54,9 @@ func (r *ReportRepository) fetchTransactions(ctx context.Context, userID string,
 			"$gte": from,
 			"$lte": to,
 		},
+		// Transferências apenas movem dinheiro entre contas e não são receita nem despesa
+		"type":   bson.M{"$nin": entity.TransferTransactionTypes},
+		"status": bson.M{"$ne": entity.TransactionStatusVoided},
 	})
 	if err != nil {
 		return nil, err
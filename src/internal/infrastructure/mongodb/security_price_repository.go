@@ -0,0 +1,88 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+type SecurityPriceRepository struct {
+	collection *mongo.Collection
+}
+
+var _ repository.SecurityPriceRepository = (*SecurityPriceRepository)(nil)
+
+func NewSecurityPriceRepository(client *Client) (*SecurityPriceRepository, error) {
+	col := client.Collection("security_prices")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "security_id", Value: 1},
+				{Key: "date", Value: -1},
+			},
+		},
+	}
+	if _, err := col.Indexes().CreateMany(ctx, indexModels); err != nil {
+		return nil, err
+	}
+	return &SecurityPriceRepository{collection: col}, nil
+}
+
+func (r *SecurityPriceRepository) Upsert(ctx context.Context, price *entity.SecurityPrice) error {
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": price.ID}, price, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (r *SecurityPriceRepository) FindOnOrBefore(ctx context.Context, userID string, securityID string, date time.Time) (*entity.SecurityPrice, error) {
+	var price entity.SecurityPrice
+	err := r.collection.FindOne(ctx, bson.M{
+		"user_id":     userID,
+		"security_id": securityID,
+		"date":        bson.M{"$lte": date},
+	}, options.FindOne().SetSort(bson.D{{Key: "date", Value: -1}})).Decode(&price)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &price, nil
+}
+
+func (r *SecurityPriceRepository) List(ctx context.Context, userID string, securityID string, from time.Time, to time.Time) ([]*entity.SecurityPrice, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{
+		"user_id":     userID,
+		"security_id": securityID,
+		"date":        bson.M{"$gte": from, "$lte": to},
+	}, options.Find().SetSort(bson.D{{Key: "date", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []*entity.SecurityPrice
+	for cursor.Next(ctx) {
+		var price entity.SecurityPrice
+		if err := cursor.Decode(&price); err != nil {
+			return nil, err
+		}
+		result = append(result, &price)
+	}
+	return result, nil
+}
+
+func (r *SecurityPriceRepository) DeleteBySecurity(ctx context.Context, userID string, securityID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID, "security_id": securityID})
+	return err
+}
@@ -0,0 +1,122 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+type SecurityRepository struct {
+	collection *mongo.Collection
+}
+
+var _ repository.SecurityRepository = (*SecurityRepository)(nil)
+
+func NewSecurityRepository(client *Client) (*SecurityRepository, error) {
+	col := client.Collection("securities")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "symbol", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}
+	if _, err := col.Indexes().CreateMany(ctx, indexModels); err != nil {
+		return nil, err
+	}
+	return &SecurityRepository{collection: col}, nil
+}
+
+func (r *SecurityRepository) Create(ctx context.Context, security *entity.Security) error {
+	_, err := r.collection.InsertOne(ctx, security)
+	if mongo.IsDuplicateKeyError(err) {
+		return domainErrors.ErrConflict
+	}
+	return err
+}
+
+func (r *SecurityRepository) Update(ctx context.Context, security *entity.Security) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{
+		"_id":     security.ID,
+		"user_id": security.UserID,
+	}, security)
+	if mongo.IsDuplicateKeyError(err) {
+		return domainErrors.ErrConflict
+	}
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domainErrors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *SecurityRepository) Delete(ctx context.Context, id string, userID string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domainErrors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *SecurityRepository) GetByID(ctx context.Context, id string, userID string) (*entity.Security, error) {
+	return r.findOne(ctx, bson.M{"_id": id, "user_id": userID})
+}
+
+func (r *SecurityRepository) GetBySymbol(ctx context.Context, userID string, symbol string) (*entity.Security, error) {
+	return r.findOne(ctx, bson.M{"user_id": userID, "symbol": symbol})
+}
+
+func (r *SecurityRepository) List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Security, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "symbol", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	if offset > 0 {
+		opts.SetSkip(offset)
+	}
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []*entity.Security
+	for cursor.Next(ctx) {
+		var security entity.Security
+		if err := cursor.Decode(&security); err != nil {
+			return nil, err
+		}
+		result = append(result, &security)
+	}
+	return result, nil
+}
+
+func (r *SecurityRepository) findOne(ctx context.Context, filter bson.M) (*entity.Security, error) {
+	var security entity.Security
+	err := r.collection.FindOne(ctx, filter).Decode(&security)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &security, nil
+}
@@ -10,6 +10,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
 	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
 )
 
@@ -29,6 +30,7 @@ func NewTransactionRepository(client *Client) (*TransactionRepository, error) {
 			Keys: bson.D{
 				{Key: "user_id", Value: 1},
 				{Key: "occurred_at", Value: -1},
+				{Key: "_id", Value: -1},
 			},
 		},
 		{
@@ -37,6 +39,84 @@ func NewTransactionRepository(client *Client) (*TransactionRepository, error) {
 				{Key: "occurred_at", Value: -1},
 			},
 		},
+		{
+			Keys:    bson.D{{Key: "transfer_id", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			// external_ref identifica transações geradas automaticamente e impede gravá-las duas vezes
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "external_ref", Value: 1},
+			},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"external_ref": bson.M{"$gt": ""}}),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "account_id", Value: 1},
+				{Key: "occurred_at", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "category_id", Value: 1},
+				{Key: "occurred_at", Value: -1},
+			},
+		},
+		{
+			// Transações rateadas aparecem no filtro de categoria de cada linha do rateio
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "splits.category_id", Value: 1},
+				{Key: "occurred_at", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "tags", Value: 1},
+				{Key: "occurred_at", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "amount", Value: 1},
+			},
+		},
+		{
+			// Busca textual na descrição; o prefixo user_id restringe a busca ao índice do próprio usuário
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "description", Value: "text"},
+			},
+			Options: options.Index().SetDefaultLanguage("portuguese"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "duplicate_of", Value: 1},
+			},
+			Options: options.Index().SetPartialFilterExpression(bson.M{"duplicate_of": bson.M{"$gt": ""}}),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "installment.group_id", Value: 1},
+				{Key: "installment.number", Value: 1},
+			},
+			Options: options.Index().SetPartialFilterExpression(bson.M{"installment.group_id": bson.M{"$exists": true}}),
+		},
+		{
+			// Conciliação: transações da conta ainda não travadas
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "account_id", Value: 1},
+				{Key: "reconciliation_id", Value: 1},
+			},
+		},
 	}
 	if _, err := col.Indexes().CreateMany(ctx, indexModels); err != nil {
 		return nil, err
@@ -47,6 +127,24 @@ func NewTransactionRepository(client *Client) (*TransactionRepository, error) {
 
 func (r *TransactionRepository) Create(ctx context.Context, transaction *entity.Transaction) error {
 	_, err := r.collection.InsertOne(ctx, transaction)
+	return conflictOnDuplicate(err)
+}
+
+func (r *TransactionRepository) CreateMany(ctx context.Context, transactions []*entity.Transaction) error {
+	documents := make([]any, 0, len(transactions))
+	for _, transaction := range transactions {
+		documents = append(documents, transaction)
+	}
+	_, err := r.collection.InsertMany(ctx, documents)
+	return conflictOnDuplicate(err)
+}
+
+// conflictOnDuplicate traduz violações dos índices únicos (ID ou external_ref), inclusive as de um InsertMany
+// (BulkWriteException), em ErrConflict: quem grava por ExternalRef trata o conflito como "já gravado"
+func conflictOnDuplicate(err error) error {
+	if mongo.IsDuplicateKeyError(err) {
+		return domainErrors.ErrConflict
+	}
 	return err
 }
 
@@ -55,18 +153,46 @@ func (r *TransactionRepository) Update(ctx context.Context, transaction *entity.
 		"_id":     transaction.ID,
 		"user_id": transaction.UserID,
 	}, bson.M{"$set": bson.M{
+		"account_id":     transaction.AccountID,
 		"category_id":    transaction.CategoryID,
+		"type":           transaction.Type,
+		"amount":         transaction.Amount,
+		"currency":       transaction.Currency,
+		"occurred_at":    transaction.OccurredAt,
 		"description":    transaction.Description,
 		"notes":          transaction.Notes,
 		"tags":           transaction.Tags,
 		"status":         transaction.Status,
 		"receipt_object": transaction.ReceiptObject,
 		"metadata":       transaction.Metadata,
+		"splits":         transaction.Splits,
 		"updated_at":     time.Now().UTC(),
 	}})
 	return err
 }
 
+func (r *TransactionRepository) Void(ctx context.Context, id string, userID string, reason string, voidedAt time.Time) error {
+	result, err := r.collection.UpdateOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+		"status":  bson.M{"$ne": entity.TransactionStatusVoided},
+		// Transações conciliadas ficam travadas
+		"reconciliation_id": bson.M{"$exists": false},
+	}, bson.M{"$set": bson.M{
+		"status":      entity.TransactionStatusVoided,
+		"voided_at":   voidedAt,
+		"void_reason": reason,
+		"updated_at":  voidedAt,
+	}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domainErrors.ErrConflict
+	}
+	return nil
+}
+
 func (r *TransactionRepository) GetByID(ctx context.Context, id string, userID string) (*entity.Transaction, error) {
 	var transaction entity.Transaction
 	err := r.collection.FindOne(ctx, bson.M{
@@ -82,13 +208,174 @@ func (r *TransactionRepository) GetByID(ctx context.Context, id string, userID s
 	return &transaction, nil
 }
 
-func (r *TransactionRepository) List(ctx context.Context, userID string, from time.Time, to time.Time, limit int64, offset int64) ([]*entity.Transaction, error) {
+func (r *TransactionRepository) GetByExternalRef(ctx context.Context, userID string, externalRef string) (*entity.Transaction, error) {
+	var transaction entity.Transaction
+	err := r.collection.FindOne(ctx, bson.M{
+		"user_id":      userID,
+		"external_ref": externalRef,
+	}).Decode(&transaction)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+func (r *TransactionRepository) List(ctx context.Context, userID string, filter repository.TransactionFilter, limit int64, offset int64) ([]*entity.Transaction, error) {
+	query := transactionQuery(userID, filter)
+	field, descending := transactionSortKey(filter)
+	opts := options.Find().SetSort(bson.D{{Key: field, Value: sortDirection(descending)}, {Key: "_id", Value: sortDirection(descending)}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	if filter.After != nil {
+		value, err := transactionCursorValue(field, *filter.After)
+		if err != nil {
+			return nil, err
+		}
+		query = bson.M{"$and": bson.A{query, keysetFilter(field, value, filter.After.ID, descending)}}
+	} else if offset > 0 {
+		opts.SetSkip(offset)
+	}
+	return r.find(ctx, query, opts)
+}
+
+// transactionQuery traduz o filtro da listagem para uma consulta coberta pelos índices de user_id
+func transactionQuery(userID string, filter repository.TransactionFilter) bson.M {
+	query := bson.M{"user_id": userID}
+	occurredAt := bson.M{}
+	if !filter.From.IsZero() {
+		occurredAt["$gte"] = filter.From
+	}
+	if !filter.To.IsZero() {
+		occurredAt["$lte"] = filter.To
+	}
+	if len(occurredAt) > 0 {
+		query["occurred_at"] = occurredAt
+	}
+	switch {
+	case len(filter.Statuses) > 0:
+		query["status"] = bson.M{"$in": filter.Statuses}
+	case !filter.IncludeVoided:
+		query["status"] = bson.M{"$ne": entity.TransactionStatusVoided}
+	}
+	if len(filter.AccountIDs) > 0 {
+		query["account_id"] = bson.M{"$in": filter.AccountIDs}
+	}
+	if len(filter.CategoryIDs) > 0 {
+		query["$or"] = inCategories(filter.CategoryIDs...)
+	}
+	if len(filter.Tags) > 0 {
+		operator := "$in"
+		if filter.MatchAllTags {
+			operator = "$all"
+		}
+		query["tags"] = bson.M{operator: filter.Tags}
+	}
+	amount := bson.M{}
+	if filter.MinAmount != nil {
+		amount["$gte"] = *filter.MinAmount
+	}
+	if filter.MaxAmount != nil {
+		amount["$lte"] = *filter.MaxAmount
+	}
+	if len(amount) > 0 {
+		query["amount"] = amount
+	}
+	if len(filter.Currencies) > 0 {
+		query["currency"] = bson.M{"$in": filter.Currencies}
+	}
+	if filter.Search != "" {
+		query["$text"] = bson.M{"$search": filter.Search}
+	}
+	return query
+}
+
+// inCategories casa a categoria principal ou qualquer linha do rateio
+func inCategories(categoryIDs ...string) bson.A {
+	return bson.A{
+		bson.M{"category_id": bson.M{"$in": categoryIDs}},
+		bson.M{"splits.category_id": bson.M{"$in": categoryIDs}},
+	}
+}
+
+// transactionSortKey devolve o campo e a direção da ordenação; o _id desempata registros com o mesmo valor
+// para que a paginação seja estável
+func transactionSortKey(filter repository.TransactionFilter) (string, bool) {
+	fields := map[repository.TransactionSortField]string{
+		repository.TransactionSortOccurredAt:  "occurred_at",
+		repository.TransactionSortAmount:      "amount",
+		repository.TransactionSortCreatedAt:   "created_at",
+		repository.TransactionSortDescription: "description",
+	}
+	field, ok := fields[filter.SortBy]
+	if !ok {
+		return "occurred_at", true
+	}
+	return field, filter.SortDescending
+}
+
+// transactionCursorValue converte o valor do cursor para o tipo gravado no campo de ordenação
+func transactionCursorValue(field string, cursor repository.PageCursor) (interface{}, error) {
+	switch field {
+	case "occurred_at", "created_at":
+		value, err := cursor.Time()
+		if err != nil {
+			return nil, domainErrors.ErrInvalidInput
+		}
+		return value, nil
+	case "amount":
+		value, err := entity.ParseMoney(cursor.Value)
+		if err != nil {
+			return nil, domainErrors.ErrInvalidInput
+		}
+		return value, nil
+	default:
+		return cursor.Value, nil
+	}
+}
+
+func sortDirection(descending bool) int {
+	if descending {
+		return -1
+	}
+	return 1
+}
+
+func (r *TransactionRepository) ListByCategory(ctx context.Context, userID string, categoryID string, from time.Time, to time.Time) ([]*entity.Transaction, error) {
 	filter := bson.M{
 		"user_id": userID,
+		"$or":     inCategories(categoryID),
+		"occurred_at": bson.M{
+			"$gte": from,
+			"$lte": to,
+		},
+		"status": bson.M{"$ne": entity.TransactionStatusVoided},
+	}
+	return r.find(ctx, filter, options.Find())
+}
+
+func (r *TransactionRepository) FindDuplicateCandidates(ctx context.Context, userID string, accountID string, amount entity.Money, from time.Time, to time.Time) ([]*entity.Transaction, error) {
+	filter := bson.M{
+		"user_id":    userID,
+		"account_id": accountID,
+		"amount":     amount,
 		"occurred_at": bson.M{
 			"$gte": from,
 			"$lte": to,
 		},
+		"status": bson.M{"$ne": entity.TransactionStatusVoided},
+	}
+	return r.find(ctx, filter, options.Find().SetSort(bson.D{{Key: "occurred_at", Value: 1}}))
+}
+
+func (r *TransactionRepository) ListDuplicates(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Transaction, error) {
+	filter := bson.M{
+		"user_id":      userID,
+		"duplicate_of": bson.M{"$gt": ""},
+		"status":       bson.M{"$ne": entity.TransactionStatusVoided},
 	}
 	opts := options.Find().SetSort(bson.D{{Key: "occurred_at", Value: -1}})
 	if limit > 0 {
@@ -97,33 +384,77 @@ func (r *TransactionRepository) List(ctx context.Context, userID string, from ti
 	if offset > 0 {
 		opts.SetSkip(offset)
 	}
-	cursor, err := r.collection.Find(ctx, filter, opts)
+	return r.find(ctx, filter, opts)
+}
+
+func (r *TransactionRepository) SetDuplicateOf(ctx context.Context, id string, userID string, duplicateOf string) error {
+	update := bson.M{"$set": bson.M{"duplicate_of": duplicateOf, "updated_at": time.Now().UTC()}}
+	if duplicateOf == "" {
+		update = bson.M{"$unset": bson.M{"duplicate_of": ""}, "$set": bson.M{"updated_at": time.Now().UTC()}}
+	}
+	result, err := r.collection.UpdateOne(ctx, bson.M{
+		"_id":     id,
+		"user_id": userID,
+	}, update)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer cursor.Close(ctx)
+	if result.MatchedCount == 0 {
+		return domainErrors.ErrNotFound
+	}
+	return nil
+}
 
-	var transactions []*entity.Transaction
-	for cursor.Next(ctx) {
-		var transaction entity.Transaction
-		if err := cursor.Decode(&transaction); err != nil {
-			return nil, err
-		}
-		transactions = append(transactions, &transaction)
+func (r *TransactionRepository) ListInstallments(ctx context.Context, userID string, groupID string) ([]*entity.Transaction, error) {
+	filter := bson.M{
+		"user_id":              userID,
+		"installment.group_id": groupID,
 	}
-	return transactions, nil
+	return r.find(ctx, filter, options.Find().SetSort(bson.D{{Key: "installment.number", Value: 1}}))
 }
 
-func (r *TransactionRepository) ListByCategory(ctx context.Context, userID string, categoryID string, from time.Time, to time.Time) ([]*entity.Transaction, error) {
+func (r *TransactionRepository) ListUnreconciled(ctx context.Context, userID string, accountID string) ([]*entity.Transaction, error) {
 	filter := bson.M{
-		"user_id":     userID,
-		"category_id": categoryID,
-		"occurred_at": bson.M{
-			"$gte": from,
-			"$lte": to,
-		},
+		"user_id":           userID,
+		"account_id":        accountID,
+		"status":            bson.M{"$ne": entity.TransactionStatusVoided},
+		"reconciliation_id": bson.M{"$exists": false},
 	}
-	cursor, err := r.collection.Find(ctx, filter)
+	return r.find(ctx, filter, options.Find().SetSort(bson.D{{Key: "occurred_at", Value: 1}, {Key: "_id", Value: 1}}))
+}
+
+func (r *TransactionRepository) SetCleared(ctx context.Context, userID string, ids []string, cleared bool) error {
+	update := bson.M{"$set": bson.M{"cleared": true, "updated_at": time.Now().UTC()}}
+	if !cleared {
+		update = bson.M{"$unset": bson.M{"cleared": ""}, "$set": bson.M{"updated_at": time.Now().UTC()}}
+	}
+	_, err := r.collection.UpdateMany(ctx, bson.M{
+		"_id":               bson.M{"$in": ids},
+		"user_id":           userID,
+		"reconciliation_id": bson.M{"$exists": false},
+	}, update)
+	return err
+}
+
+func (r *TransactionRepository) MarkReconciled(ctx context.Context, userID string, ids []string, reconciliationID string) error {
+	_, err := r.collection.UpdateMany(ctx, bson.M{
+		"_id":     bson.M{"$in": ids},
+		"user_id": userID,
+	}, bson.M{"$set": bson.M{"reconciliation_id": reconciliationID, "updated_at": time.Now().UTC()}})
+	return err
+}
+
+func (r *TransactionRepository) CountByAccount(ctx context.Context, userID string, accountID string) (int64, error) {
+	return r.collection.CountDocuments(ctx, bson.M{"user_id": userID, "account_id": accountID})
+}
+
+func (r *TransactionRepository) DeleteByAccount(ctx context.Context, userID string, accountID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID, "account_id": accountID})
+	return err
+}
+
+func (r *TransactionRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.Transaction, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
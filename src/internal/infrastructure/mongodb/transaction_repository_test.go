@@ -0,0 +1,38 @@
+package mongodb
+
+import (
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	domainErrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+// TestConflictOnDuplicate garante que chave duplicada vira ErrConflict tanto no InsertOne quanto no InsertMany
+func TestConflictOnDuplicate(t *testing.T) {
+	duplicate := mongo.WriteError{Index: 1, Code: 11000, Message: "E11000 duplicate key error collection: transactions index: user_id_1_external_ref_1"}
+	validation := mongo.WriteError{Code: 121, Message: "Document failed validation"}
+
+	cases := map[string]struct {
+		err      error
+		conflict bool
+	}{
+		"insert one duplicado":  {err: mongo.WriteException{WriteErrors: mongo.WriteErrors{duplicate}}, conflict: true},
+		"insert many duplicado": {err: mongo.BulkWriteException{WriteErrors: []mongo.BulkWriteError{{WriteError: duplicate}}}, conflict: true},
+		"insert many inválido":  {err: mongo.BulkWriteException{WriteErrors: []mongo.BulkWriteError{{WriteError: validation}}}},
+		"erro de rede":          {err: errors.New("connection reset")},
+	}
+	for name, tc := range cases {
+		got := conflictOnDuplicate(tc.err)
+		if tc.conflict != (got == domainErrors.ErrConflict) {
+			t.Fatalf("%s: conflito esperado %v, obtive %v", name, tc.conflict, got)
+		}
+		if !tc.conflict && got.Error() != tc.err.Error() {
+			t.Fatalf("%s: o erro original deveria ser mantido, obtive %v", name, got)
+		}
+	}
+	if conflictOnDuplicate(nil) != nil {
+		t.Fatalf("sem erro deveria continuar sem erro")
+	}
+}
@@ -0,0 +1,48 @@
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+type UnitOfWork struct {
+	client        *Client
+	transactional bool
+}
+
+var _ repository.UnitOfWork = (*UnitOfWork)(nil)
+
+// NewUnitOfWork cria a unidade de trabalho; em servidores standalone (sem replica set)
+// as transações não são suportadas e as escritas são executadas sem sessão.
+func NewUnitOfWork(ctx context.Context, client *Client) *UnitOfWork {
+	return &UnitOfWork{client: client, transactional: client.SupportsTransactions(ctx)}
+}
+
+// Transactional indica se as escritas estão protegidas por transações multi-documento
+func (u *UnitOfWork) Transactional() bool {
+	return u.transactional
+}
+
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !u.transactional {
+		return fn(ctx)
+	}
+	// Chamadas aninhadas reutilizam a transação já aberta no contexto
+	if mongo.SessionFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	session, err := u.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (any, error) {
+		return nil, fn(sessionCtx)
+	})
+	return err
+}
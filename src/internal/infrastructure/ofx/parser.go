@@ -0,0 +1,226 @@
+package ofx
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+var ErrInvalidStatement = errors.New("invalid ofx statement")
+
+// Statement reúne os lançamentos (STMTTRN) de todos os extratos de conta e cartão do arquivo
+type Statement struct {
+	AccountID    string
+	Currency     entity.Currency
+	Transactions []Transaction
+}
+
+// Transaction é um STMTTRN; Amount mantém o sinal do banco (negativo para débitos)
+type Transaction struct {
+	FITID    string
+	Type     string
+	PostedAt time.Time
+	Amount   entity.Money
+	Name     string
+	Memo     string
+	Currency entity.Currency
+}
+
+// Description combina NAME e MEMO, que alguns bancos usam para detalhes diferentes
+func (t Transaction) Description() string {
+	switch {
+	case t.Name == "":
+		return t.Memo
+	case t.Memo == "" || strings.EqualFold(t.Memo, t.Name):
+		return t.Name
+	default:
+		return t.Name + " - " + t.Memo
+	}
+}
+
+// Parse interpreta extratos OFX/QFX 1.x (SGML) e 2.x (XML). Os dois formatos são lidos pelo mesmo tokenizador:
+// no SGML os elementos folha não têm tag de fechamento e no XML o fechamento é apenas ignorado.
+func Parse(data []byte) (*Statement, error) {
+	start := bytes.Index(bytes.ToUpper(data), []byte("<OFX>"))
+	if start < 0 {
+		return nil, ErrInvalidStatement
+	}
+	body := decodeBody(data[:start], data[start:])
+
+	statement := &Statement{}
+	var current *Transaction
+	var currency entity.Currency
+	for _, token := range tokenize(body) {
+		switch {
+		case token.name == "STMTTRN" && !token.closing:
+			current = &Transaction{Currency: currency}
+		case token.name == "STMTTRN" && token.closing:
+			if current == nil {
+				return nil, ErrInvalidStatement
+			}
+			if current.PostedAt.IsZero() {
+				return nil, fmt.Errorf("%w: transaction %q without DTPOSTED", ErrInvalidStatement, current.FITID)
+			}
+			statement.Transactions = append(statement.Transactions, *current)
+			current = nil
+		case token.closing:
+			continue
+		case token.name == "CURDEF":
+			currency = entity.Currency(strings.ToUpper(token.value))
+			if statement.Currency == "" {
+				statement.Currency = currency
+			}
+		case token.name == "ACCTID" && statement.AccountID == "":
+			statement.AccountID = token.value
+		case current != nil:
+			if err := current.set(token.name, token.value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if current != nil {
+		return nil, fmt.Errorf("%w: unterminated STMTTRN", ErrInvalidStatement)
+	}
+	return statement, nil
+}
+
+func (t *Transaction) set(name string, value string) error {
+	switch name {
+	case "FITID":
+		t.FITID = value
+	case "TRNTYPE":
+		t.Type = strings.ToUpper(value)
+	case "NAME":
+		t.Name = value
+	case "MEMO":
+		t.Memo = value
+	case "DTPOSTED":
+		postedAt, err := parseDate(value)
+		if err != nil {
+			return err
+		}
+		t.PostedAt = postedAt
+	case "TRNAMT":
+		amount, err := parseAmount(value)
+		if err != nil {
+			return err
+		}
+		t.Amount = amount
+	}
+	return nil
+}
+
+// parseDate usa só a data de DTPOSTED (YYYYMMDD[HHMMSS[.XXX]][[-3:BRT]]); o horário costuma ser
+// fictício e o fuso alteraria o dia do lançamento
+func parseDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("%w: invalid date %q", ErrInvalidStatement, value)
+	}
+	date, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid date %q", ErrInvalidStatement, value)
+	}
+	return date, nil
+}
+
+// parseAmount aceita ponto decimal (padrão OFX) e vírgula decimal, usada por alguns bancos brasileiros, com ou sem
+// separador de milhares
+func parseAmount(value string) (entity.Money, error) {
+	text := strings.ReplaceAll(strings.TrimSpace(value), " ", "")
+	// O separador decimal é o último que aparece e o outro separa milhares ("1.234,56" ou "1,234.56");
+	// repetido, o separador só pode ser de milhares ("1.234.567")
+	decimal, thousands := ".", ","
+	if strings.LastIndex(text, ",") > strings.LastIndex(text, ".") {
+		decimal, thousands = ",", "."
+	}
+	if strings.Count(text, decimal) > 1 && !strings.Contains(text, thousands) {
+		text = strings.ReplaceAll(text, decimal, "")
+	} else {
+		text = strings.Replace(strings.ReplaceAll(text, thousands, ""), decimal, ".", 1)
+	}
+	amount, err := entity.ParseMoney(text)
+	if err != nil {
+		return entity.ZeroMoney, fmt.Errorf("%w: invalid amount %q", ErrInvalidStatement, value)
+	}
+	return amount, nil
+}
+
+// decodeBody converte o corpo para UTF-8 conforme o CHARSET do cabeçalho SGML (OFX 2.x já é UTF-8)
+func decodeBody(header []byte, body []byte) string {
+	charset := ""
+	scanner := bufio.NewScanner(bytes.NewReader(header))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if found && strings.EqualFold(key, "CHARSET") {
+			charset = strings.ToUpper(strings.TrimSpace(value))
+		}
+	}
+
+	var decoder *charmap.Charmap
+	switch charset {
+	case "1252", "WINDOWS-1252":
+		decoder = charmap.Windows1252
+	case "8859-1", "ISO-8859-1", "LATIN1":
+		decoder = charmap.ISO8859_1
+	}
+	if decoder == nil {
+		return string(body)
+	}
+	decoded, err := io.ReadAll(decoder.NewDecoder().Reader(bytes.NewReader(body)))
+	if err != nil {
+		return string(body)
+	}
+	return string(decoded)
+}
+
+type token struct {
+	name    string
+	closing bool
+	value   string
+}
+
+// tokenize devolve as tags na ordem do arquivo com o texto que vem logo depois de cada abertura
+func tokenize(body string) []token {
+	var tokens []token
+	for {
+		open := strings.IndexByte(body, '<')
+		if open < 0 {
+			return tokens
+		}
+		end := strings.IndexByte(body[open:], '>')
+		if end < 0 {
+			return tokens
+		}
+		tag := strings.TrimSpace(body[open+1 : open+end])
+		body = body[open+end+1:]
+		if tag == "" || tag[0] == '?' || tag[0] == '!' {
+			continue
+		}
+
+		current := token{}
+		if tag[0] == '/' {
+			current.closing = true
+			tag = tag[1:]
+		}
+		if fields := strings.Fields(tag); len(fields) > 0 {
+			current.name = strings.ToUpper(fields[0])
+		}
+		if !current.closing {
+			next := strings.IndexByte(body, '<')
+			if next < 0 {
+				next = len(body)
+			}
+			current.value = html.UnescapeString(strings.TrimSpace(body[:next]))
+		}
+		tokens = append(tokens, current)
+	}
+}
@@ -0,0 +1,267 @@
+package ofx
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+// Extrato 1.x como os bancos exportam: cabeçalho SGML, tags folha sem fechamento e texto em Windows-1252
+const sgmlStatement = "OFXHEADER:100\r\n" +
+	"DATA:OFXSGML\r\n" +
+	"VERSION:102\r\n" +
+	"SECURITY:NONE\r\n" +
+	"ENCODING:USASCII\r\n" +
+	"CHARSET:1252\r\n" +
+	"COMPRESSION:NONE\r\n" +
+	"OLDFILEUID:NONE\r\n" +
+	"NEWFILEUID:NONE\r\n" +
+	"\r\n" +
+	"<OFX>\r\n" +
+	"<SIGNONMSGSRSV1>\r\n" +
+	"<SONRS>\r\n" +
+	"<STATUS>\r\n" +
+	"<CODE>0\r\n" +
+	"<SEVERITY>INFO\r\n" +
+	"</STATUS>\r\n" +
+	"<DTSERVER>20240201120000[-3:BRT]\r\n" +
+	"<LANGUAGE>POR\r\n" +
+	"</SONRS>\r\n" +
+	"</SIGNONMSGSRSV1>\r\n" +
+	"<BANKMSGSRSV1>\r\n" +
+	"<STMTTRNRS>\r\n" +
+	"<TRNUID>1001\r\n" +
+	"<STATUS>\r\n" +
+	"<CODE>0\r\n" +
+	"<SEVERITY>INFO\r\n" +
+	"</STATUS>\r\n" +
+	"<STMTRS>\r\n" +
+	"<CURDEF>BRL\r\n" +
+	"<BANKACCTFROM>\r\n" +
+	"<BANKID>0341\r\n" +
+	"<ACCTID>12345-6\r\n" +
+	"<ACCTTYPE>CHECKING\r\n" +
+	"</BANKACCTFROM>\r\n" +
+	"<BANKTRANLIST>\r\n" +
+	"<DTSTART>20240101000000[-3:BRT]\r\n" +
+	"<DTEND>20240131235959[-3:BRT]\r\n" +
+	"<STMTTRN>\r\n" +
+	"<TRNTYPE>DEBIT\r\n" +
+	"<DTPOSTED>20240131220000.000[-3:BRT]\r\n" +
+	"<TRNAMT>-150.00\r\n" +
+	"<FITID>20240131001\r\n" +
+	"<CHECKNUM>001\r\n" +
+	"<MEMO>SUPERMERCADO P&amp;A - S\xc3O PAULO\r\n" +
+	"</STMTTRN>\r\n" +
+	"<STMTTRN>\r\n" +
+	"<TRNTYPE>CREDIT\r\n" +
+	"<DTPOSTED>20240110\r\n" +
+	"<TRNAMT>2500,00\r\n" +
+	"<FITID>20240110002\r\n" +
+	"<NAME>SALARIO\r\n" +
+	"<MEMO>SALARIO\r\n" +
+	"</STMTTRN>\r\n" +
+	"</BANKTRANLIST>\r\n" +
+	"<LEDGERBAL>\r\n" +
+	"<BALAMT>2350.00\r\n" +
+	"<DTASOF>20240131\r\n" +
+	"</LEDGERBAL>\r\n" +
+	"</STMTRS>\r\n" +
+	"</STMTTRNRS>\r\n" +
+	"</BANKMSGSRSV1>\r\n" +
+	"</OFX>\r\n"
+
+// Extrato 2.x: XML em UTF-8, com todas as tags fechadas, de um cartão em dólar
+const xmlStatement = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+  <SIGNONMSGSRSV1>
+    <SONRS>
+      <STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>
+      <DTSERVER>20240205083000.000[-5:EST]</DTSERVER>
+      <LANGUAGE>ENG</LANGUAGE>
+    </SONRS>
+  </SIGNONMSGSRSV1>
+  <CREDITCARDMSGSRSV1>
+    <CCSTMTTRNRS>
+      <TRNUID>0</TRNUID>
+      <STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>
+      <CCSTMTRS>
+        <CURDEF>USD</CURDEF>
+        <CCACCTFROM><ACCTID>4111XXXXXXXX1111</ACCTID></CCACCTFROM>
+        <BANKTRANLIST>
+          <DTSTART>20240101</DTSTART>
+          <DTEND>20240131</DTEND>
+          <STMTTRN>
+            <TRNTYPE>DEBIT</TRNTYPE>
+            <DTPOSTED>20240115000000.000[-5:EST]</DTPOSTED>
+            <TRNAMT>-42.17</TRNAMT>
+            <FITID>2024011524692164015017234</FITID>
+            <NAME>AMAZON MKTPLACE PMTS</NAME>
+            <MEMO>Amzn.com/bill WA</MEMO>
+          </STMTTRN>
+          <STMTTRN>
+            <TRNTYPE>CREDIT</TRNTYPE>
+            <DTPOSTED>20240120</DTPOSTED>
+            <TRNAMT>500.00</TRNAMT>
+            <FITID>2024012000000000000000001</FITID>
+            <NAME>PAYMENT THANK YOU</NAME>
+          </STMTTRN>
+        </BANKTRANLIST>
+      </CCSTMTRS>
+    </CCSTMTTRNRS>
+  </CREDITCARDMSGSRSV1>
+</OFX>
+`
+
+// Lançamento sem FITID, que alguns bancos omitem em tarifas
+const missingFITIDStatement = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>BRL
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>FEE
+<DTPOSTED>20240105
+<TRNAMT>-12.90
+<MEMO>TARIFA PACOTE SERVICOS
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// TestParse cobre os dois formatos e os campos que a importação usa de cada lançamento
+func TestParse(t *testing.T) {
+	cases := map[string]struct {
+		data      string
+		accountID string
+		currency  entity.Currency
+		want      []Transaction
+	}{
+		"ofx 1.x sgml": {
+			data:      sgmlStatement,
+			accountID: "12345-6",
+			currency:  entity.CurrencyBRL,
+			want: []Transaction{
+				// 22h em Brasília já é dia 1º em UTC; o fuso é ignorado para não mudar o dia do lançamento
+				{FITID: "20240131001", Type: "DEBIT", PostedAt: date(2024, 1, 31), Amount: entity.MustParseMoney("-150.00"), Memo: "SUPERMERCADO P&A - SÃO PAULO", Currency: entity.CurrencyBRL},
+				{FITID: "20240110002", Type: "CREDIT", PostedAt: date(2024, 1, 10), Amount: entity.MustParseMoney("2500.00"), Name: "SALARIO", Memo: "SALARIO", Currency: entity.CurrencyBRL},
+			},
+		},
+		"ofx 2.x xml": {
+			data:      xmlStatement,
+			accountID: "4111XXXXXXXX1111",
+			currency:  entity.CurrencyUSD,
+			want: []Transaction{
+				{FITID: "2024011524692164015017234", Type: "DEBIT", PostedAt: date(2024, 1, 15), Amount: entity.MustParseMoney("-42.17"), Name: "AMAZON MKTPLACE PMTS", Memo: "Amzn.com/bill WA", Currency: entity.CurrencyUSD},
+				{FITID: "2024012000000000000000001", Type: "CREDIT", PostedAt: date(2024, 1, 20), Amount: entity.MustParseMoney("500.00"), Name: "PAYMENT THANK YOU", Currency: entity.CurrencyUSD},
+			},
+		},
+		"sem fitid": {
+			data:     missingFITIDStatement,
+			currency: entity.CurrencyBRL,
+			want: []Transaction{
+				{Type: "FEE", PostedAt: date(2024, 1, 5), Amount: entity.MustParseMoney("-12.90"), Memo: "TARIFA PACOTE SERVICOS", Currency: entity.CurrencyBRL},
+			},
+		},
+	}
+	for name, tc := range cases {
+		statement, err := Parse([]byte(tc.data))
+		if err != nil {
+			t.Fatalf("%s: não esperava erro: %v", name, err)
+		}
+		if statement.AccountID != tc.accountID || statement.Currency != tc.currency {
+			t.Fatalf("%s: conta %q e moeda %q inesperadas", name, statement.AccountID, statement.Currency)
+		}
+		if len(statement.Transactions) != len(tc.want) {
+			t.Fatalf("%s: esperava %d lançamentos, obtive %d", name, len(tc.want), len(statement.Transactions))
+		}
+		for i, want := range tc.want {
+			got := statement.Transactions[i]
+			if got.FITID != want.FITID || got.Type != want.Type || !got.PostedAt.Equal(want.PostedAt) || got.Amount.Cmp(want.Amount) != 0 ||
+				got.Name != want.Name || got.Memo != want.Memo || got.Currency != want.Currency {
+				t.Fatalf("%s: lançamento %d inesperado:\n obtive %+v\nesperava %+v", name, i, got, want)
+			}
+		}
+	}
+}
+
+// TestParseAmountSeparadores garante os formatos de valor usados pelos bancos, com e sem separador de milhares
+func TestParseAmountSeparadores(t *testing.T) {
+	cases := map[string]string{
+		"-10.00":        "-10",
+		"-10,50":        "-10.5",
+		"1.234,56":      "1234.56",
+		"-1.234.567,89": "-1234567.89",
+		"1,234.56":      "1234.56",
+		"1.234.567":     "1234567",
+		" 2 500,00 ":    "2500",
+	}
+	for value, expected := range cases {
+		amount, err := parseAmount(value)
+		if err != nil {
+			t.Fatalf("%q: não esperava erro: %v", value, err)
+		}
+		if amount != entity.MustParseMoney(expected) {
+			t.Fatalf("%q: esperava %s, obtive %s", value, expected, amount)
+		}
+	}
+	for _, value := range []string{"1,234.56.78", "1.2,3,4"} {
+		if _, err := parseAmount(value); !errors.Is(err, ErrInvalidStatement) {
+			t.Fatalf("%q: esperava ErrInvalidStatement, obtive %v", value, err)
+		}
+	}
+}
+
+// TestParseFITIDEstavel garante que o FITID, usado como ExternalRef na importação, sai igual do mesmo lançamento
+// em extratos 1.x e 2.x e sem os espaços e quebras de linha do SGML, para que extratos sobrepostos não dupliquem
+func TestParseFITIDEstavel(t *testing.T) {
+	sgml := "OFXHEADER:100\nDATA:OFXSGML\n\n<OFX><BANKTRANLIST>\n" +
+		"<STMTTRN>\n<TRNTYPE>DEBIT\n<DTPOSTED>20240105\n<TRNAMT>-10.00\n<FITID>  AB&amp;123  \r\n</STMTTRN>\n" +
+		"</BANKTRANLIST></OFX>"
+	xml := `<?xml version="1.0"?><?OFX OFXHEADER="200" VERSION="211"?><OFX><BANKTRANLIST>` +
+		`<STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20240105</DTPOSTED><TRNAMT>-10.00</TRNAMT><FITID>AB&amp;123</FITID></STMTTRN>` +
+		`</BANKTRANLIST></OFX>`
+
+	var fitids []string
+	for _, data := range []string{sgml, xml} {
+		statement, err := Parse([]byte(data))
+		if err != nil {
+			t.Fatalf("não esperava erro: %v", err)
+		}
+		if len(statement.Transactions) != 1 {
+			t.Fatalf("esperava um lançamento, obtive %d", len(statement.Transactions))
+		}
+		fitids = append(fitids, statement.Transactions[0].FITID)
+	}
+	if fitids[0] != "AB&123" || fitids[1] != fitids[0] {
+		t.Fatalf("FITID deveria ser o mesmo nos dois formatos, obtive %q", fitids)
+	}
+}
+
+// TestParseInvalido garante ErrInvalidStatement para arquivos que não são extratos ou têm lançamentos quebrados
+func TestParseInvalido(t *testing.T) {
+	cases := map[string]string{
+		"sem ofx":             "Data,Descrição,Valor\n05/01/2024,Mercado,-10.00\n",
+		"sem dtposted":        "<OFX><STMTTRN><TRNTYPE>DEBIT<TRNAMT>-10.00<FITID>1</STMTTRN></OFX>",
+		"data inválida":       "<OFX><STMTTRN><DTPOSTED>2024-01-05<TRNAMT>-10.00<FITID>1</STMTTRN></OFX>",
+		"valor inválido":      "<OFX><STMTTRN><DTPOSTED>20240105<TRNAMT>dez reais<FITID>1</STMTTRN></OFX>",
+		"stmttrn sem fim":     "<OFX><STMTTRN><DTPOSTED>20240105<TRNAMT>-10.00<FITID>1</OFX>",
+		"fechamento sobrando": "<OFX><DTPOSTED>20240105</STMTTRN></OFX>",
+	}
+	for name, data := range cases {
+		if _, err := Parse([]byte(data)); !errors.Is(err, ErrInvalidStatement) {
+			t.Fatalf("%s: esperava ErrInvalidStatement, obtive %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package rates
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/port"
+)
+
+const csvDateLayout = "2006-01-02"
+
+// CSVProvider lê cotações de um arquivo local com as colunas date,base,quote,rate
+// (ex.: 2024-01-31,USD,BRL,4.9535). A primeira linha pode ser um cabeçalho.
+type CSVProvider struct {
+	path string
+}
+
+var _ port.RateProvider = (*CSVProvider)(nil)
+
+func NewCSVProvider(path string) *CSVProvider {
+	return &CSVProvider{path: path}
+}
+
+func (p *CSVProvider) FetchRates(ctx context.Context, from time.Time, to time.Time) ([]entity.ExchangeRate, error) {
+	file, err := os.Open(p.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return parseRates(file, from, to, "csv:"+p.path)
+}
+
+func parseRates(reader io.Reader, from time.Time, to time.Time, source string) ([]entity.ExchangeRate, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = 4
+	csvReader.TrimLeadingSpace = true
+	csvReader.Comment = '#'
+
+	var result []entity.ExchangeRate
+	line := 0
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line++
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "date") {
+			continue
+		}
+
+		date, err := time.Parse(csvDateLayout, strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid date %q", line, record[0])
+		}
+		if (!from.IsZero() && date.Before(entity.ExchangeRateDate(from))) || (!to.IsZero() && date.After(to)) {
+			continue
+		}
+		base := entity.Currency(strings.ToUpper(strings.TrimSpace(record[1])))
+		quote := entity.Currency(strings.ToUpper(strings.TrimSpace(record[2])))
+		if !entity.IsISOCurrency(base.String()) || !entity.IsISOCurrency(quote.String()) {
+			return nil, fmt.Errorf("line %d: unknown currency pair %s/%s", line, base, quote)
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(record[3]), 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("line %d: invalid rate %q", line, record[3])
+		}
+
+		result = append(result, entity.ExchangeRate{
+			BaseCurrency:  base,
+			QuoteCurrency: quote,
+			Rate:          rate,
+			Date:          date,
+			Source:        source,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,113 @@
+package rates
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+const ratesCSV = `# Cotações diárias: 1 base vale rate quote
+Date, Base, Quote, Rate
+2024-01-02,USD,BRL,4.8910
+2024-01-02, eur , usd ,1.0940
+2024-01-03,USD,BRL,4.9220
+2024-01-04,USD,BRL,4.9560
+`
+
+// TestParseRatesCabecalho garante que o cabeçalho e os comentários são ignorados e que as moedas são normalizadas
+func TestParseRatesCabecalho(t *testing.T) {
+	rates, err := parseRates(strings.NewReader(ratesCSV), time.Time{}, time.Time{}, "csv:teste")
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(rates) != 4 {
+		t.Fatalf("esperava 4 cotações, obtive %d", len(rates))
+	}
+	eur := rates[1]
+	if eur.BaseCurrency != entity.CurrencyEUR || eur.QuoteCurrency != entity.CurrencyUSD || eur.Rate != 1.094 ||
+		!eur.Date.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) || eur.Source != "csv:teste" {
+		t.Fatalf("cotação inesperada: %+v", eur)
+	}
+
+	withoutHeader, err := parseRates(strings.NewReader("2024-01-02,USD,BRL,4.8910\n"), time.Time{}, time.Time{}, "csv:teste")
+	if err != nil || len(withoutHeader) != 1 {
+		t.Fatalf("arquivo sem cabeçalho deveria ser lido, obtive %d cotações e %v", len(withoutHeader), err)
+	}
+}
+
+// TestParseRatesLinhasInvalidas garante que uma linha ruim recusa o arquivo indicando a linha
+func TestParseRatesLinhasInvalidas(t *testing.T) {
+	cases := map[string]struct {
+		data    string
+		message string
+	}{
+		"data inválida":            {data: "date,base,quote,rate\n02/01/2024,USD,BRL,4.89\n", message: "line 2: invalid date"},
+		"moeda desconhecida":       {data: "2024-01-02,USD,XYZ,4.89\n", message: "line 1: unknown currency pair USD/XYZ"},
+		"cotação não numérica":     {data: "2024-01-02,USD,BRL,4,89\n", message: "wrong number of fields"},
+		"cotação zero":             {data: "2024-01-02,USD,BRL,0\n", message: "line 1: invalid rate"},
+		"cotação negativa":         {data: "2024-01-02,USD,BRL,-4.89\n", message: "line 1: invalid rate"},
+		"cotação vazia":            {data: "2024-01-02,USD,BRL,\n", message: "line 1: invalid rate"},
+		"colunas faltando":         {data: "2024-01-02,USD,BRL\n", message: "wrong number of fields"},
+		"cabeçalho fora do início": {data: "2024-01-02,USD,BRL,4.89\ndate,base,quote,rate\n", message: "line 2: invalid date"},
+	}
+	for name, tc := range cases {
+		_, err := parseRates(strings.NewReader(tc.data), time.Time{}, time.Time{}, "csv:teste")
+		if err == nil || !strings.Contains(err.Error(), tc.message) {
+			t.Fatalf("%s: esperava erro com %q, obtive %v", name, tc.message, err)
+		}
+	}
+}
+
+// TestParseRatesPeriodo garante que só entram as cotações do período, com os dois extremos inclusivos e from
+// considerado pelo dia mesmo quando tem horário
+func TestParseRatesPeriodo(t *testing.T) {
+	cases := map[string]struct {
+		from  time.Time
+		to    time.Time
+		dates []string
+	}{
+		"sem período":     {dates: []string{"2024-01-02", "2024-01-02", "2024-01-03", "2024-01-04"}},
+		"só from":         {from: time.Date(2024, 1, 3, 15, 30, 0, 0, time.UTC), dates: []string{"2024-01-03", "2024-01-04"}},
+		"só to":           {to: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), dates: []string{"2024-01-02", "2024-01-02"}},
+		"from e to":       {from: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), to: time.Date(2024, 1, 3, 23, 59, 0, 0, time.UTC), dates: []string{"2024-01-03"}},
+		"fora do arquivo": {from: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for name, tc := range cases {
+		rates, err := parseRates(strings.NewReader(ratesCSV), tc.from, tc.to, "csv:teste")
+		if err != nil {
+			t.Fatalf("%s: não esperava erro: %v", name, err)
+		}
+		var dates []string
+		for _, rate := range rates {
+			dates = append(dates, rate.Date.Format(csvDateLayout))
+		}
+		if strings.Join(dates, ",") != strings.Join(tc.dates, ",") {
+			t.Fatalf("%s: esperava %v, obtive %v", name, tc.dates, dates)
+		}
+	}
+}
+
+// TestCSVProviderFetchRates garante a leitura do arquivo configurado e o erro quando ele não existe
+func TestCSVProviderFetchRates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "exchange_rates.csv")
+	if err := os.WriteFile(path, []byte(ratesCSV), 0o600); err != nil {
+		t.Fatalf("não esperava erro ao gravar o arquivo: %v", err)
+	}
+
+	rates, err := NewCSVProvider(path).FetchRates(context.Background(), time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(rates) != 4 || rates[0].Source != "csv:"+path {
+		t.Fatalf("cotações inesperadas: %+v", rates)
+	}
+
+	if _, err := NewCSVProvider(filepath.Join(t.TempDir(), "missing.csv")).FetchRates(context.Background(), time.Time{}, time.Time{}); !os.IsNotExist(err) {
+		t.Fatalf("esperava erro de arquivo inexistente, obtive %v", err)
+	}
+}
@@ -13,12 +13,28 @@ import (
 )
 
 type AccountUseCase struct {
-	accountRepo repository.AccountRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	snapshotRepo    repository.BalanceSnapshotRepository
+	tradeRepo       repository.InvestmentTradeRepository
+	recurringRepo   repository.RecurringTransactionRepository
+	reconRepo       repository.ReconciliationRepository
+	importBatchRepo repository.ImportBatchRepository
+	unitOfWork      repository.UnitOfWork
+	transactions    *TransactionUseCase // publica os eventos de anulação na remoção em cascata
 }
 
-func NewAccountUseCase(accountRepo repository.AccountRepository) *AccountUseCase {
+func NewAccountUseCase(accountRepo repository.AccountRepository, transactionRepo repository.TransactionRepository, snapshotRepo repository.BalanceSnapshotRepository, tradeRepo repository.InvestmentTradeRepository, recurringRepo repository.RecurringTransactionRepository, reconRepo repository.ReconciliationRepository, importBatchRepo repository.ImportBatchRepository, unitOfWork repository.UnitOfWork, transactions *TransactionUseCase) *AccountUseCase {
 	return &AccountUseCase{
-		accountRepo: accountRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		snapshotRepo:    snapshotRepo,
+		tradeRepo:       tradeRepo,
+		recurringRepo:   recurringRepo,
+		reconRepo:       reconRepo,
+		importBatchRepo: importBatchRepo,
+		unitOfWork:      unitOfWork,
+		transactions:    transactions,
 	}
 }
 
@@ -32,22 +48,26 @@ func (uc *AccountUseCase) CreateAccount(ctx context.Context, userID string, requ
 		Currency:    entity.Currency(request.Currency),
 		Description: request.Description,
 		Balance:     request.Balance,
+		CreditCard:  toCreditCard(request.CreditCard),
+		Investment:  toInvestment(request.Investment),
+		Loan:        toLoan(request.Loan, entity.Currency(request.Currency)),
+		Savings:     toSavings(request.Savings, nil, now),
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
+	if !validCreditCard(account) || !validInvestment(account) || !validLoan(account) || !validSavings(account) {
+		return nil, errors.ErrInvalidInput
+	}
+	// O saldo de um empréstimo é o saldo devedor, negativo
+	if account.Loan != nil {
+		account.Balance = account.Loan.Outstanding.Neg()
+	}
 
 	if err := uc.accountRepo.Create(ctx, account); err != nil {
 		return nil, err
 	}
 
-	return &dto.AccountResponse{
-		ID:          account.ID,
-		Name:        account.Name,
-		Type:        string(account.Type),
-		Currency:    account.Currency.String(),
-		Description: account.Description,
-		Balance:     account.Balance,
-	}, nil
+	return toAccountResponse(account), nil
 }
 
 func (uc *AccountUseCase) UpdateAccount(ctx context.Context, userID string, accountID string, request dto.UpdateAccountRequest) (*dto.AccountResponse, error) {
@@ -64,7 +84,12 @@ func (uc *AccountUseCase) UpdateAccount(ctx context.Context, userID string, acco
 		account.Name = *request.Name
 	}
 	if request.Type != nil {
-		account.Type = entity.AccountType(*request.Type)
+		// Os termos do empréstimo são fixados na criação: uma conta não vira nem deixa de ser empréstimo
+		newType := entity.AccountType(*request.Type)
+		if newType != account.Type && (newType == entity.AccountTypeLoan || account.Type == entity.AccountTypeLoan) {
+			return nil, errors.ErrInvalidInput
+		}
+		account.Type = newType
 	}
 	if request.Currency != nil {
 		account.Currency = entity.Currency(*request.Currency)
@@ -72,47 +97,337 @@ func (uc *AccountUseCase) UpdateAccount(ctx context.Context, userID string, acco
 	if request.Description != nil {
 		account.Description = *request.Description
 	}
+	if request.CreditCard != nil {
+		account.CreditCard = toCreditCard(request.CreditCard)
+	}
+	// Deixar de ser cartão de crédito descarta o ciclo de fatura
+	if account.Type != entity.AccountTypeCredit && request.CreditCard == nil {
+		account.CreditCard = nil
+	}
+	if request.Investment != nil {
+		account.Investment = toInvestment(request.Investment)
+	}
+	if account.Type != entity.AccountTypeInvestment && request.Investment == nil {
+		account.Investment = nil
+	}
+	if request.Savings != nil {
+		account.Savings = toSavings(request.Savings, account.Savings, time.Now().UTC())
+	}
+	if account.Type != entity.AccountTypeSavings && request.Savings == nil {
+		account.Savings = nil
+	}
+	if !validCreditCard(account) || !validInvestment(account) || !validLoan(account) || !validSavings(account) {
+		return nil, errors.ErrInvalidInput
+	}
 	account.UpdatedAt = time.Now().UTC()
 
 	if err := uc.accountRepo.Update(ctx, account); err != nil {
 		return nil, err
 	}
 
-	return &dto.AccountResponse{
+	return toAccountResponse(account), nil
+}
+
+// ListAccounts lista as contas do usuário; as encerradas só entram com includeClosed
+func (uc *AccountUseCase) ListAccounts(ctx context.Context, userID string, includeClosed bool, limit int64, offset int64) ([]*dto.AccountResponse, error) {
+	accounts, err := uc.accountRepo.List(ctx, userID, includeClosed, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.AccountResponse, 0, len(accounts))
+	for _, account := range accounts {
+		response = append(response, toAccountResponse(account))
+	}
+
+	return response, nil
+}
+
+// ListAccountsPage lista as contas por chave (created_at, _id); cursor vazio devolve a primeira página
+func (uc *AccountUseCase) ListAccountsPage(ctx context.Context, userID string, includeClosed bool, cursor string, limit int64) (*dto.AccountPage, error) {
+	after, err := decodePageCursor(cursor, createdAtCursorSort)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := uc.accountRepo.ListAfter(ctx, userID, includeClosed, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	accounts, hasMore := splitPage(accounts, limit)
+
+	page := &dto.AccountPage{Items: make([]*dto.AccountResponse, 0, len(accounts))}
+	for _, account := range accounts {
+		page.Items = append(page.Items, toAccountResponse(account))
+	}
+	if hasMore {
+		last := accounts[len(accounts)-1]
+		page.NextCursor = encodePageCursor(repository.NewTimeCursor(createdAtCursorSort, last.CreatedAt, last.ID))
+	}
+	return page, nil
+}
+
+func toAccountResponse(account *entity.Account) *dto.AccountResponse {
+	response := &dto.AccountResponse{
 		ID:          account.ID,
 		Name:        account.Name,
 		Type:        string(account.Type),
 		Currency:    account.Currency.String(),
 		Description: account.Description,
 		Balance:     account.Balance,
-	}, nil
+		ClosedAt:    account.ClosedAt,
+	}
+	if account.CreditCard != nil {
+		response.CreditCard = &dto.CreditCard{
+			ClosingDay:  account.CreditCard.ClosingDay,
+			DueDay:      account.CreditCard.DueDay,
+			CreditLimit: account.CreditCard.CreditLimit,
+		}
+	}
+	if account.Type == entity.AccountTypeInvestment {
+		response.Investment = &dto.InvestmentSettings{CostBasis: string(account.CostBasisMethod())}
+	}
+	if account.Loan != nil {
+		response.Loan = &dto.LoanTerms{
+			Principal:    account.Loan.Principal,
+			AnnualRate:   account.Loan.AnnualRate,
+			TermMonths:   account.Loan.TermMonths,
+			FirstDueDate: account.Loan.FirstDueDate,
+			System:       string(account.Loan.System),
+		}
+	}
+	if account.Savings != nil {
+		response.Savings = &dto.SavingsSettings{AnnualRate: account.Savings.AnnualRate, Compounding: string(account.Savings.Compounding)}
+	}
+	return response
 }
 
-func (uc *AccountUseCase) ListAccounts(ctx context.Context, userID string, limit int64, offset int64) ([]*dto.AccountResponse, error) {
-	accounts, err := uc.accountRepo.List(ctx, userID, limit, offset)
+func toCreditCard(card *dto.CreditCard) *entity.CreditCard {
+	if card == nil {
+		return nil
+	}
+	return &entity.CreditCard{ClosingDay: card.ClosingDay, DueDay: card.DueDay, CreditLimit: card.CreditLimit}
+}
+
+// validCreditCard aceita o ciclo de fatura apenas em contas do tipo credit
+func validCreditCard(account *entity.Account) bool {
+	if account.CreditCard == nil {
+		return true
+	}
+	return account.Type == entity.AccountTypeCredit && account.CreditCard.IsValid()
+}
+
+func toInvestment(settings *dto.InvestmentSettings) *entity.Investment {
+	if settings == nil {
+		return nil
+	}
+	method := entity.CostBasisMethod(settings.CostBasis)
+	if method == "" {
+		method = entity.CostBasisFIFO
+	}
+	return &entity.Investment{CostBasis: method}
+}
+
+func toLoan(terms *dto.LoanTerms, currency entity.Currency) *entity.Loan {
+	if terms == nil {
+		return nil
+	}
+	system := entity.AmortizationSystem(terms.System)
+	if system == "" {
+		system = entity.AmortizationSAC
+	}
+	return entity.NewLoan(terms.Principal, terms.AnnualRate, terms.TermMonths, terms.FirstDueDate.UTC(), system, currency.MinorUnits())
+}
+
+// validLoan exige termos válidos em contas do tipo loan e só nelas
+func validLoan(account *entity.Account) bool {
+	if account.Loan == nil {
+		return account.Type != entity.AccountTypeLoan
+	}
+	return account.Type == entity.AccountTypeLoan && account.Loan.IsValid()
+}
+
+// toSavings monta o rendimento da conta. Os juros contam a partir do dia em que o rendimento é configurado; ao
+// mudar a taxa, os dias ainda não lançados passam a render pela nova taxa
+func toSavings(settings *dto.SavingsSettings, current *entity.SavingsInterest, now time.Time) *entity.SavingsInterest {
+	if settings == nil {
+		return nil
+	}
+	compounding := entity.CompoundingConvention(settings.Compounding)
+	if compounding == "" {
+		compounding = entity.CompoundingMonthly
+	}
+	savings := &entity.SavingsInterest{AnnualRate: settings.AnnualRate, Compounding: compounding, AccruedThrough: entity.StartOfDay(now)}
+	if current != nil {
+		savings.AccruedThrough = current.AccruedThrough
+	}
+	return savings
+}
+
+// validSavings aceita o rendimento apenas em contas do tipo savings
+func validSavings(account *entity.Account) bool {
+	if account.Savings == nil {
+		return true
+	}
+	return account.Type == entity.AccountTypeSavings && account.Savings.IsValid()
+}
+
+// validInvestment aceita o método de custo apenas em contas do tipo investment
+func validInvestment(account *entity.Account) bool {
+	if account.Investment == nil {
+		return true
+	}
+	return account.Type == entity.AccountTypeInvestment && account.Investment.CostBasis.IsValid()
+}
+
+// CloseAccount encerra (arquiva) a conta na data informada ou agora. A conta some das listagens padrão e deixa
+// de receber transações, mas o histórico e os relatórios continuam considerando o saldo dela
+func (uc *AccountUseCase) CloseAccount(ctx context.Context, userID string, accountID string, request dto.CloseAccountRequest) (*dto.AccountResponse, error) {
+	account, err := uc.accountRepo.GetByID(ctx, accountID, userID)
 	if err != nil {
 		return nil, err
 	}
+	if account == nil {
+		return nil, errors.ErrNotFound
+	}
+	if account.IsClosed() {
+		return nil, errors.ErrConflict
+	}
 
-	response := make([]*dto.AccountResponse, 0, len(accounts))
-	for _, account := range accounts {
-		response = append(response, &dto.AccountResponse{
-			ID:          account.ID,
-			Name:        account.Name,
-			Type:        string(account.Type),
-			Currency:    account.Currency.String(),
-			Description: account.Description,
-			Balance:     account.Balance,
-		})
+	now := time.Now().UTC()
+	closedAt := now
+	if request.ClosedAt != nil {
+		closedAt = request.ClosedAt.UTC()
 	}
+	if closedAt.After(now) || closedAt.Before(account.CreatedAt) {
+		return nil, errors.ErrInvalidInput
+	}
+	account.ClosedAt = &closedAt
+	account.UpdatedAt = now
 
-	return response, nil
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		return nil, err
+	}
+	return toAccountResponse(account), nil
 }
 
-func (uc *AccountUseCase) DeleteAccount(ctx context.Context, userID string, accountID string) error {
-	return uc.accountRepo.Delete(ctx, accountID, userID)
+// ReopenAccount reabre uma conta encerrada
+func (uc *AccountUseCase) ReopenAccount(ctx context.Context, userID string, accountID string) (*dto.AccountResponse, error) {
+	account, err := uc.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, errors.ErrNotFound
+	}
+	if !account.IsClosed() {
+		return nil, errors.ErrConflict
+	}
+	account.ClosedAt = nil
+	account.UpdatedAt = time.Now().UTC()
+
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		return nil, err
+	}
+	return toAccountResponse(account), nil
+}
+
+// DeleteAccount remove a conta de vez. Com transações apontando para ela a remoção é recusada com ErrConflict,
+// a menos que cascade seja pedido: aí as transações da conta são removidas junto, publicando a anulação de cada
+// uma para que os orçamentos deixem de contá-las. As pernas de transferência em outras contas são mantidas,
+// para não alterar o saldo delas. Recorrências, conciliações e lotes de importação da conta são removidos
+// sempre, já que não fazem sentido sem ela
+func (uc *AccountUseCase) DeleteAccount(ctx context.Context, userID string, accountID string, cascade bool) error {
+	account, err := uc.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return errors.ErrNotFound
+	}
+
+	return uc.unitOfWork.Do(ctx, func(txCtx context.Context) error {
+		// A contagem fica na mesma unidade de trabalho da remoção, para não apagar uma conta que recebeu
+		// transação entre a verificação e a remoção
+		if !cascade {
+			count, err := uc.transactionRepo.CountByAccount(txCtx, userID, accountID)
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				return errors.ErrConflict
+			}
+		} else {
+			if err := uc.enqueueVoidedEvents(txCtx, userID, accountID); err != nil {
+				return err
+			}
+			if err := uc.transactionRepo.DeleteByAccount(txCtx, userID, accountID); err != nil {
+				return err
+			}
+			if uc.tradeRepo != nil {
+				if err := uc.tradeRepo.DeleteByAccount(txCtx, userID, accountID); err != nil {
+					return err
+				}
+			}
+		}
+		if uc.snapshotRepo != nil {
+			if err := uc.snapshotRepo.DeleteFrom(txCtx, userID, accountID, time.Time{}); err != nil {
+				return err
+			}
+		}
+		if err := uc.deleteDependents(txCtx, userID, accountID); err != nil {
+			return err
+		}
+		return uc.accountRepo.Delete(txCtx, accountID, userID)
+	})
+}
+
+// deleteDependents remove as recorrências, conciliações e lotes de importação da conta; sem isso o agendador
+// continuaria tentando lançar recorrências numa conta que não existe mais
+func (uc *AccountUseCase) deleteDependents(ctx context.Context, userID string, accountID string) error {
+	if uc.recurringRepo != nil {
+		if err := uc.recurringRepo.DeleteByAccount(ctx, userID, accountID); err != nil {
+			return err
+		}
+	}
+	if uc.reconRepo != nil {
+		if err := uc.reconRepo.DeleteByAccount(ctx, userID, accountID); err != nil {
+			return err
+		}
+	}
+	if uc.importBatchRepo != nil {
+		if err := uc.importBatchRepo.DeleteByAccount(ctx, userID, accountID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enqueueVoidedEvents publica a anulação das transações ainda válidas da conta antes da remoção em cascata.
+// Registros antigos sem tipo recebem o da categoria, senão o processador descartaria o evento
+func (uc *AccountUseCase) enqueueVoidedEvents(ctx context.Context, userID string, accountID string) error {
+	if uc.transactions == nil {
+		return nil
+	}
+	transactions, err := uc.transactionRepo.List(ctx, userID, repository.TransactionFilter{AccountIDs: []string{accountID}}, 0, 0)
+	if err != nil {
+		return err
+	}
+	if uc.transactions.categoryRepo != nil {
+		if err := resolveTransactionTypes(ctx, uc.transactions.categoryRepo, transactions); err != nil {
+			return err
+		}
+	}
+	for _, transaction := range transactions {
+		if transaction.Status == entity.TransactionStatusVoided {
+			continue
+		}
+		if err := uc.transactions.enqueueTransactionEvent(ctx, eventTypeTransactionVoided, transaction); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (uc *AccountUseCase) AdjustAccountBalance(ctx context.Context, userID string, accountID string, amount float64) error {
+func (uc *AccountUseCase) AdjustAccountBalance(ctx context.Context, userID string, accountID string, amount entity.Money) error {
 	return uc.accountRepo.AdjustBalance(ctx, accountID, userID, amount)
 }
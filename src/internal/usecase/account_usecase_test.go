@@ -2,16 +2,20 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
 	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
 )
 
 // TestAccountUseCaseCreate lista a criação básica garantindo persistência no repositório
 func TestAccountUseCaseCreate(t *testing.T) {
 	repo := newAccountRepositoryStub()
-	uc := NewAccountUseCase(repo)
+	uc := NewAccountUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	resp, err := uc.CreateAccount(context.Background(), "user-1", dto.CreateAccountRequest{
 		Name:     "Main",
@@ -39,8 +43,8 @@ func TestAccountUseCaseList(t *testing.T) {
 	repo.Create(context.Background(), &entity.Account{ID: "a2", UserID: "user-1", Name: "Conta B"})
 	repo.Create(context.Background(), &entity.Account{ID: "a3", UserID: "user-2", Name: "Conta C"})
 
-	uc := NewAccountUseCase(repo)
-    resp, err := uc.ListAccounts(context.Background(), "user-1", 10, 0)
+	uc := NewAccountUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil)
+    resp, err := uc.ListAccounts(context.Background(), "user-1", false, 10, 0)
 	if err != nil {
 		t.Fatalf("esperava listagem sem erros, obteve: %v", err)
 	}
@@ -51,3 +55,194 @@ func TestAccountUseCaseList(t *testing.T) {
 		t.Fatalf("esperava limit=10 offset=0, obtido limit=%d offset=%d", repo.lastLimit, repo.lastOffset)
 	}
 }
+
+// TestAccountUseCaseListAccountsPage garante que a paginação por cursor não repete contas quando uma nova é criada entre as páginas
+func TestAccountUseCaseListAccountsPage(t *testing.T) {
+	repo := newAccountRepositoryStub()
+	ctx := context.Background()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, id := range []string{"a1", "a2", "a3", "a4", "a5"} {
+		repo.Create(ctx, &entity.Account{ID: id, UserID: "user-1", CreatedAt: base.Add(time.Duration(i) * time.Hour)})
+	}
+	// Mesmo created_at: o _id desempata
+	repo.Create(ctx, &entity.Account{ID: "a0", UserID: "user-1", CreatedAt: base})
+	uc := NewAccountUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	first, err := uc.ListAccountsPage(ctx, "user-1", false, "", 2)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(first.Items) != 2 || first.Items[0].ID != "a5" || first.Items[1].ID != "a4" || first.NextCursor == "" {
+		t.Fatalf("primeira página inesperada: %+v", first)
+	}
+
+	repo.Create(ctx, &entity.Account{ID: "a6", UserID: "user-1", CreatedAt: base.Add(10 * time.Hour)})
+
+	var ids []string
+	cursor := first.NextCursor
+	for cursor != "" {
+		page, err := uc.ListAccountsPage(ctx, "user-1", false, cursor, 2)
+		if err != nil {
+			t.Fatalf("não esperava erro: %v", err)
+		}
+		for _, item := range page.Items {
+			ids = append(ids, item.ID)
+		}
+		cursor = page.NextCursor
+	}
+	expected := []string{"a3", "a2", "a1", "a0"}
+	if len(ids) != len(expected) {
+		t.Fatalf("esperava %v, obtido %v", expected, ids)
+	}
+	for i := range expected {
+		if ids[i] != expected[i] {
+			t.Fatalf("esperava %v, obtido %v", expected, ids)
+		}
+	}
+
+	if _, err := uc.ListAccountsPage(ctx, "user-1", false, "não-é-um-cursor", 2); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("cursor inválido deveria retornar ErrInvalidInput, obtido %v", err)
+	}
+}
+
+// TestAccountUseCaseCloseAndReopen garante que a conta encerrada some da listagem padrão e recusa novas transações
+func TestAccountUseCaseCloseAndReopen(t *testing.T) {
+	repo := newAccountRepositoryStub()
+	txRepo := newTransactionRepositoryStub()
+	ctx := context.Background()
+	repo.Create(ctx, &entity.Account{ID: "a1", UserID: "user-1", Name: "Conta A", Currency: entity.CurrencyBRL, CreatedAt: time.Now().UTC().AddDate(-1, 0, 0)})
+	repo.Create(ctx, &entity.Account{ID: "a2", UserID: "user-1", Name: "Conta B", Currency: entity.CurrencyBRL})
+	uc := NewAccountUseCase(repo, txRepo, nil, nil, nil, nil, nil, newUnitOfWorkStub(repo, txRepo), nil)
+
+	closedAt := time.Now().UTC().AddDate(0, -1, 0)
+	closed, err := uc.CloseAccount(ctx, "user-1", "a1", dto.CloseAccountRequest{ClosedAt: &closedAt})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if closed.ClosedAt == nil || !closed.ClosedAt.Equal(closedAt) {
+		t.Fatalf("data de encerramento inesperada: %v", closed.ClosedAt)
+	}
+	if _, err := uc.CloseAccount(ctx, "user-1", "a1", dto.CloseAccountRequest{}); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("esperava ErrConflict ao encerrar de novo, obteve %v", err)
+	}
+
+	open, err := uc.ListAccounts(ctx, "user-1", false, 0, 0)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	all, err := uc.ListAccounts(ctx, "user-1", true, 0, 0)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(open) != 1 || open[0].ID != "a2" || len(all) != 2 {
+		t.Fatalf("listagem inesperada: %d abertas, %d no total", len(open), len(all))
+	}
+
+	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
+		"cat": {ID: "cat", UserID: "user-1", Type: entity.CategoryTypeExpense},
+	}}
+	transactions := NewTransactionUseCase(txRepo, repo, categoryRepo, nil, newUnitOfWorkStub(repo, txRepo), nil, nil, nil, "", nil)
+	_, err = transactions.RecordTransaction(ctx, "user-1", dto.CreateTransactionRequest{
+		AccountID:  "a1",
+		CategoryID: "cat",
+		Amount:     entity.MoneyFromInt(10),
+		Currency:   "BRL",
+		OccurredAt: time.Now().UTC(),
+	})
+	if !errors.Is(err, domainerrors.ErrAccountClosed) {
+		t.Fatalf("conta encerrada não deveria receber transações: %v", err)
+	}
+
+	if _, err := uc.ReopenAccount(ctx, "user-1", "a1"); err != nil {
+		t.Fatalf("não esperava erro ao reabrir: %v", err)
+	}
+	if repo.storage["a1"].IsClosed() {
+		t.Fatalf("conta deveria estar aberta")
+	}
+}
+
+// TestAccountUseCaseDeleteWithTransactions garante que a remoção só apaga as transações quando cascade é pedido
+func TestAccountUseCaseDeleteWithTransactions(t *testing.T) {
+	repo := newAccountRepositoryStub()
+	txRepo := newTransactionRepositoryStub()
+	ctx := context.Background()
+	repo.Create(ctx, &entity.Account{ID: "a1", UserID: "user-1", Name: "Conta A"})
+	repo.Create(ctx, &entity.Account{ID: "a2", UserID: "user-1", Name: "Conta B"})
+	txRepo.storage["t1"] = &entity.Transaction{ID: "t1", UserID: "user-1", AccountID: "a1", Type: entity.TransactionTypeExpense, Status: entity.TransactionStatusCompleted}
+	txRepo.storage["t2"] = &entity.Transaction{ID: "t2", UserID: "user-1", AccountID: "a2", Type: entity.TransactionTypeExpense, Status: entity.TransactionStatusCompleted}
+	txRepo.storage["t3"] = &entity.Transaction{ID: "t3", UserID: "user-1", AccountID: "a1", Type: entity.TransactionTypeExpense, Status: entity.TransactionStatusVoided}
+	outbox := newOutboxRepositoryStub()
+	unitOfWork := newUnitOfWorkStub(repo, txRepo)
+	transactions := NewTransactionUseCase(txRepo, repo, nil, nil, unitOfWork, outbox, nil, nil, "transactions", nil)
+	uc := NewAccountUseCase(repo, txRepo, nil, nil, nil, nil, nil, unitOfWork, transactions)
+
+	if err := uc.DeleteAccount(ctx, "user-1", "a1", false); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("esperava ErrConflict com transações na conta, obteve %v", err)
+	}
+	if repo.storage["a1"] == nil {
+		t.Fatalf("a conta não deveria ter sido removida")
+	}
+
+	if err := uc.DeleteAccount(ctx, "user-1", "a1", true); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if repo.storage["a1"] != nil || txRepo.storage["t1"] != nil {
+		t.Fatalf("a conta e as transações dela deveriam ter sido removidas")
+	}
+	if txRepo.storage["t2"] == nil {
+		t.Fatalf("transações de outras contas não deveriam ser removidas")
+	}
+	if len(outbox.events) != 1 || outbox.events[0].EventType != eventTypeTransactionVoided || outbox.events[0].AggregateID != "t1" {
+		t.Fatalf("esperava um evento de anulação para t1, obteve %+v", outbox.events)
+	}
+	if err := uc.DeleteAccount(ctx, "user-1", "a1", true); !errors.Is(err, domainerrors.ErrNotFound) {
+		t.Fatalf("esperava ErrNotFound, obteve %v", err)
+	}
+}
+
+// TestAccountUseCaseDeleteRemoveDependentes garante que a remoção leva junto recorrências, conciliações e lotes de
+// importação da conta e que a anulação de um registro antigo sem tipo sai com o tipo da categoria
+func TestAccountUseCaseDeleteRemoveDependentes(t *testing.T) {
+	repo := newAccountRepositoryStub()
+	txRepo := newTransactionRepositoryStub()
+	ctx := context.Background()
+	repo.Create(ctx, &entity.Account{ID: "a1", UserID: "user-1", Name: "Conta A"})
+	repo.Create(ctx, &entity.Account{ID: "a2", UserID: "user-1", Name: "Conta B"})
+	txRepo.storage["t1"] = &entity.Transaction{ID: "t1", UserID: "user-1", AccountID: "a1", CategoryID: "salary", Status: entity.TransactionStatusCompleted}
+	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
+		"salary": {ID: "salary", UserID: "user-1", Type: entity.CategoryTypeIncome},
+	}}
+	recurringRepo := newRecurringRepositoryStub()
+	recurringRepo.storage["r1"] = &entity.RecurringTransaction{ID: "r1", UserID: "user-1", AccountID: "a1", Status: entity.RecurringStatusActive}
+	recurringRepo.storage["r2"] = &entity.RecurringTransaction{ID: "r2", UserID: "user-1", AccountID: "a2", Status: entity.RecurringStatusActive}
+	reconRepo := newReconciliationRepositoryStub()
+	reconRepo.storage["c1"] = &entity.Reconciliation{ID: "c1", UserID: "user-1", AccountID: "a1", Status: entity.ReconciliationStatusOpen}
+	importBatchRepo := &importBatchRepositoryStub{storage: map[string]*entity.ImportBatch{
+		"b1": {ID: "b1", UserID: "user-1", AccountID: "a1"},
+	}}
+	outbox := newOutboxRepositoryStub()
+	unitOfWork := newUnitOfWorkStub(repo, txRepo)
+	transactions := NewTransactionUseCase(txRepo, repo, categoryRepo, nil, unitOfWork, outbox, nil, nil, "transactions", nil)
+	uc := NewAccountUseCase(repo, txRepo, nil, nil, recurringRepo, reconRepo, importBatchRepo, unitOfWork, transactions)
+
+	if err := uc.DeleteAccount(ctx, "user-1", "a1", true); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if recurringRepo.storage["r1"] != nil || reconRepo.storage["c1"] != nil || importBatchRepo.storage["b1"] != nil {
+		t.Fatalf("recorrências, conciliações e lotes da conta deveriam ter sido removidos")
+	}
+	if recurringRepo.storage["r2"] == nil {
+		t.Fatalf("recorrências de outras contas não deveriam ser removidas")
+	}
+
+	if len(outbox.events) != 1 {
+		t.Fatalf("esperava um evento de anulação, obteve %d", len(outbox.events))
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(outbox.events[0].Payload, &payload); err != nil {
+		t.Fatalf("payload inválido: %v", err)
+	}
+	if payload["type"] != string(entity.TransactionTypeIncome) {
+		t.Fatalf("esperava o tipo da categoria no evento, obteve %v", payload["type"])
+	}
+}
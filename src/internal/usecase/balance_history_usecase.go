@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+// maxBalanceHistoryPoints limita o tamanho da série (pouco mais de um ano de pontos diários)
+const maxBalanceHistoryPoints = 400
+
+const defaultSnapshotBatchSize = 200
+
+// BalanceHistoryUseCase reconstrói o saldo das contas em datas passadas. O saldo é calculado desfazendo, a partir
+// de um ponto conhecido, o efeito das transações posteriores: o snapshot diário mais próximo depois do período
+// ou, sem snapshot, o saldo atual da conta. Os snapshots só encurtam a reprodução; o resultado é o mesmo.
+type BalanceHistoryUseCase struct {
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	categoryRepo    repository.CategoryRepository
+	snapshotRepo    repository.BalanceSnapshotRepository
+	batchSize       int64
+	now             func() time.Time
+}
+
+func NewBalanceHistoryUseCase(accountRepo repository.AccountRepository, transactionRepo repository.TransactionRepository, categoryRepo repository.CategoryRepository, snapshotRepo repository.BalanceSnapshotRepository, batchSize int) *BalanceHistoryUseCase {
+	if batchSize <= 0 {
+		batchSize = defaultSnapshotBatchSize
+	}
+	return &BalanceHistoryUseCase{
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+		snapshotRepo:    snapshotRepo,
+		batchSize:       int64(batchSize),
+		now:             func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// GetBalanceHistory devolve o saldo no fim de cada dia, semana ou mês entre from e to
+func (uc *BalanceHistoryUseCase) GetBalanceHistory(ctx context.Context, userID string, accountID string, from time.Time, to time.Time, interval string) (*dto.BalanceHistoryResponse, error) {
+	period := entity.BalanceInterval(interval)
+	if period == "" {
+		period = entity.BalanceIntervalDay
+	}
+	if !period.IsValid() || to.Before(from) {
+		return nil, errors.ErrInvalidInput
+	}
+	account, err := uc.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, errors.ErrNotFound
+	}
+
+	// Cada ponto vale no fim do seu período, exceto o último, que vale em "to"
+	var points []dto.BalancePoint
+	var ends []time.Time
+	for start := period.PeriodStart(from); !start.After(to); start = period.Next(start) {
+		if len(points) == maxBalanceHistoryPoints {
+			return nil, errors.ErrInvalidInput
+		}
+		end := period.Next(start)
+		if end.After(to) {
+			end = to
+		}
+		points = append(points, dto.BalancePoint{Date: start})
+		ends = append(ends, end)
+	}
+
+	closing, anchoredAt, err := uc.anchor(ctx, account, to)
+	if err != nil {
+		return nil, err
+	}
+	filter := repository.TransactionFilter{AccountIDs: []string{account.ID}, From: ends[0]}
+	if !anchoredAt.IsZero() {
+		filter.To = anchoredAt.Add(-time.Nanosecond)
+	}
+	transactions, err := uc.transactionRepo.List(ctx, account.UserID, filter, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !anchoredAt.IsZero() {
+		transactions = occurredBefore(transactions, anchoredAt)
+	}
+	if err := resolveTransactionTypes(ctx, uc.categoryRepo, transactions); err != nil {
+		return nil, err
+	}
+
+	// Percorre os pontos do mais recente para o mais antigo desfazendo as transações de cada intervalo
+	sort.Slice(transactions, func(i, j int) bool {
+		return transactions[i].OccurredAt.After(transactions[j].OccurredAt)
+	})
+	balance := closing
+	next := 0
+	for i := len(points) - 1; i >= 0; i-- {
+		var undone []*entity.Transaction
+		for next < len(transactions) && !transactions[next].OccurredAt.Before(ends[i]) {
+			undone = append(undone, transactions[next])
+			next++
+		}
+		balance = entity.BalanceBefore(balance, undone, ends[i])
+		points[i].Balance = balance
+	}
+
+	return &dto.BalanceHistoryResponse{
+		AccountID: account.ID,
+		Currency:  account.Currency.String(),
+		Interval:  string(period),
+		From:      from,
+		To:        to,
+		Points:    points,
+	}, nil
+}
+
+// anchor escolhe o saldo de partida: o primeiro snapshot que fecha em "to" ou depois dele, ou o saldo atual
+// (anchoredAt zero, depois de todas as transações, inclusive as com data futura)
+func (uc *BalanceHistoryUseCase) anchor(ctx context.Context, account *entity.Account, to time.Time) (entity.Money, time.Time, error) {
+	if uc.snapshotRepo == nil {
+		return account.Balance, time.Time{}, nil
+	}
+	snapshot, err := uc.snapshotRepo.FindFrom(ctx, account.UserID, account.ID, entity.StartOfDay(to))
+	if err != nil {
+		return entity.ZeroMoney, time.Time{}, err
+	}
+	if snapshot == nil {
+		return account.Balance, time.Time{}, nil
+	}
+	return snapshot.Balance, snapshot.ClosesAt(), nil
+}
+
+// SnapshotBalances grava o saldo de todas as contas no fim de day; rodar de novo para o mesmo dia substitui os snapshots
+func (uc *BalanceHistoryUseCase) SnapshotBalances(ctx context.Context, day time.Time) (int, error) {
+	date := entity.StartOfDay(day)
+	closesAt := date.AddDate(0, 0, 1)
+	if closesAt.After(uc.now()) {
+		return 0, errors.ErrInvalidInput
+	}
+
+	recorded := 0
+	afterID := ""
+	for {
+		accounts, err := uc.accountRepo.ListAll(ctx, afterID, uc.batchSize)
+		if err != nil {
+			return recorded, err
+		}
+		for _, account := range accounts {
+			later, err := uc.transactionRepo.List(ctx, account.UserID, repository.TransactionFilter{
+				AccountIDs: []string{account.ID},
+				From:       closesAt,
+			}, 0, 0)
+			if err != nil {
+				return recorded, err
+			}
+			if err := resolveTransactionTypes(ctx, uc.categoryRepo, later); err != nil {
+				return recorded, err
+			}
+			if err := uc.snapshotRepo.Upsert(ctx, &entity.BalanceSnapshot{
+				ID:        entity.BalanceSnapshotID(account.ID, date),
+				UserID:    account.UserID,
+				AccountID: account.ID,
+				Date:      date,
+				Balance:   entity.BalanceBefore(account.Balance, later, closesAt),
+				Currency:  account.Currency,
+				TakenAt:   uc.now(),
+			}); err != nil {
+				return recorded, err
+			}
+			recorded++
+		}
+		if int64(len(accounts)) < uc.batchSize {
+			return recorded, nil
+		}
+		afterID = accounts[len(accounts)-1].ID
+	}
+}
+
+func occurredBefore(transactions []*entity.Transaction, at time.Time) []*entity.Transaction {
+	result := make([]*entity.Transaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		if transaction.OccurredAt.Before(at) {
+			result = append(result, transaction)
+		}
+	}
+	return result
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+func newBalanceHistoryFixture(t *testing.T) (*BalanceHistoryUseCase, *accountRepositoryStub, *transactionRepositoryStub, *balanceSnapshotRepositoryStub) {
+	t.Helper()
+	// Saldo de abertura 1000 mais as transações abaixo
+	f := newTransactionFixture().withAccounts(
+		&entity.Account{ID: "checking", UserID: "user", Type: entity.AccountTypeChecking, Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(1350)},
+	).withCategories(
+		&entity.Category{ID: "salary", Type: entity.CategoryTypeIncome},
+	).withTransactions("checking",
+		// Registro antigo, sem tipo: é uma receita pela categoria
+		&entity.Transaction{ID: "salary", CategoryID: "salary", Amount: entity.MoneyFromInt(500), OccurredAt: time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC)},
+		&entity.Transaction{ID: "market", Type: entity.TransactionTypeExpense, Amount: entity.MoneyFromInt(120), OccurredAt: time.Date(2024, 1, 6, 18, 0, 0, 0, time.UTC)},
+		&entity.Transaction{ID: "voided", Type: entity.TransactionTypeExpense, Amount: entity.MoneyFromInt(999), OccurredAt: time.Date(2024, 1, 6, 19, 0, 0, 0, time.UTC), Status: entity.TransactionStatusVoided},
+		&entity.Transaction{ID: "rent", Type: entity.TransactionTypeExpense, Amount: entity.MoneyFromInt(30), OccurredAt: time.Date(2024, 2, 10, 12, 0, 0, 0, time.UTC)},
+	)
+
+	snapshots := newBalanceSnapshotRepositoryStub()
+	uc := NewBalanceHistoryUseCase(f.accountRepo, f.txRepo, f.categoryRepo, snapshots, 0)
+	uc.now = func() time.Time { return time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC) }
+	return uc, f.accountRepo, f.txRepo, snapshots
+}
+
+func balanceSeries(points []dto.BalancePoint) []string {
+	series := make([]string, 0, len(points))
+	for _, point := range points {
+		series = append(series, point.Balance.String())
+	}
+	return series
+}
+
+// TestGetBalanceHistoryReplaysPastDates garante o saldo no fim de cada dia e de cada mês reconstruído a partir do saldo atual
+func TestGetBalanceHistoryReplaysPastDates(t *testing.T) {
+	uc, _, _, _ := newBalanceHistoryFixture(t)
+	ctx := context.Background()
+
+	daily, err := uc.GetBalanceHistory(ctx, "user", "checking", time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 7, 23, 59, 59, 0, time.UTC), "")
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if daily.Interval != "day" || len(daily.Points) != 4 {
+		t.Fatalf("esperava 4 pontos diários, obtive %d (%s)", len(daily.Points), daily.Interval)
+	}
+	want := []string{"1000.00", "1500.00", "1380.00", "1380.00"}
+	for i, balance := range balanceSeries(daily.Points) {
+		if balance != want[i] {
+			t.Fatalf("série diária inesperada: %v", balanceSeries(daily.Points))
+		}
+	}
+
+	monthly, err := uc.GetBalanceHistory(ctx, "user", "checking", time.Date(2023, 12, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), "month")
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	want = []string{"1000.00", "1380.00", "1350.00"}
+	if len(monthly.Points) != 3 || !monthly.Points[0].Date.Equal(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("pontos mensais inesperados: %+v", monthly.Points)
+	}
+	for i, balance := range balanceSeries(monthly.Points) {
+		if balance != want[i] {
+			t.Fatalf("série mensal inesperada: %v", balanceSeries(monthly.Points))
+		}
+	}
+
+	if _, err := uc.GetBalanceHistory(ctx, "user", "checking", time.Now(), time.Now(), "year"); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("esperava ErrInvalidInput para intervalo inválido, obtive %v", err)
+	}
+	if _, err := uc.GetBalanceHistory(ctx, "user", "missing", time.Now(), time.Now(), "day"); !errors.Is(err, domainerrors.ErrNotFound) {
+		t.Fatalf("esperava ErrNotFound, obtive %v", err)
+	}
+}
+
+// TestBalanceSnapshotsAnchorHistory garante que o snapshot diário é usado como ponto de partida da reconstrução
+func TestBalanceSnapshotsAnchorHistory(t *testing.T) {
+	uc, accountRepo, _, snapshots := newBalanceHistoryFixture(t)
+	ctx := context.Background()
+
+	recorded, err := uc.SnapshotBalances(ctx, time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	snapshot := snapshots.storage[entity.BalanceSnapshotID("checking", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))]
+	if recorded != 1 || snapshot == nil || snapshot.Balance.Cmp(entity.MoneyFromInt(1380)) != 0 {
+		t.Fatalf("snapshot inesperado: %d gravado(s), %+v", recorded, snapshot)
+	}
+
+	// O saldo atual deixa de ser usado: só as transações até o snapshot são reproduzidas
+	accountRepo.storage["checking"].Balance = entity.MoneyFromInt(-1)
+	history, err := uc.GetBalanceHistory(ctx, "user", "checking", time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC), "week")
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	want := []string{"1380.00", "1380.00", "1380.00"}
+	if len(history.Points) != len(want) || !history.Points[0].Date.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("pontos semanais inesperados: %+v", history.Points)
+	}
+	for i, balance := range balanceSeries(history.Points) {
+		if balance != want[i] {
+			t.Fatalf("série semanal inesperada: %v", balanceSeries(history.Points))
+		}
+	}
+
+	if _, err := uc.SnapshotBalances(ctx, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("dia ainda não encerrado não deveria gerar snapshot: %v", err)
+	}
+}
+
+// TestBackdatedTransactionDiscardsSnapshots garante que uma transação retroativa descarta os snapshots a partir da sua data
+func TestBackdatedTransactionDiscardsSnapshots(t *testing.T) {
+	accountRepo := newAccountRepositoryStub()
+	txRepo := newTransactionRepositoryStub()
+	snapshots := newBalanceSnapshotRepositoryStub()
+	ctx := context.Background()
+	accountRepo.Create(ctx, &entity.Account{ID: "checking", UserID: "user", Type: entity.AccountTypeChecking, Currency: entity.CurrencyBRL})
+	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
+		"cat": {ID: "cat", UserID: "user", Type: entity.CategoryTypeExpense},
+	}}
+	for _, day := range []int{9, 10, 11} {
+		date := time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)
+		snapshots.Upsert(ctx, &entity.BalanceSnapshot{ID: entity.BalanceSnapshotID("checking", date), UserID: "user", AccountID: "checking", Date: date})
+	}
+
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, snapshots, nil, "", nil)
+	if _, err := uc.RecordTransaction(ctx, "user", dto.CreateTransactionRequest{
+		AccountID:  "checking",
+		CategoryID: "cat",
+		Amount:     entity.MoneyFromInt(50),
+		Currency:   "BRL",
+		OccurredAt: time.Date(2024, 1, 10, 15, 0, 0, 0, time.UTC),
+	}); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(snapshots.storage) != 1 || snapshots.storage[entity.BalanceSnapshotID("checking", time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC))] == nil {
+		t.Fatalf("só o snapshot anterior à transação deveria sobrar: %v", snapshots.storage)
+	}
+}
+
+// TestMovingTransactionDateDiscardsSnapshots garante que mudar só a data de uma transação para antes de um snapshot
+// descarta o snapshot, para que o histórico não parta de um saldo sem a transação movida
+func TestMovingTransactionDateDiscardsSnapshots(t *testing.T) {
+	uc, accountRepo, txRepo, snapshots := newBalanceHistoryFixture(t)
+	ctx := context.Background()
+	if _, err := uc.SnapshotBalances(ctx, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	transactions := NewTransactionUseCase(txRepo, accountRepo, &categoryRepositoryStub{}, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, snapshots, nil, "", nil)
+	movedTo := time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)
+	if _, err := transactions.UpdateTransaction(ctx, "user", "rent", dto.UpdateTransactionRequest{OccurredAt: &movedTo}); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(snapshots.storage) != 0 {
+		t.Fatalf("o snapshot posterior à nova data deveria ser descartado: %v", snapshots.storage)
+	}
+	if accountRepo.storage["checking"].Balance.Cmp(entity.MoneyFromInt(1350)) != 0 {
+		t.Fatalf("mudar só a data não deveria alterar o saldo atual: %s", accountRepo.storage["checking"].Balance)
+	}
+
+	history, err := uc.GetBalanceHistory(ctx, "user", "checking", time.Date(2024, 1, 19, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 31, 23, 59, 59, 0, time.UTC), "week")
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	want := []string{"1350.00", "1350.00", "1350.00"}
+	if len(history.Points) != len(want) {
+		t.Fatalf("pontos semanais inesperados: %+v", history.Points)
+	}
+	for i, balance := range balanceSeries(history.Points) {
+		if balance != want[i] {
+			t.Fatalf("série semanal inesperada: %v", balanceSeries(history.Points))
+		}
+	}
+}
@@ -13,12 +13,14 @@ import (
 )
 
 type BudgetUseCase struct {
-	budgetRepo repository.BudgetRepository
+	budgetRepo    repository.BudgetRepository
+	exchangeRates *ExchangeRateUseCase
 }
 
-func NewBudgetUseCase(budgetRepo repository.BudgetRepository) *BudgetUseCase {
+func NewBudgetUseCase(budgetRepo repository.BudgetRepository, exchangeRates *ExchangeRateUseCase) *BudgetUseCase {
 	return &BudgetUseCase{
-		budgetRepo: budgetRepo,
+		budgetRepo:    budgetRepo,
+		exchangeRates: exchangeRates,
 	}
 }
 
@@ -54,7 +56,8 @@ func (uc *BudgetUseCase) CreateBudget(ctx context.Context, userID string, reques
 	}, nil
 }
 
-func (uc *BudgetUseCase) ListBudgets(ctx context.Context, userID string, limit int64, offset int64) ([]*dto.BudgetResponse, error) {
+// ListBudgets lista os orçamentos incluindo os valores convertidos para displayCurrency quando a moeda difere
+func (uc *BudgetUseCase) ListBudgets(ctx context.Context, userID string, displayCurrency string, limit int64, offset int64) ([]*dto.BudgetResponse, error) {
 	budgets, err := uc.budgetRepo.List(ctx, userID, limit, offset)
 	if err != nil {
 		return nil, err
@@ -62,23 +65,57 @@ func (uc *BudgetUseCase) ListBudgets(ctx context.Context, userID string, limit i
 
 	response := make([]*dto.BudgetResponse, 0, len(budgets))
 	for _, budget := range budgets {
-		response = append(response, &dto.BudgetResponse{
-			ID:           budget.ID,
-			CategoryID:   budget.CategoryID,
-			Amount:       budget.Amount,
-			Currency:     budget.Currency.String(),
-			Period:       string(budget.Period),
-			PeriodStart:  budget.PeriodStart,
-			PeriodEnd:    budget.PeriodEnd,
-			Spent:        budget.Spent,
-			AlertPercent: budget.AlertPercent,
-		})
+		response = append(response, uc.toListedBudgetResponse(ctx, budget, displayCurrency))
 	}
 
 	return response, nil
 }
 
-func (uc *BudgetUseCase) UpdateSpent(ctx context.Context, userID string, budgetID string, spent float64) error {
+// ListBudgetsPage lista os orçamentos por chave (created_at, _id); cursor vazio devolve a primeira página
+func (uc *BudgetUseCase) ListBudgetsPage(ctx context.Context, userID string, displayCurrency string, cursor string, limit int64) (*dto.BudgetPage, error) {
+	after, err := decodePageCursor(cursor, createdAtCursorSort)
+	if err != nil {
+		return nil, err
+	}
+	budgets, err := uc.budgetRepo.ListAfter(ctx, userID, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	budgets, hasMore := splitPage(budgets, limit)
+
+	page := &dto.BudgetPage{Items: make([]*dto.BudgetResponse, 0, len(budgets))}
+	for _, budget := range budgets {
+		page.Items = append(page.Items, uc.toListedBudgetResponse(ctx, budget, displayCurrency))
+	}
+	if hasMore {
+		last := budgets[len(budgets)-1]
+		page.NextCursor = encodePageCursor(repository.NewTimeCursor(createdAtCursorSort, last.CreatedAt, last.ID))
+	}
+	return page, nil
+}
+
+func (uc *BudgetUseCase) toListedBudgetResponse(ctx context.Context, budget *entity.Budget, displayCurrency string) *dto.BudgetResponse {
+	target := entity.Currency(displayCurrency)
+	item := &dto.BudgetResponse{
+		ID:           budget.ID,
+		CategoryID:   budget.CategoryID,
+		Amount:       budget.Amount,
+		Currency:     budget.Currency.String(),
+		Period:       string(budget.Period),
+		PeriodStart:  budget.PeriodStart,
+		PeriodEnd:    budget.PeriodEnd,
+		Spent:        budget.Spent,
+		AlertPercent: budget.AlertPercent,
+	}
+	if convertedAmount := uc.exchangeRates.convertForDisplay(ctx, budget.Amount, budget.Currency, target); convertedAmount != nil {
+		item.DisplayCurrency = displayCurrency
+		item.ConvertedAmount = convertedAmount
+		item.ConvertedSpent = uc.exchangeRates.convertForDisplay(ctx, budget.Spent, budget.Currency, target)
+	}
+	return item
+}
+
+func (uc *BudgetUseCase) UpdateSpent(ctx context.Context, userID string, budgetID string, spent entity.Money) error {
 	budget, err := uc.budgetRepo.GetByID(ctx, budgetID, userID)
 	if err != nil {
 		return err
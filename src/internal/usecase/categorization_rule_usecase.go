@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+const defaultRuleDryRunLimit = 50
+
+type CategorizationRuleUseCase struct {
+	ruleRepo        repository.CategorizationRuleRepository
+	categoryRepo    repository.CategoryRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewCategorizationRuleUseCase(ruleRepo repository.CategorizationRuleRepository, categoryRepo repository.CategoryRepository, transactionRepo repository.TransactionRepository) *CategorizationRuleUseCase {
+	return &CategorizationRuleUseCase{
+		ruleRepo:        ruleRepo,
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+func (uc *CategorizationRuleUseCase) CreateRule(ctx context.Context, userID string, request dto.CreateCategorizationRuleRequest) (*dto.CategorizationRuleResponse, error) {
+	rule, err := uc.buildRule(ctx, userID, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.ruleRepo.Create(ctx, rule); err != nil {
+		return nil, err
+	}
+	return toCategorizationRuleResponse(rule), nil
+}
+
+func (uc *CategorizationRuleUseCase) UpdateRule(ctx context.Context, userID string, ruleID string, request dto.UpdateCategorizationRuleRequest) (*dto.CategorizationRuleResponse, error) {
+	rule, err := uc.ruleRepo.GetByID(ctx, ruleID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, errors.ErrNotFound
+	}
+
+	if request.Name != nil {
+		rule.Name = strings.TrimSpace(*request.Name)
+	}
+	if request.Priority != nil {
+		rule.Priority = *request.Priority
+	}
+	if request.Enabled != nil {
+		rule.Enabled = *request.Enabled
+	}
+	if request.Conditions != nil {
+		rule.Conditions = toRuleConditions(*request.Conditions)
+	}
+	if request.Actions != nil {
+		rule.Actions = toRuleActions(*request.Actions)
+	}
+	if err := uc.validateRule(ctx, userID, rule); err != nil {
+		return nil, err
+	}
+	rule.UpdatedAt = time.Now().UTC()
+
+	if err := uc.ruleRepo.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+	return toCategorizationRuleResponse(rule), nil
+}
+
+func (uc *CategorizationRuleUseCase) DeleteRule(ctx context.Context, userID string, ruleID string) error {
+	return uc.ruleRepo.Delete(ctx, ruleID, userID)
+}
+
+func (uc *CategorizationRuleUseCase) ListRules(ctx context.Context, userID string, limit int64, offset int64) ([]*dto.CategorizationRuleResponse, error) {
+	rules, err := uc.ruleRepo.List(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.CategorizationRuleResponse, 0, len(rules))
+	for _, rule := range rules {
+		response = append(response, toCategorizationRuleResponse(rule))
+	}
+	return response, nil
+}
+
+// DryRun aplica as regras às últimas transações sem gravar nada. A categoria proposta é a que as regras
+// dariam a uma transação sem categoria, para que o usuário veja o efeito da regra mesmo em lançamentos já categorizados.
+func (uc *CategorizationRuleUseCase) DryRun(ctx context.Context, userID string, request dto.DryRunRulesRequest) (*dto.DryRunRulesResponse, error) {
+	var rules []*entity.CategorizationRule
+	if request.Rule != nil {
+		rule, err := uc.buildRule(ctx, userID, *request.Rule)
+		if err != nil {
+			return nil, err
+		}
+		rule.Enabled = true
+		rules = []*entity.CategorizationRule{rule}
+	} else {
+		enabled, err := uc.ruleRepo.ListEnabled(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		rules = enabled
+	}
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultRuleDryRunLimit
+	}
+	transactions, err := uc.transactionRepo.List(ctx, userID, repository.TransactionFilter{To: time.Now().UTC()}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.DryRunRulesResponse{Results: []dto.RuleDryRunResult{}}
+	for _, transaction := range transactions {
+		// Transferências não passam pelas regras, nem as pernas de compra e venda de investimentos, que têm tipo
+		// de transferência sem pertencer a uma
+		if transaction.TransferID != "" || transaction.Type.IsTransfer() {
+			continue
+		}
+		response.Evaluated++
+
+		candidate := *transaction
+		candidate.CategoryID = ""
+		candidate.Tags = append([]string(nil), transaction.Tags...)
+		matched := entity.ApplyCategorizationRules(rules, &candidate)
+		if len(matched) == 0 {
+			continue
+		}
+		response.Matched++
+
+		result := dto.RuleDryRunResult{
+			TransactionID: transaction.ID,
+			OccurredAt:    transaction.OccurredAt,
+			Description:   transaction.Description,
+			CategoryID:    transaction.CategoryID,
+			AddedTags:     candidate.Tags[len(transaction.Tags):],
+		}
+		if candidate.Description != transaction.Description {
+			result.NewDescription = candidate.Description
+		}
+		if candidate.CategoryID != "" && candidate.CategoryID != transaction.CategoryID {
+			result.NewCategoryID = candidate.CategoryID
+		}
+		for _, rule := range matched {
+			result.MatchedRules = append(result.MatchedRules, rule.Name)
+		}
+		response.Results = append(response.Results, result)
+	}
+	return response, nil
+}
+
+func (uc *CategorizationRuleUseCase) buildRule(ctx context.Context, userID string, request dto.CreateCategorizationRuleRequest) (*entity.CategorizationRule, error) {
+	now := time.Now().UTC()
+	rule := &entity.CategorizationRule{
+		ID:         uuid.NewString(),
+		UserID:     userID,
+		Name:       strings.TrimSpace(request.Name),
+		Priority:   request.Priority,
+		Enabled:    request.Enabled == nil || *request.Enabled,
+		Conditions: toRuleConditions(request.Conditions),
+		Actions:    toRuleActions(request.Actions),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := uc.validateRule(ctx, userID, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (uc *CategorizationRuleUseCase) validateRule(ctx context.Context, userID string, rule *entity.CategorizationRule) error {
+	if !rule.IsValid() {
+		return errors.ErrInvalidInput
+	}
+	if rule.Actions.CategoryID == "" {
+		return nil
+	}
+	category, err := uc.categoryRepo.GetByID(ctx, rule.Actions.CategoryID, userID)
+	if err != nil {
+		return err
+	}
+	if category == nil {
+		return errors.ErrInvalidInput
+	}
+	return nil
+}
+
+func toRuleConditions(conditions dto.RuleConditions) entity.RuleConditions {
+	return entity.RuleConditions{
+		DescriptionContains: strings.TrimSpace(conditions.DescriptionContains),
+		DescriptionRegex:    conditions.DescriptionRegex,
+		MinAmount:           conditions.MinAmount,
+		MaxAmount:           conditions.MaxAmount,
+		AccountIDs:          conditions.AccountIDs,
+		Tags:                conditions.Tags,
+	}
+}
+
+func toRuleActions(actions dto.RuleActions) entity.RuleActions {
+	return entity.RuleActions{
+		CategoryID:  actions.CategoryID,
+		AddTags:     actions.AddTags,
+		Description: strings.TrimSpace(actions.Description),
+	}
+}
+
+func toCategorizationRuleResponse(rule *entity.CategorizationRule) *dto.CategorizationRuleResponse {
+	return &dto.CategorizationRuleResponse{
+		ID:       rule.ID,
+		Name:     rule.Name,
+		Priority: rule.Priority,
+		Enabled:  rule.Enabled,
+		Conditions: dto.RuleConditions{
+			DescriptionContains: rule.Conditions.DescriptionContains,
+			DescriptionRegex:    rule.Conditions.DescriptionRegex,
+			MinAmount:           rule.Conditions.MinAmount,
+			MaxAmount:           rule.Conditions.MaxAmount,
+			AccountIDs:          rule.Conditions.AccountIDs,
+			Tags:                rule.Conditions.Tags,
+		},
+		Actions: dto.RuleActions{
+			CategoryID:  rule.Actions.CategoryID,
+			AddTags:     rule.Actions.AddTags,
+			Description: rule.Actions.Description,
+		},
+		CreatedAt: rule.CreatedAt,
+		UpdatedAt: rule.UpdatedAt,
+	}
+}
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+func newRuleTestUseCases() (*CategorizationRuleUseCase, *TransactionUseCase, *transactionRepositoryStub, *categoryRepositoryStub, *categorizationRuleRepositoryStub) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["acc"] = &entity.Account{ID: "acc", UserID: "user", Currency: entity.CurrencyBRL}
+	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
+		"transport": {ID: "transport", Type: entity.CategoryTypeExpense},
+		"market":    {ID: "market", Type: entity.CategoryTypeExpense},
+		"salary":    {ID: "salary", Type: entity.CategoryTypeIncome},
+	}}
+	ruleRepo := &categorizationRuleRepositoryStub{}
+	transactions := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, ruleRepo, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, nil, "queue", nil)
+	rules := NewCategorizationRuleUseCase(ruleRepo, categoryRepo, txRepo)
+	return rules, transactions, txRepo, categoryRepo, ruleRepo
+}
+
+// TestCategorizationRuleAplicadaNaCriacao garante categoria pela regra de maior prioridade, tags e renomeação
+func TestCategorizationRuleAplicadaNaCriacao(t *testing.T) {
+	rules, transactions, _, _, _ := newRuleTestUseCases()
+	ctx := context.Background()
+
+	if _, err := rules.CreateRule(ctx, "user", dto.CreateCategorizationRuleRequest{
+		Name:       "Tudo acima de 10",
+		Priority:   20,
+		Conditions: dto.RuleConditions{MinAmount: moneyPointer(entity.MoneyFromInt(10))},
+		Actions:    dto.RuleActions{CategoryID: "market", AddTags: []string{"revisar"}},
+	}); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if _, err := rules.CreateRule(ctx, "user", dto.CreateCategorizationRuleRequest{
+		Name:       "Uber",
+		Priority:   10,
+		Conditions: dto.RuleConditions{DescriptionRegex: `^pag\*uber`},
+		Actions:    dto.RuleActions{CategoryID: "transport", AddTags: []string{"mobilidade"}, Description: "Uber"},
+	}); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	resp, err := transactions.RecordTransaction(ctx, "user", dto.CreateTransactionRequest{
+		AccountID:   "acc",
+		Amount:      entity.MoneyFromInt(32),
+		Currency:    "BRL",
+		Description: "PAG*UBER TRIP 1234",
+		OccurredAt:  time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if resp.CategoryID != "transport" || resp.Description != "Uber" || resp.Type != string(entity.TransactionTypeExpense) {
+		t.Fatalf("regra de maior prioridade deveria definir categoria e descrição: %+v", resp)
+	}
+	if len(resp.Tags) != 2 || resp.Tags[0] != "mobilidade" || resp.Tags[1] != "revisar" {
+		t.Fatalf("tags das duas regras deveriam ser adicionadas: %v", resp.Tags)
+	}
+
+	explicit, err := transactions.RecordTransaction(ctx, "user", dto.CreateTransactionRequest{
+		AccountID:   "acc",
+		CategoryID:  "market",
+		Amount:      entity.MoneyFromInt(15),
+		Currency:    "BRL",
+		Description: "PAG*UBER EATS",
+		OccurredAt:  time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if explicit.CategoryID != "market" {
+		t.Fatalf("categoria informada não deveria ser substituída pela regra, obteve %s", explicit.CategoryID)
+	}
+
+	if _, err := transactions.RecordTransaction(ctx, "user", dto.CreateTransactionRequest{
+		AccountID:   "acc",
+		Amount:      entity.MoneyFromInt(5),
+		Currency:    "BRL",
+		Description: "Padaria",
+		OccurredAt:  time.Now(),
+	}); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("esperava ErrInvalidInput sem categoria e sem regra, obteve %v", err)
+	}
+}
+
+// TestCategorizationRuleInvalida garante que regras sem critério, com regex inválida ou categoria inexistente são recusadas
+func TestCategorizationRuleInvalida(t *testing.T) {
+	rules, _, _, _, ruleRepo := newRuleTestUseCases()
+	ctx := context.Background()
+
+	requests := []dto.CreateCategorizationRuleRequest{
+		{Name: "sem critério", Actions: dto.RuleActions{CategoryID: "market"}},
+		{Name: "regex", Conditions: dto.RuleConditions{DescriptionRegex: "(uber"}, Actions: dto.RuleActions{CategoryID: "market"}},
+		{Name: "sem ação", Conditions: dto.RuleConditions{DescriptionContains: "uber"}},
+		{Name: "categoria", Conditions: dto.RuleConditions{DescriptionContains: "uber"}, Actions: dto.RuleActions{CategoryID: "missing"}},
+		{Name: "faixa", Conditions: dto.RuleConditions{MinAmount: moneyPointer(entity.MoneyFromInt(20)), MaxAmount: moneyPointer(entity.MoneyFromInt(10))}, Actions: dto.RuleActions{AddTags: []string{"x"}}},
+	}
+	for _, request := range requests {
+		if _, err := rules.CreateRule(ctx, "user", request); !errors.Is(err, domainerrors.ErrInvalidInput) {
+			t.Fatalf("regra %q: esperava ErrInvalidInput, obteve %v", request.Name, err)
+		}
+	}
+	if len(ruleRepo.rules) != 0 {
+		t.Fatalf("regras inválidas não deveriam ser gravadas")
+	}
+}
+
+// TestCategorizationRuleDryRun garante a simulação sobre transações existentes sem alterá-las
+func TestCategorizationRuleDryRun(t *testing.T) {
+	rules, transactions, txRepo, _, _ := newRuleTestUseCases()
+	ctx := context.Background()
+
+	for _, description := range []string{"Supermercado Pão de Açúcar", "Cinema"} {
+		if _, err := transactions.RecordTransaction(ctx, "user", dto.CreateTransactionRequest{
+			AccountID:   "acc",
+			CategoryID:  "transport",
+			Amount:      entity.MoneyFromInt(80),
+			Currency:    "BRL",
+			Description: description,
+			OccurredAt:  time.Now().Add(-time.Hour),
+		}); err != nil {
+			t.Fatalf("não esperava erro: %v", err)
+		}
+	}
+	// Perna de uma compra de ativo: tipo de transferência sem TransferID, fora das regras
+	txRepo.storage["trade"] = &entity.Transaction{
+		ID: "trade", UserID: "user", AccountID: "acc", CategoryID: "transport", Type: entity.TransactionTypeTransferOut,
+		Amount: entity.MoneyFromInt(80), Currency: entity.CurrencyBRL, Description: "Supermercado Pão de Açúcar",
+		Status: entity.TransactionStatusCompleted, OccurredAt: time.Now().Add(-time.Hour),
+	}
+
+	result, err := rules.DryRun(ctx, "user", dto.DryRunRulesRequest{
+		Limit: 10,
+		Rule: &dto.CreateCategorizationRuleRequest{
+			Name:       "Mercado",
+			Conditions: dto.RuleConditions{DescriptionContains: "pao de acucar"},
+			Actions:    dto.RuleActions{CategoryID: "market", AddTags: []string{"casa"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if result.Evaluated != 2 || result.Matched != 1 || len(result.Results) != 1 {
+		t.Fatalf("simulação inesperada: %+v", result)
+	}
+	match := result.Results[0]
+	if match.NewCategoryID != "market" || len(match.AddedTags) != 1 || match.MatchedRules[0] != "Mercado" {
+		t.Fatalf("resultado inesperado: %+v", match)
+	}
+	for _, transaction := range txRepo.storage {
+		if transaction.CategoryID != "transport" || len(transaction.Tags) != 0 {
+			t.Fatalf("simulação não deveria alterar transações gravadas")
+		}
+	}
+}
+
+// TestImportCommitAplicaRegras garante regras na importação e categoria padrão quando o tipo da regra não confere
+func TestImportCommitAplicaRegras(t *testing.T) {
+	rules, transactions, txRepo, categoryRepo, ruleRepo := newRuleTestUseCases()
+	ctx := context.Background()
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["acc"] = &entity.Account{ID: "acc", UserID: "user", Currency: entity.CurrencyBRL}
+	imports := NewImportUseCase(
+		&importProfileRepositoryStub{storage: map[string]*entity.ImportProfile{}},
+		&importBatchRepositoryStub{storage: map[string]*entity.ImportBatch{}},
+		accountRepo,
+		categoryRepo,
+		txRepo,
+		transactions,
+	)
+
+	if _, err := rules.CreateRule(ctx, "user", dto.CreateCategorizationRuleRequest{
+		Name:       "Farmácia é mercado",
+		Conditions: dto.RuleConditions{DescriptionContains: "farmacia"},
+		Actions:    dto.RuleActions{CategoryID: "market", Description: "Farmácia"},
+	}); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if _, err := rules.CreateRule(ctx, "user", dto.CreateCategorizationRuleRequest{
+		Name:       "Salário como despesa",
+		Conditions: dto.RuleConditions{DescriptionContains: "salario"},
+		Actions:    dto.RuleActions{CategoryID: "transport"},
+	}); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(ruleRepo.rules) != 2 {
+		t.Fatalf("regras deveriam ser gravadas")
+	}
+
+	preview, err := imports.PreviewOFX(ctx, "user", "acc", "fevereiro.ofx", bytes.NewReader([]byte(ofxXMLStatement)))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if _, err := imports.CommitImport(ctx, "user", preview.ID, dto.CommitImportRequest{ExpenseCategoryID: "transport", IncomeCategoryID: "salary"}); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	categories := map[string]string{}
+	for _, transaction := range txRepo.created {
+		categories[transaction.Description] = transaction.CategoryID
+	}
+	if categories["Farmácia"] != "market" {
+		t.Fatalf("regra deveria categorizar e renomear a linha importada: %v", categories)
+	}
+	if categories["SALARIO"] != "salary" {
+		t.Fatalf("categoria de despesa não deveria ir para uma receita: %v", categories)
+	}
+}
+
+func moneyPointer(value entity.Money) *entity.Money {
+	return &value
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+// CreditCardUseCase agrupa as transações de contas de cartão de crédito em faturas. As faturas não são gravadas:
+// são calculadas pelo ciclo da conta, então lançamentos retroativos ou anulados se refletem imediatamente.
+type CreditCardUseCase struct {
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	transactions    *TransactionUseCase
+	now             func() time.Time
+}
+
+func NewCreditCardUseCase(accountRepo repository.AccountRepository, transactionRepo repository.TransactionRepository, transactions *TransactionUseCase) *CreditCardUseCase {
+	return &CreditCardUseCase{
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		transactions:    transactions,
+		now:             func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// GetSummary devolve a última fatura fechada, a fatura aberta, a próxima e o limite disponível
+func (uc *CreditCardUseCase) GetSummary(ctx context.Context, userID string, accountID string) (*dto.CreditCardSummaryResponse, error) {
+	account, err := uc.creditAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	card := *account.CreditCard
+	now := uc.now()
+	current := card.StatementFor(now)
+	closed := card.PreviousStatement(current)
+	next := card.NextStatement(current)
+
+	// Pagamentos de uma fatura são os feitos no período seguinte, e o saldo anterior de cada fatura é reconstruído
+	// desfazendo o que veio depois do início dela, então a busca não tem fim
+	transactions, err := uc.transactionRepo.List(ctx, userID, repository.TransactionFilter{
+		AccountIDs: []string{account.ID},
+		From:       closed.Start,
+	}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := resolveTransactionTypes(ctx, uc.transactions.categoryRepo, transactions); err != nil {
+		return nil, err
+	}
+
+	response := &dto.CreditCardSummaryResponse{
+		AccountID:       account.ID,
+		Currency:        account.Currency.String(),
+		Balance:         account.Balance,
+		CreditLimit:     card.CreditLimit,
+		AvailableCredit: card.AvailableCredit(account.Balance),
+		Current:         summarizeStatement(card, current, account.Balance, transactions, now),
+		Next:            summarizeStatement(card, next, account.Balance, transactions, now),
+	}
+	// Contas criadas depois do último fechamento ainda não têm fatura fechada
+	if account.CreatedAt.Before(closed.ClosingDate) {
+		statement := summarizeStatement(card, closed, account.Balance, transactions, now)
+		response.ClosedStatement = &statement
+	}
+	return response, nil
+}
+
+// PayStatement transfere da conta informada para o cartão; sem valor, paga o saldo em aberto da última fatura fechada
+func (uc *CreditCardUseCase) PayStatement(ctx context.Context, userID string, accountID string, request dto.PayStatementRequest) (*dto.TransferResponse, error) {
+	summary, err := uc.GetSummary(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var amount entity.Money
+	switch {
+	case request.Amount != nil:
+		amount = *request.Amount
+	case summary.ClosedStatement != nil:
+		amount = summary.ClosedStatement.Outstanding
+	}
+	if !amount.IsPositive() {
+		return nil, errors.ErrInvalidInput
+	}
+
+	occurredAt := uc.now()
+	if request.OccurredAt != nil {
+		occurredAt = *request.OccurredAt
+	}
+	description := request.Description
+	if description == "" && summary.ClosedStatement != nil {
+		description = "Statement " + summary.ClosedStatement.ClosingDate.Format("2006-01-02")
+	}
+
+	return uc.transactions.RecordTransfer(ctx, userID, dto.CreateTransferRequest{
+		FromAccountID: request.FromAccountID,
+		ToAccountID:   accountID,
+		Amount:        amount,
+		Description:   description,
+		OccurredAt:    occurredAt,
+	})
+}
+
+func (uc *CreditCardUseCase) creditAccount(ctx context.Context, userID string, accountID string) (*entity.Account, error) {
+	account, err := uc.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, errors.ErrNotFound
+	}
+	if account.Type != entity.AccountTypeCredit || account.CreditCard == nil {
+		return nil, errors.ErrInvalidInput
+	}
+	return account, nil
+}
+
+// summarizeStatement soma o saldo deixado sem pagar nas faturas anteriores, as compras e créditos do período da
+// fatura e os pagamentos (transferências recebidas) feitos no período seguinte. balance é o saldo atual da conta e
+// transactions precisa ter todas as transações a partir do início da fatura, com o tipo resolvido
+func summarizeStatement(card entity.CreditCard, statement entity.Statement, balance entity.Money, transactions []*entity.Transaction, now time.Time) dto.StatementResponse {
+	following := card.NextStatement(statement)
+	response := dto.StatementResponse{
+		PeriodStart: statement.Start,
+		ClosingDate: statement.ClosingDate,
+		DueDate:     statement.DueDate,
+		Closed:      !now.Before(statement.ClosingDate),
+		// A dívida no início da fatura, menos os pagamentos feitos durante ela (que quitam a anterior), ficou sem pagar
+		PreviousBalance: entity.BalanceBefore(balance, transactions, statement.Start).Neg(),
+	}
+	for _, transaction := range transactions {
+		switch {
+		case statement.Contains(transaction.OccurredAt):
+			switch transaction.Type {
+			case entity.TransactionTypeExpense, entity.TransactionTypeTransferOut:
+				response.Charges = response.Charges.Add(transaction.Amount)
+			case entity.TransactionTypeIncome:
+				response.Credits = response.Credits.Add(transaction.Amount)
+			case entity.TransactionTypeTransferIn:
+				response.PreviousBalance = response.PreviousBalance.Sub(transaction.Amount)
+			}
+		case following.Contains(transaction.OccurredAt) && transaction.Type == entity.TransactionTypeTransferIn:
+			response.Paid = response.Paid.Add(transaction.Amount)
+		}
+	}
+	if response.PreviousBalance.IsNegative() {
+		response.PreviousBalance = entity.ZeroMoney
+	}
+	response.Total = response.PreviousBalance.Add(response.Charges).Sub(response.Credits)
+	response.Outstanding = response.Total.Sub(response.Paid)
+	if response.Outstanding.IsNegative() {
+		response.Outstanding = entity.ZeroMoney
+	}
+	return response
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+func newCreditCardFixture(t *testing.T) (*CreditCardUseCase, *accountRepositoryStub, *transactionRepositoryStub) {
+	t.Helper()
+	f := newTransactionFixture().withAccounts(
+		&entity.Account{
+			ID: "card", UserID: "user", Type: entity.AccountTypeCredit, Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(-140),
+			CreditCard: &entity.CreditCard{ClosingDay: 25, DueDay: 5, CreditLimit: entity.MoneyFromInt(1000)},
+			CreatedAt:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		&entity.Account{ID: "checking", UserID: "user", Type: entity.AccountTypeChecking, Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(2000)},
+	).withCategories(
+		&entity.Category{ID: "market", Type: entity.CategoryTypeExpense},
+	).withTransactions("card",
+		&entity.Transaction{ID: "t1", Type: entity.TransactionTypeExpense, Amount: entity.MoneyFromInt(100), OccurredAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		&entity.Transaction{ID: "t2", Type: entity.TransactionTypeIncome, Amount: entity.MoneyFromInt(20), OccurredAt: time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)},
+		// Registro antigo, sem tipo: é uma compra pela categoria
+		&entity.Transaction{ID: "t3", CategoryID: "market", Amount: entity.MoneyFromInt(50), OccurredAt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		&entity.Transaction{ID: "t4", Type: entity.TransactionTypeTransferIn, Amount: entity.MoneyFromInt(30), OccurredAt: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)},
+		&entity.Transaction{ID: "t5", Type: entity.TransactionTypeExpense, Amount: entity.MoneyFromInt(40), OccurredAt: time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC)},
+	)
+
+	uc := NewCreditCardUseCase(f.accountRepo, f.txRepo, f.transactions())
+	uc.now = func() time.Time { return time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC) }
+	return uc, f.accountRepo, f.txRepo
+}
+
+// TestCreditCardUseCaseGetSummary garante o agrupamento das compras por fatura e o abatimento dos pagamentos
+func TestCreditCardUseCaseGetSummary(t *testing.T) {
+	uc, _, _ := newCreditCardFixture(t)
+
+	summary, err := uc.GetSummary(context.Background(), "user", "card")
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if summary.AvailableCredit.Cmp(entity.MoneyFromInt(860)) != 0 {
+		t.Fatalf("limite disponível inesperado: %s", summary.AvailableCredit)
+	}
+
+	closed := summary.ClosedStatement
+	if closed == nil || !closed.Closed || !closed.DueDate.Equal(time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("fatura fechada inesperada: %+v", closed)
+	}
+	if closed.Total.Cmp(entity.MoneyFromInt(80)) != 0 || closed.Paid.Cmp(entity.MoneyFromInt(30)) != 0 || closed.Outstanding.Cmp(entity.MoneyFromInt(50)) != 0 {
+		t.Fatalf("totais da fatura fechada inesperados: %+v", closed)
+	}
+	if !closed.PreviousBalance.IsZero() {
+		t.Fatalf("a primeira fatura não tem saldo anterior: %+v", closed)
+	}
+	if summary.Current.Closed || summary.Current.Charges.Cmp(entity.MoneyFromInt(50)) != 0 || !summary.Current.DueDate.Equal(time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("fatura aberta inesperada: %+v", summary.Current)
+	}
+	// Os 50 que sobraram da fatura fechada depois do pagamento de 30 passam para a fatura aberta
+	if summary.Current.PreviousBalance.Cmp(entity.MoneyFromInt(50)) != 0 || summary.Current.Total.Cmp(entity.MoneyFromInt(100)) != 0 {
+		t.Fatalf("saldo anterior da fatura aberta inesperado: %+v", summary.Current)
+	}
+	if summary.Next.Charges.Cmp(entity.MoneyFromInt(40)) != 0 {
+		t.Fatalf("compra no dia do fechamento deveria ir para a próxima fatura: %+v", summary.Next)
+	}
+	if summary.Next.PreviousBalance.Cmp(entity.MoneyFromInt(100)) != 0 || summary.Next.Total.Cmp(entity.MoneyFromInt(140)) != 0 {
+		t.Fatalf("saldo anterior da próxima fatura inesperado: %+v", summary.Next)
+	}
+}
+
+// TestCreditCardUseCasePayStatement garante que o pagamento padrão quita o saldo em aberto da fatura fechada
+func TestCreditCardUseCasePayStatement(t *testing.T) {
+	uc, accountRepo, _ := newCreditCardFixture(t)
+	ctx := context.Background()
+
+	response, err := uc.PayStatement(ctx, "user", "card", dto.PayStatementRequest{FromAccountID: "checking"})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if response.From.AccountID != "checking" || response.To.AccountID != "card" || response.To.Amount.Cmp(entity.MoneyFromInt(50)) != 0 {
+		t.Fatalf("pagamento inesperado: %+v", response)
+	}
+	if balance := accountRepo.storage["card"].Balance; balance.Cmp(entity.MoneyFromInt(-90)) != 0 {
+		t.Fatalf("saldo do cartão deveria ser abatido, obtido %s", balance)
+	}
+
+	summary, err := uc.GetSummary(ctx, "user", "card")
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if !summary.ClosedStatement.Outstanding.IsZero() {
+		t.Fatalf("fatura fechada deveria estar quitada: %+v", summary.ClosedStatement)
+	}
+	if !summary.Current.PreviousBalance.IsZero() {
+		t.Fatalf("fatura quitada não deveria passar saldo para a aberta: %+v", summary.Current)
+	}
+	if _, err := uc.PayStatement(ctx, "user", "card", dto.PayStatementRequest{FromAccountID: "checking"}); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("sem saldo em aberto o pagamento padrão deveria ser recusado, obteve %v", err)
+	}
+}
+
+// TestCreditCardUseCaseContaSemCartao garante que faturas só existem para contas de cartão com ciclo configurado
+func TestCreditCardUseCaseContaSemCartao(t *testing.T) {
+	uc, _, _ := newCreditCardFixture(t)
+	if _, err := uc.GetSummary(context.Background(), "user", "checking"); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("esperava ErrInvalidInput, obteve %v", err)
+	}
+	if _, err := uc.GetSummary(context.Background(), "user", "missing"); !errors.Is(err, domainerrors.ErrNotFound) {
+		t.Fatalf("esperava ErrNotFound, obteve %v", err)
+	}
+
+	accounts := NewAccountUseCase(newAccountRepositoryStub(), nil, nil, nil, nil, nil, nil, nil, nil)
+	_, err := accounts.CreateAccount(context.Background(), "user", dto.CreateAccountRequest{
+		Name: "Corrente", Type: "checking", Currency: "BRL",
+		CreditCard: &dto.CreditCard{ClosingDay: 25, DueDay: 5},
+	})
+	if !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("ciclo de fatura em conta corrente deveria ser recusado, obteve %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+type CurrencyUseCase struct{}
+
+func NewCurrencyUseCase() *CurrencyUseCase {
+	return &CurrencyUseCase{}
+}
+
+// ListCurrencies devolve as moedas habilitadas ou, com includeDisabled, todo o catálogo ISO 4217.
+// Os nomes são traduzidos para o locale informado (en ou pt-BR).
+func (uc *CurrencyUseCase) ListCurrencies(includeDisabled bool, locale string) []*dto.CurrencyResponse {
+	enabled := map[entity.Currency]bool{}
+	for _, currency := range entity.EnabledCurrencies() {
+		enabled[currency] = true
+	}
+
+	var catalog []entity.CurrencyInfo
+	if includeDisabled {
+		catalog = entity.ISOCurrencies()
+	} else {
+		for _, currency := range entity.EnabledCurrencies() {
+			if info, ok := currency.Info(); ok {
+				catalog = append(catalog, info)
+			}
+		}
+	}
+
+	response := make([]*dto.CurrencyResponse, 0, len(catalog))
+	for _, info := range catalog {
+		response = append(response, &dto.CurrencyResponse{
+			Code:        info.Code.String(),
+			NumericCode: info.Numeric,
+			MinorUnits:  info.MinorUnits,
+			Symbol:      info.Symbol,
+			Name:        info.LocalizedName(locale),
+			Enabled:     enabled[info.Code],
+		})
+	}
+	return response
+}
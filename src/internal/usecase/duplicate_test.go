@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+func newDuplicateTestUseCase() (*TransactionUseCase, *transactionRepositoryStub, *accountRepositoryStub) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["acc"] = &entity.Account{ID: "acc", UserID: "user", Currency: "BRL", Balance: entity.MoneyFromInt(1000)}
+	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
+		"cat": {ID: "cat", Type: entity.CategoryTypeExpense},
+	}}
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, nil, "queue", nil)
+	return uc, txRepo, accountRepo
+}
+
+func duplicateRequest(description string, occurredAt time.Time) dto.CreateTransactionRequest {
+	return dto.CreateTransactionRequest{
+		AccountID:   "acc",
+		CategoryID:  "cat",
+		Amount:      entity.MoneyFromInt(50),
+		Currency:    "BRL",
+		Description: description,
+		OccurredAt:  occurredAt,
+	}
+}
+
+// TestTransactionUseCaseDuplicataMarcada garante que a mesma compra lançada dois dias depois é gravada e marcada
+func TestTransactionUseCaseDuplicataMarcada(t *testing.T) {
+	uc, _, _ := newDuplicateTestUseCase()
+	ctx := context.Background()
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	original, err := uc.RecordTransaction(ctx, "user", duplicateRequest("Padaria São João", day))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	duplicate, err := uc.RecordTransaction(ctx, "user", duplicateRequest("PADARIA SAO JOAO.", day.AddDate(0, 0, 2)))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if duplicate.DuplicateOf != original.ID {
+		t.Fatalf("esperava duplicata de %s, obteve %q", original.ID, duplicate.DuplicateOf)
+	}
+
+	outside, err := uc.RecordTransaction(ctx, "user", duplicateRequest("Padaria São João", day.AddDate(0, 0, 6)))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if outside.DuplicateOf != "" {
+		t.Fatalf("lançamento fora da janela não deveria ser marcado")
+	}
+
+	list, err := uc.ListDuplicates(ctx, "user", 100, 0)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(list) != 1 || list[0].Transaction.ID != duplicate.ID || list[0].Original == nil || list[0].Original.ID != original.ID {
+		t.Fatalf("lista de duplicatas inesperada: %#v", list)
+	}
+}
+
+// TestTransactionUseCaseDuplicataRecusada garante ErrConflict com onDuplicate=reject e gravação livre com allow
+func TestTransactionUseCaseDuplicataRecusada(t *testing.T) {
+	uc, txRepo, _ := newDuplicateTestUseCase()
+	ctx := context.Background()
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	if _, err := uc.RecordTransaction(ctx, "user", duplicateRequest("Mercado", day)); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	rejected := duplicateRequest("mercado", day.AddDate(0, 0, -1))
+	rejected.OnDuplicate = string(entity.DuplicatePolicyReject)
+	if _, err := uc.RecordTransaction(ctx, "user", rejected); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("esperava ErrConflict, obteve %v", err)
+	}
+	if len(txRepo.created) != 1 {
+		t.Fatalf("duplicata recusada não deveria ser gravada")
+	}
+
+	allowed := duplicateRequest("mercado", day)
+	allowed.OnDuplicate = string(entity.DuplicatePolicyAllow)
+	resp, err := uc.RecordTransaction(ctx, "user", allowed)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if resp.DuplicateOf != "" {
+		t.Fatalf("onDuplicate=allow não deveria marcar a transação")
+	}
+}
+
+// TestTransactionUseCaseMergeDuplicate garante que a fusão anula a duplicata, estorna o saldo e preserva as tags
+func TestTransactionUseCaseMergeDuplicate(t *testing.T) {
+	uc, txRepo, accountRepo := newDuplicateTestUseCase()
+	ctx := context.Background()
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	original, err := uc.RecordTransaction(ctx, "user", duplicateRequest("Farmácia", day))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	request := duplicateRequest("Farmacia", day.AddDate(0, 0, 1))
+	request.Tags = []string{"saude"}
+	duplicate, err := uc.RecordTransaction(ctx, "user", request)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	merged, err := uc.MergeDuplicate(ctx, "user", duplicate.ID)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if merged.ID != original.ID || len(merged.Tags) != 1 || merged.Tags[0] != "saude" {
+		t.Fatalf("original deveria receber as tags da duplicata: %#v", merged)
+	}
+	if txRepo.storage[duplicate.ID].Status != entity.TransactionStatusVoided {
+		t.Fatalf("duplicata deveria ser anulada")
+	}
+	if accountRepo.storage["acc"].Balance != entity.MoneyFromInt(950) {
+		t.Fatalf("saldo deveria refletir só a original, obteve %v", accountRepo.storage["acc"].Balance)
+	}
+
+	if _, err := uc.MergeDuplicate(ctx, "user", duplicate.ID); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("esperava ErrConflict ao fundir novamente, obteve %v", err)
+	}
+}
+
+// TestTransactionUseCaseMergeDuplicateConciliada garante que a fusão recusada não altera a original
+func TestTransactionUseCaseMergeDuplicateConciliada(t *testing.T) {
+	uc, txRepo, _ := newDuplicateTestUseCase()
+	ctx := context.Background()
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	original, err := uc.RecordTransaction(ctx, "user", duplicateRequest("Farmácia", day))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	request := duplicateRequest("Farmacia", day.AddDate(0, 0, 1))
+	request.Tags = []string{"saude"}
+	duplicate, err := uc.RecordTransaction(ctx, "user", request)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	txRepo.storage[duplicate.ID].ReconciliationID = "rec"
+
+	if _, err := uc.MergeDuplicate(ctx, "user", duplicate.ID); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("esperava ErrConflict para duplicata conciliada, obteve %v", err)
+	}
+	if len(txRepo.storage[original.ID].Tags) != 0 || txRepo.storage[duplicate.ID].Status == entity.TransactionStatusVoided {
+		t.Fatalf("fusão recusada não deveria alterar nenhuma das transações")
+	}
+}
+
+// TestTransactionUseCaseDismissDuplicate garante que descartar a suspeita mantém as duas transações
+func TestTransactionUseCaseDismissDuplicate(t *testing.T) {
+	uc, txRepo, _ := newDuplicateTestUseCase()
+	ctx := context.Background()
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	if _, err := uc.RecordTransaction(ctx, "user", duplicateRequest("Uber", day)); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	duplicate, err := uc.RecordTransaction(ctx, "user", duplicateRequest("Uber", day))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	resp, err := uc.DismissDuplicate(ctx, "user", duplicate.ID)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if resp.DuplicateOf != "" || txRepo.storage[duplicate.ID].DuplicateOf != "" {
+		t.Fatalf("marcação de duplicata deveria ser removida")
+	}
+	if txRepo.storage[duplicate.ID].Status == entity.TransactionStatusVoided {
+		t.Fatalf("transação descartada como duplicata não deveria ser anulada")
+	}
+	if _, err := uc.DismissDuplicate(ctx, "user", "missing"); !errors.Is(err, domainerrors.ErrNotFound) {
+		t.Fatalf("esperava ErrNotFound, obteve %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/port"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+// exchangeRatePivot é a moeda usada para cotação cruzada quando não existe o par direto
+const exchangeRatePivot = entity.CurrencyUSD
+
+type ExchangeRateUseCase struct {
+	rateRepo repository.ExchangeRateRepository
+	provider port.RateProvider
+}
+
+// NewExchangeRateUseCase cria o serviço de câmbio; provider pode ser nil quando só há conversão
+func NewExchangeRateUseCase(rateRepo repository.ExchangeRateRepository, provider port.RateProvider) *ExchangeRateUseCase {
+	return &ExchangeRateUseCase{rateRepo: rateRepo, provider: provider}
+}
+
+// SyncRates copia para o repositório as cotações do provider no intervalo informado
+func (uc *ExchangeRateUseCase) SyncRates(ctx context.Context, from time.Time, to time.Time) (int, error) {
+	if uc.provider == nil {
+		return 0, nil
+	}
+	rates, err := uc.provider.FetchRates(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+	for i := range rates {
+		rate := rates[i]
+		rate.Date = entity.ExchangeRateDate(rate.Date)
+		if err := uc.rateRepo.Upsert(ctx, &rate); err != nil {
+			return i, err
+		}
+	}
+	return len(rates), nil
+}
+
+// Rate devolve quantas unidades de to valem uma unidade de from na data informada,
+// usando o par direto, o inverso ou a cotação cruzada via exchangeRatePivot
+func (uc *ExchangeRateUseCase) Rate(ctx context.Context, from entity.Currency, to entity.Currency, at time.Time) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+	rate, found, err := uc.pairRate(ctx, from, to, at)
+	if err != nil || found {
+		return rate, err
+	}
+	if from == exchangeRatePivot || to == exchangeRatePivot {
+		return 0, errors.ErrRateNotFound
+	}
+
+	fromPivot, found, err := uc.pairRate(ctx, from, exchangeRatePivot, at)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, errors.ErrRateNotFound
+	}
+	pivotTo, found, err := uc.pairRate(ctx, exchangeRatePivot, to, at)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, errors.ErrRateNotFound
+	}
+	return fromPivot * pivotTo, nil
+}
+
+// Convert converte o valor para a moeda de destino com a cotação do dia, arredondando para as casas decimais da moeda
+func (uc *ExchangeRateUseCase) Convert(ctx context.Context, amount entity.Money, from entity.Currency, to entity.Currency, at time.Time) (entity.Money, error) {
+	if from == to || amount.IsZero() {
+		return amount, nil
+	}
+	rate, err := uc.Rate(ctx, from, to, at)
+	if err != nil {
+		return entity.ZeroMoney, err
+	}
+	return amount.Mul(rate).Round(to.MinorUnits()), nil
+}
+
+func (uc *ExchangeRateUseCase) pairRate(ctx context.Context, from entity.Currency, to entity.Currency, at time.Time) (float64, bool, error) {
+	direct, err := uc.rateRepo.FindOnOrBefore(ctx, from, to, at)
+	if err != nil {
+		return 0, false, err
+	}
+	inverse, err := uc.rateRepo.FindOnOrBefore(ctx, to, from, at)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// Quando os dois sentidos existem vale a cotação mais recente
+	switch {
+	case direct != nil && (inverse == nil || !inverse.Date.After(direct.Date)):
+		return direct.Rate, true, nil
+	case inverse != nil && inverse.Rate > 0:
+		return 1 / inverse.Rate, true, nil
+	default:
+		return 0, false, nil
+	}
+}
+
+// convertForDisplay converte um valor apenas para exibição: devolve nil quando a moeda já é a de destino
+// ou quando não há cotação, em vez de falhar a listagem inteira
+func (uc *ExchangeRateUseCase) convertForDisplay(ctx context.Context, amount entity.Money, from entity.Currency, to entity.Currency) *entity.Money {
+	if uc == nil || to == "" || from == to {
+		return nil
+	}
+	converted, err := uc.Convert(ctx, amount, from, to, time.Now().UTC())
+	if err != nil {
+		return nil
+	}
+	return &converted
+}
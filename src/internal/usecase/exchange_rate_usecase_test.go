@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+// TestExchangeRateConvertUsaCotacaoDaData garante o uso da cotação mais recente até a data informada
+func TestExchangeRateConvertUsaCotacaoDaData(t *testing.T) {
+	rates := &exchangeRateRepositoryStub{}
+	rates.add(entity.CurrencyUSD, entity.CurrencyBRL, 5.0, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	rates.add(entity.CurrencyUSD, entity.CurrencyBRL, 5.5, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	uc := NewExchangeRateUseCase(rates, nil)
+
+	converted, err := uc.Convert(context.Background(), entity.MoneyFromInt(10), entity.CurrencyUSD, entity.CurrencyBRL, time.Date(2024, 1, 20, 15, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if converted.Cmp(entity.MoneyFromInt(50)) != 0 {
+		t.Fatalf("esperava 50.00 pela cotação de janeiro, obteve %s", converted)
+	}
+
+	converted, err = uc.Convert(context.Background(), entity.MoneyFromInt(10), entity.CurrencyUSD, entity.CurrencyBRL, time.Date(2024, 2, 1, 23, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if converted.Cmp(entity.MoneyFromInt(55)) != 0 {
+		t.Fatalf("esperava 55.00 pela cotação de fevereiro, obteve %s", converted)
+	}
+
+	if _, err := uc.Convert(context.Background(), entity.MoneyFromInt(10), entity.CurrencyUSD, entity.CurrencyBRL, time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)); err != errors.ErrRateNotFound {
+		t.Fatalf("esperava ErrRateNotFound antes da primeira cotação, obteve %v", err)
+	}
+}
+
+// TestExchangeRateConvertInversoECruzado garante par inverso, cotação cruzada via USD e arredondamento pela moeda de destino
+func TestExchangeRateConvertInversoECruzado(t *testing.T) {
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	rates := &exchangeRateRepositoryStub{}
+	rates.add(entity.CurrencyUSD, entity.CurrencyBRL, 5.0, day)
+	rates.add(entity.CurrencyEUR, entity.CurrencyUSD, 1.1, day)
+	rates.add(entity.CurrencyUSD, entity.Currency("JPY"), 150.123, day)
+	uc := NewExchangeRateUseCase(rates, nil)
+
+	converted, err := uc.Convert(context.Background(), entity.MoneyFromInt(100), entity.CurrencyBRL, entity.CurrencyUSD, day)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if converted.Cmp(entity.MoneyFromInt(20)) != 0 {
+		t.Fatalf("esperava 20.00 pelo par inverso, obteve %s", converted)
+	}
+
+	converted, err = uc.Convert(context.Background(), entity.MoneyFromInt(10), entity.CurrencyEUR, entity.CurrencyBRL, day)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if converted.Cmp(entity.MoneyFromInt(55)) != 0 {
+		t.Fatalf("esperava 55.00 pela cotação cruzada, obteve %s", converted)
+	}
+
+	converted, err = uc.Convert(context.Background(), entity.MoneyFromInt(1), entity.CurrencyUSD, entity.Currency("JPY"), day)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if converted.Cmp(entity.MoneyFromInt(150)) != 0 {
+		t.Fatalf("iene não tem casas decimais, esperava 150, obteve %s", converted)
+	}
+
+	if _, err := uc.Convert(context.Background(), entity.MoneyFromInt(1), entity.CurrencyGBP, entity.CurrencyBRL, day); err != errors.ErrRateNotFound {
+		t.Fatalf("esperava ErrRateNotFound sem cotação para GBP, obteve %v", err)
+	}
+}
+
+// TestExchangeRateSyncRates garante que as cotações do provider são gravadas por dia
+func TestExchangeRateSyncRates(t *testing.T) {
+	rates := &exchangeRateRepositoryStub{}
+	provider := &rateProviderStub{rates: []entity.ExchangeRate{
+		{BaseCurrency: entity.CurrencyUSD, QuoteCurrency: entity.CurrencyBRL, Rate: 5.1, Date: time.Date(2024, 4, 1, 18, 30, 0, 0, time.UTC)},
+		{BaseCurrency: entity.CurrencyUSD, QuoteCurrency: entity.CurrencyBRL, Rate: 5.2, Date: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}}
+	uc := NewExchangeRateUseCase(rates, provider)
+
+	count, err := uc.SyncRates(context.Background(), time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if count != 2 || len(rates.rates) != 1 {
+		t.Fatalf("esperava uma cotação por par e dia, obteve %d gravações e %d cotações", count, len(rates.rates))
+	}
+	if rates.rates[0].Rate != 5.2 {
+		t.Fatalf("a última cotação do dia deveria prevalecer, obteve %v", rates.rates[0].Rate)
+	}
+}
@@ -13,12 +13,14 @@ import (
 )
 
 type GoalUseCase struct {
-	goalRepo repository.GoalRepository
+	goalRepo      repository.GoalRepository
+	exchangeRates *ExchangeRateUseCase
 }
 
-func NewGoalUseCase(goalRepo repository.GoalRepository) *GoalUseCase {
+func NewGoalUseCase(goalRepo repository.GoalRepository, exchangeRates *ExchangeRateUseCase) *GoalUseCase {
 	return &GoalUseCase{
-		goalRepo: goalRepo,
+		goalRepo:      goalRepo,
+		exchangeRates: exchangeRates,
 	}
 }
 
@@ -29,7 +31,7 @@ func (uc *GoalUseCase) CreateGoal(ctx context.Context, userID string, request dt
 		UserID:        userID,
 		Name:          request.Name,
 		TargetAmount:  request.TargetAmount,
-		CurrentAmount: 0,
+		CurrentAmount: entity.ZeroMoney,
 		Currency:      entity.Currency(request.Currency),
 		Deadline:      request.Deadline,
 		Description:   request.Description,
@@ -54,7 +56,8 @@ func (uc *GoalUseCase) CreateGoal(ctx context.Context, userID string, request dt
 	}, nil
 }
 
-func (uc *GoalUseCase) ListGoals(ctx context.Context, userID string, limit int64, offset int64) ([]*dto.GoalResponse, error) {
+// ListGoals lista as metas incluindo os valores convertidos para displayCurrency quando a moeda difere
+func (uc *GoalUseCase) ListGoals(ctx context.Context, userID string, displayCurrency string, limit int64, offset int64) ([]*dto.GoalResponse, error) {
 	goals, err := uc.goalRepo.List(ctx, userID, limit, offset)
 	if err != nil {
 		return nil, err
@@ -62,22 +65,56 @@ func (uc *GoalUseCase) ListGoals(ctx context.Context, userID string, limit int64
 
 	response := make([]*dto.GoalResponse, 0, len(goals))
 	for _, goal := range goals {
-		response = append(response, &dto.GoalResponse{
-			ID:            goal.ID,
-			Name:          goal.Name,
-			TargetAmount:  goal.TargetAmount,
-			CurrentAmount: goal.CurrentAmount,
-			Currency:      goal.Currency.String(),
-			Deadline:      goal.Deadline,
-			Status:        string(goal.Status),
-			Description:   goal.Description,
-		})
+		response = append(response, uc.toListedGoalResponse(ctx, goal, displayCurrency))
 	}
 
 	return response, nil
 }
 
-func (uc *GoalUseCase) UpdateProgress(ctx context.Context, userID string, goalID string, amount float64) (*dto.GoalResponse, error) {
+// ListGoalsPage lista as metas por chave (created_at, _id); cursor vazio devolve a primeira página
+func (uc *GoalUseCase) ListGoalsPage(ctx context.Context, userID string, displayCurrency string, cursor string, limit int64) (*dto.GoalPage, error) {
+	after, err := decodePageCursor(cursor, createdAtCursorSort)
+	if err != nil {
+		return nil, err
+	}
+	goals, err := uc.goalRepo.ListAfter(ctx, userID, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	goals, hasMore := splitPage(goals, limit)
+
+	page := &dto.GoalPage{Items: make([]*dto.GoalResponse, 0, len(goals))}
+	for _, goal := range goals {
+		page.Items = append(page.Items, uc.toListedGoalResponse(ctx, goal, displayCurrency))
+	}
+	if hasMore {
+		last := goals[len(goals)-1]
+		page.NextCursor = encodePageCursor(repository.NewTimeCursor(createdAtCursorSort, last.CreatedAt, last.ID))
+	}
+	return page, nil
+}
+
+func (uc *GoalUseCase) toListedGoalResponse(ctx context.Context, goal *entity.Goal, displayCurrency string) *dto.GoalResponse {
+	target := entity.Currency(displayCurrency)
+	item := &dto.GoalResponse{
+		ID:            goal.ID,
+		Name:          goal.Name,
+		TargetAmount:  goal.TargetAmount,
+		CurrentAmount: goal.CurrentAmount,
+		Currency:      goal.Currency.String(),
+		Deadline:      goal.Deadline,
+		Status:        string(goal.Status),
+		Description:   goal.Description,
+	}
+	if convertedTarget := uc.exchangeRates.convertForDisplay(ctx, goal.TargetAmount, goal.Currency, target); convertedTarget != nil {
+		item.DisplayCurrency = displayCurrency
+		item.ConvertedTargetAmount = convertedTarget
+		item.ConvertedCurrentAmount = uc.exchangeRates.convertForDisplay(ctx, goal.CurrentAmount, goal.Currency, target)
+	}
+	return item
+}
+
+func (uc *GoalUseCase) UpdateProgress(ctx context.Context, userID string, goalID string, amount entity.Money) (*dto.GoalResponse, error) {
 	goal, err := uc.goalRepo.GetByID(ctx, goalID, userID)
 	if err != nil {
 		return nil, err
@@ -86,8 +123,8 @@ func (uc *GoalUseCase) UpdateProgress(ctx context.Context, userID string, goalID
 		return nil, errors.ErrNotFound
 	}
 
-	goal.CurrentAmount += amount
-	if goal.CurrentAmount >= goal.TargetAmount {
+	goal.CurrentAmount = goal.CurrentAmount.Add(amount)
+	if goal.CurrentAmount.Cmp(goal.TargetAmount) >= 0 {
 		goal.Status = entity.GoalStatusCompleted
 	}
 	goal.UpdatedAt = time.Now().UTC()
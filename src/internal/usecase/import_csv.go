@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// decodeStatement converte o arquivo para UTF-8 conforme a codificação do perfil
+func decodeStatement(encoding entity.ImportEncoding, data []byte) io.Reader {
+	switch encoding {
+	case entity.ImportEncodingLatin1:
+		return charmap.ISO8859_1.NewDecoder().Reader(bytes.NewReader(data))
+	case entity.ImportEncodingWindows1252:
+		return charmap.Windows1252.NewDecoder().Reader(bytes.NewReader(data))
+	default:
+		return bytes.NewReader(bytes.TrimPrefix(data, utf8BOM))
+	}
+}
+
+// parseCSVStatement interpreta o extrato com o perfil informado. Linhas com problema voltam como
+// inválidas com a mensagem em Error para aparecerem na prévia; só erros de estrutura do CSV abortam.
+func parseCSVStatement(profile *entity.ImportProfile, data []byte) ([]entity.ImportRow, error) {
+	csvReader := csv.NewReader(decodeStatement(profile.Encoding, data))
+	csvReader.Comma = []rune(profile.Delimiter)[0]
+	csvReader.FieldsPerRecord = -1
+	csvReader.LazyQuotes = true
+	csvReader.TrimLeadingSpace = true
+
+	layout := profile.DateLayout()
+	var rows []entity.ImportRow
+	records := 0
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		records++
+		if records <= profile.SkipRows || isBlankRecord(record) {
+			continue
+		}
+
+		line, _ := csvReader.FieldPos(0)
+		rows = append(rows, parseStatementRecord(profile, layout, line, record))
+	}
+	return rows, nil
+}
+
+func parseStatementRecord(profile *entity.ImportProfile, layout string, line int, record []string) entity.ImportRow {
+	row := entity.ImportRow{Line: line, Status: entity.ImportRowStatusPending}
+	invalid := func(format string, args ...interface{}) entity.ImportRow {
+		row.Status = entity.ImportRowStatusInvalid
+		row.Error = fmt.Sprintf(format, args...)
+		return row
+	}
+
+	dateValue, ok := recordColumn(record, profile.DateColumn)
+	if !ok {
+		return invalid("missing date column %d", profile.DateColumn)
+	}
+	occurredAt, err := time.Parse(layout, dateValue)
+	if err != nil {
+		return invalid("invalid date %q", dateValue)
+	}
+	row.OccurredAt = occurredAt
+
+	description, _ := recordColumn(record, profile.DescriptionColumn)
+	row.Description = strings.Join(strings.Fields(description), " ")
+
+	var amount entity.Money
+	switch profile.SignConvention {
+	case entity.ImportSignDebitCreditColumns:
+		debit, err := optionalStatementAmount(record, *profile.DebitColumn, profile.DecimalSeparator)
+		if err != nil {
+			return invalid("%v", err)
+		}
+		credit, err := optionalStatementAmount(record, *profile.CreditColumn, profile.DecimalSeparator)
+		if err != nil {
+			return invalid("%v", err)
+		}
+		amount = credit.Abs().Sub(debit.Abs())
+	default:
+		value, ok := recordColumn(record, *profile.AmountColumn)
+		if !ok {
+			return invalid("missing amount column %d", *profile.AmountColumn)
+		}
+		amount, err = parseStatementAmount(value, profile.DecimalSeparator)
+		if err != nil {
+			return invalid("%v", err)
+		}
+		if profile.SignConvention == entity.ImportSignPositiveExpense {
+			amount = amount.Neg()
+		}
+	}
+
+	if amount.IsZero() {
+		return invalid("zero amount")
+	}
+	row.Type = entity.TransactionTypeIncome
+	if amount.IsNegative() {
+		row.Type = entity.TransactionTypeExpense
+	}
+	row.Amount = amount.Abs()
+	return row
+}
+
+func recordColumn(record []string, index int) (string, bool) {
+	if index < 0 || index >= len(record) {
+		return "", false
+	}
+	value := strings.TrimSpace(record[index])
+	return value, value != ""
+}
+
+func isBlankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func optionalStatementAmount(record []string, index int, decimalSeparator string) (entity.Money, error) {
+	value, ok := recordColumn(record, index)
+	if !ok {
+		return entity.ZeroMoney, nil
+	}
+	return parseStatementAmount(value, decimalSeparator)
+}
+
+// parseStatementAmount aceita formatos comuns de bancos: "R$ -1.234,56", "(12.30)" e "45,00-". Parênteses e
+// sinal no fim só tornam o valor negativo quando ele ainda não tem sinal, então "(-12.30)" continua negativo
+func parseStatementAmount(value string, decimalSeparator string) (entity.Money, error) {
+	text := strings.TrimSpace(value)
+	negative := false
+	if strings.HasPrefix(text, "(") && strings.HasSuffix(text, ")") {
+		negative = true
+		text = text[1 : len(text)-1]
+	}
+	if strings.HasSuffix(text, "-") {
+		negative = true
+		text = strings.TrimSuffix(text, "-")
+	}
+
+	thousandsSeparator := ","
+	if decimalSeparator == "," {
+		thousandsSeparator = "."
+	}
+	text = strings.ReplaceAll(text, thousandsSeparator, "")
+	text = strings.ReplaceAll(text, decimalSeparator, ".")
+	text = strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) || r == '.' || r == '-' || r == '+' {
+			return r
+		}
+		return -1
+	}, text)
+
+	if negative && !strings.HasPrefix(text, "-") && !strings.HasPrefix(text, "+") {
+		text = "-" + text
+	}
+
+	amount, err := entity.ParseMoney(text)
+	if err != nil {
+		return entity.ZeroMoney, fmt.Errorf("invalid amount %q", value)
+	}
+	return amount, nil
+}
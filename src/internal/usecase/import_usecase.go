@@ -0,0 +1,506 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+	"github.com/vasconcellos/financial-control/src/internal/infrastructure/ofx"
+)
+
+const MaxImportFileSizeBytes int64 = 5 * 1024 * 1024
+
+// importPreviewTTL é o prazo para confirmar uma prévia antes que ela expire
+const importPreviewTTL = 24 * time.Hour
+
+type ImportUseCase struct {
+	profileRepo        repository.ImportProfileRepository
+	batchRepo          repository.ImportBatchRepository
+	accountRepo        repository.AccountRepository
+	categoryRepo       repository.CategoryRepository
+	transactionRepo    repository.TransactionRepository
+	transactionUseCase *TransactionUseCase
+}
+
+func NewImportUseCase(
+	profileRepo repository.ImportProfileRepository,
+	batchRepo repository.ImportBatchRepository,
+	accountRepo repository.AccountRepository,
+	categoryRepo repository.CategoryRepository,
+	transactionRepo repository.TransactionRepository,
+	transactionUseCase *TransactionUseCase,
+) *ImportUseCase {
+	return &ImportUseCase{
+		profileRepo:        profileRepo,
+		batchRepo:          batchRepo,
+		accountRepo:        accountRepo,
+		categoryRepo:       categoryRepo,
+		transactionRepo:    transactionRepo,
+		transactionUseCase: transactionUseCase,
+	}
+}
+
+func (uc *ImportUseCase) CreateProfile(ctx context.Context, userID string, request dto.CreateImportProfileRequest) (*dto.ImportProfileResponse, error) {
+	now := time.Now().UTC()
+	profile := &entity.ImportProfile{
+		ID:                uuid.NewString(),
+		UserID:            userID,
+		Name:              request.Name,
+		Delimiter:         request.Delimiter,
+		Encoding:          entity.ImportEncoding(request.Encoding),
+		SkipRows:          request.SkipRows,
+		DateColumn:        request.DateColumn,
+		DescriptionColumn: request.DescriptionColumn,
+		AmountColumn:      request.AmountColumn,
+		DebitColumn:       request.DebitColumn,
+		CreditColumn:      request.CreditColumn,
+		DateFormat:        request.DateFormat,
+		DecimalSeparator:  request.DecimalSeparator,
+		SignConvention:    entity.ImportSignConvention(request.SignConvention),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	if profile.Delimiter == "" {
+		profile.Delimiter = ","
+	}
+	if profile.Encoding == "" {
+		profile.Encoding = entity.ImportEncodingUTF8
+	}
+	if profile.DecimalSeparator == "" {
+		profile.DecimalSeparator = "."
+	}
+	if !profile.IsValid() {
+		return nil, errors.ErrInvalidInput
+	}
+
+	if err := uc.profileRepo.Create(ctx, profile); err != nil {
+		return nil, err
+	}
+	return toImportProfileResponse(profile), nil
+}
+
+func (uc *ImportUseCase) ListProfiles(ctx context.Context, userID string) ([]*dto.ImportProfileResponse, error) {
+	profiles, err := uc.profileRepo.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.ImportProfileResponse, 0, len(profiles))
+	for _, profile := range profiles {
+		response = append(response, toImportProfileResponse(profile))
+	}
+	return response, nil
+}
+
+func (uc *ImportUseCase) DeleteProfile(ctx context.Context, userID string, profileID string) error {
+	return uc.profileRepo.Delete(ctx, profileID, userID)
+}
+
+// PreviewCSV interpreta o extrato com o perfil e guarda a prévia sem gravar transações
+func (uc *ImportUseCase) PreviewCSV(ctx context.Context, userID string, profileID string, accountID string, fileName string, file io.Reader) (*dto.ImportBatchResponse, error) {
+	profile, err := uc.profileRepo.GetByID(ctx, profileID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		return nil, errors.ErrInvalidInput
+	}
+	account, err := uc.getAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := readImportFile(file)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := parseCSVStatement(profile, data)
+	if err != nil || len(rows) == 0 {
+		return nil, errors.ErrInvalidInput
+	}
+	return uc.createBatch(ctx, userID, account, entity.ImportSourceCSV, profile.ID, fileName, rows)
+}
+
+// PreviewOFX interpreta um extrato OFX/QFX e guarda a prévia. O FITID de cada lançamento vira a
+// ExternalRef da transação, então lançamentos já importados por um extrato sobreposto aparecem
+// como duplicados e não são gravados de novo.
+func (uc *ImportUseCase) PreviewOFX(ctx context.Context, userID string, accountID string, fileName string, file io.Reader) (*dto.ImportBatchResponse, error) {
+	account, err := uc.getAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := readImportFile(file)
+	if err != nil {
+		return nil, err
+	}
+	statement, err := ofx.Parse(data)
+	if err != nil || len(statement.Transactions) == 0 {
+		return nil, errors.ErrInvalidInput
+	}
+
+	rows := make([]entity.ImportRow, 0, len(statement.Transactions))
+	for i, transaction := range statement.Transactions {
+		rows = append(rows, ofxImportRow(account, i+1, transaction))
+	}
+	return uc.createBatch(ctx, userID, account, entity.ImportSourceOFX, "", fileName, rows)
+}
+
+func ofxImportRow(account *entity.Account, line int, transaction ofx.Transaction) entity.ImportRow {
+	row := entity.ImportRow{
+		Line:        line,
+		OccurredAt:  transaction.PostedAt,
+		Description: transaction.Description(),
+		Amount:      transaction.Amount.Abs(),
+		Type:        entity.TransactionTypeIncome,
+		Status:      entity.ImportRowStatusPending,
+	}
+	if transaction.FITID != "" {
+		row.ExternalRef = "ofx:" + account.ID + ":" + transaction.FITID
+	}
+	if transaction.Amount.IsNegative() {
+		row.Type = entity.TransactionTypeExpense
+	}
+
+	switch {
+	case transaction.Amount.IsZero():
+		row.Status = entity.ImportRowStatusInvalid
+		row.Error = "zero amount"
+	case transaction.Currency != "" && transaction.Currency != account.Currency:
+		row.Status = entity.ImportRowStatusInvalid
+		row.Error = "statement currency " + transaction.Currency.String() + " differs from account currency " + account.Currency.String()
+	}
+	return row
+}
+
+func (uc *ImportUseCase) createBatch(ctx context.Context, userID string, account *entity.Account, source string, profileID string, fileName string, rows []entity.ImportRow) (*dto.ImportBatchResponse, error) {
+	if err := uc.markDuplicates(ctx, userID, account, rows); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(importPreviewTTL)
+	batch := &entity.ImportBatch{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		AccountID: account.ID,
+		ProfileID: profileID,
+		Source:    source,
+		FileName:  fileName,
+		Currency:  account.Currency,
+		Status:    entity.ImportBatchStatusPreview,
+		Rows:      rows,
+		CreatedAt: now,
+		UpdatedAt: now,
+		ExpiresAt: &expiresAt,
+	}
+	if err := uc.batchRepo.Create(ctx, batch); err != nil {
+		return nil, err
+	}
+	return toImportBatchResponse(batch), nil
+}
+
+// markDuplicates marca linhas cuja ExternalRef já foi gravada ou se repete no próprio arquivo como
+// duplicadas, e linhas parecidas com transações existentes da conta como suspeitas
+func (uc *ImportUseCase) markDuplicates(ctx context.Context, userID string, account *entity.Account, rows []entity.ImportRow) error {
+	seen := map[string]bool{}
+	for i := range rows {
+		row := &rows[i]
+		if row.Status != entity.ImportRowStatusPending {
+			continue
+		}
+		if row.ExternalRef != "" {
+			if seen[row.ExternalRef] {
+				row.Status = entity.ImportRowStatusDuplicate
+				continue
+			}
+			seen[row.ExternalRef] = true
+
+			existing, err := uc.transactionRepo.GetByExternalRef(ctx, userID, row.ExternalRef)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				row.Status = entity.ImportRowStatusDuplicate
+				row.TransactionID = existing.ID
+				continue
+			}
+		}
+
+		suspected, err := uc.transactionUseCase.FindSuspectedDuplicate(ctx, userID, &entity.Transaction{
+			AccountID:   account.ID,
+			Type:        row.Type,
+			Amount:      row.Amount,
+			Description: row.Description,
+			OccurredAt:  row.OccurredAt,
+		})
+		if err != nil {
+			return err
+		}
+		if suspected != nil {
+			row.Status = entity.ImportRowStatusSuspected
+			row.TransactionID = suspected.ID
+		}
+	}
+	return nil
+}
+
+func (uc *ImportUseCase) getAccount(ctx context.Context, userID string, accountID string) (*entity.Account, error) {
+	account, err := uc.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, errors.ErrInvalidInput
+	}
+	if account.IsClosed() {
+		return nil, errors.ErrAccountClosed
+	}
+	return account, nil
+}
+
+func readImportFile(file io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(file, MaxImportFileSizeBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > MaxImportFileSizeBytes {
+		return nil, errors.ErrPayloadTooLarge
+	}
+	return data, nil
+}
+
+func (uc *ImportUseCase) GetImport(ctx context.Context, userID string, batchID string) (*dto.ImportBatchResponse, error) {
+	batch, err := uc.getBatch(ctx, userID, batchID)
+	if err != nil {
+		return nil, err
+	}
+	return toImportBatchResponse(batch), nil
+}
+
+// CommitImport grava as linhas pendentes como transações na conta do lote. Linhas fora de
+// request.Lines são marcadas como ignoradas; linhas que falharem continuam pendentes e podem ser
+// confirmadas de novo sem duplicar as que já foram gravadas. Suspeitas escolhidas em request.Lines
+// são gravadas marcadas como possíveis duplicatas para revisão em GET /transactions/duplicates.
+func (uc *ImportUseCase) CommitImport(ctx context.Context, userID string, batchID string, request dto.CommitImportRequest) (*dto.ImportBatchResponse, error) {
+	batch, err := uc.getBatch(ctx, userID, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make(map[int]bool, len(request.Lines))
+	for _, line := range request.Lines {
+		selected[line] = true
+	}
+	categoryIDs := map[entity.TransactionType]string{
+		entity.TransactionTypeExpense: request.ExpenseCategoryID,
+		entity.TransactionTypeIncome:  request.IncomeCategoryID,
+	}
+	// Suspeitas de duplicidade só são gravadas quando escolhidas explicitamente em request.Lines
+	isSelected := func(row entity.ImportRow) bool {
+		switch row.Status {
+		case entity.ImportRowStatusPending:
+			return len(selected) == 0 || selected[row.Line]
+		case entity.ImportRowStatusSuspected:
+			return selected[row.Line]
+		default:
+			return false
+		}
+	}
+
+	// Valida as categorias padrão antes de gravar qualquer linha; as regras de categorização podem refinar cada linha
+	checked := map[entity.TransactionType]bool{}
+	for _, row := range batch.Rows {
+		if !isSelected(row) || checked[row.Type] {
+			continue
+		}
+		if err := uc.checkImportCategory(ctx, userID, categoryIDs[row.Type], row.Type); err != nil {
+			return nil, err
+		}
+		checked[row.Type] = true
+	}
+
+	drafts, err := uc.categorizeRows(ctx, userID, batch, isSelected, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range batch.Rows {
+		row := &batch.Rows[i]
+		if !isSelected(*row) {
+			if row.Status == entity.ImportRowStatusPending || (row.Status == entity.ImportRowStatusSuspected && len(selected) > 0) {
+				row.Status = entity.ImportRowStatusSkipped
+			}
+			continue
+		}
+
+		draft := drafts[row.Line]
+		transaction, err := uc.transactionUseCase.RecordTransaction(ctx, userID, dto.CreateTransactionRequest{
+			AccountID:   batch.AccountID,
+			CategoryID:  draft.CategoryID,
+			Amount:      row.Amount,
+			Currency:    batch.Currency.String(),
+			Description: draft.Description,
+			OccurredAt:  row.OccurredAt,
+			Tags:        draft.Tags,
+			ExternalRef: batch.RowExternalRef(*row),
+			SkipRules:   true,
+		})
+		if err != nil {
+			row.Error = err.Error()
+			continue
+		}
+		row.Status = entity.ImportRowStatusImported
+		row.TransactionID = transaction.ID
+		row.Error = ""
+	}
+
+	now := time.Now().UTC()
+	batch.Status = entity.ImportBatchStatusCommitted
+	if batch.CommittedAt == nil {
+		batch.CommittedAt = &now
+	}
+	batch.ExpiresAt = nil
+	batch.UpdatedAt = now
+	if err := uc.batchRepo.Update(ctx, batch); err != nil {
+		return nil, err
+	}
+	return toImportBatchResponse(batch), nil
+}
+
+// categorizeRows aplica as regras de categorização às linhas selecionadas. A categoria da regra só é usada se
+// tiver o mesmo tipo da linha; caso contrário a linha fica com a categoria padrão do lote para o seu tipo.
+func (uc *ImportUseCase) categorizeRows(ctx context.Context, userID string, batch *entity.ImportBatch, isSelected func(entity.ImportRow) bool, categoryIDs map[entity.TransactionType]string) (map[int]*entity.Transaction, error) {
+	drafts := map[int]*entity.Transaction{}
+	var pending []*entity.Transaction
+	for _, row := range batch.Rows {
+		if !isSelected(row) {
+			continue
+		}
+		draft := &entity.Transaction{
+			AccountID:   batch.AccountID,
+			Type:        row.Type,
+			Amount:      row.Amount,
+			Currency:    batch.Currency,
+			Description: row.Description,
+			OccurredAt:  row.OccurredAt,
+		}
+		drafts[row.Line] = draft
+		pending = append(pending, draft)
+	}
+	if err := uc.transactionUseCase.Categorize(ctx, userID, pending...); err != nil {
+		return nil, err
+	}
+
+	categoryTypes := map[string]entity.TransactionType{}
+	for _, draft := range drafts {
+		if draft.CategoryID == "" {
+			draft.CategoryID = categoryIDs[draft.Type]
+			continue
+		}
+		categoryType, known := categoryTypes[draft.CategoryID]
+		if !known {
+			category, err := uc.categoryRepo.GetByID(ctx, draft.CategoryID, userID)
+			if err != nil {
+				return nil, err
+			}
+			if category != nil {
+				categoryType = entity.TransactionTypeFromCategory(category.Type)
+			}
+			categoryTypes[draft.CategoryID] = categoryType
+		}
+		if categoryType != draft.Type {
+			draft.CategoryID = categoryIDs[draft.Type]
+		}
+	}
+	return drafts, nil
+}
+
+// checkImportCategory garante que a categoria existe e tem o mesmo tipo das linhas que vai receber
+func (uc *ImportUseCase) checkImportCategory(ctx context.Context, userID string, categoryID string, transactionType entity.TransactionType) error {
+	if categoryID == "" {
+		return errors.ErrInvalidInput
+	}
+	category, err := uc.categoryRepo.GetByID(ctx, categoryID, userID)
+	if err != nil {
+		return err
+	}
+	if category == nil || entity.TransactionTypeFromCategory(category.Type) != transactionType {
+		return errors.ErrInvalidInput
+	}
+	return nil
+}
+
+func (uc *ImportUseCase) getBatch(ctx context.Context, userID string, batchID string) (*entity.ImportBatch, error) {
+	batch, err := uc.batchRepo.GetByID(ctx, batchID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, errors.ErrNotFound
+	}
+	return batch, nil
+}
+
+func toImportProfileResponse(profile *entity.ImportProfile) *dto.ImportProfileResponse {
+	return &dto.ImportProfileResponse{
+		ID:                profile.ID,
+		Name:              profile.Name,
+		Delimiter:         profile.Delimiter,
+		Encoding:          string(profile.Encoding),
+		SkipRows:          profile.SkipRows,
+		DateColumn:        profile.DateColumn,
+		DescriptionColumn: profile.DescriptionColumn,
+		AmountColumn:      profile.AmountColumn,
+		DebitColumn:       profile.DebitColumn,
+		CreditColumn:      profile.CreditColumn,
+		DateFormat:        profile.DateFormat,
+		DecimalSeparator:  profile.DecimalSeparator,
+		SignConvention:    string(profile.SignConvention),
+	}
+}
+
+func toImportBatchResponse(batch *entity.ImportBatch) *dto.ImportBatchResponse {
+	response := &dto.ImportBatchResponse{
+		ID:          batch.ID,
+		AccountID:   batch.AccountID,
+		ProfileID:   batch.ProfileID,
+		Source:      batch.Source,
+		FileName:    batch.FileName,
+		Currency:    batch.Currency.String(),
+		Status:      string(batch.Status),
+		TotalRows:   len(batch.Rows),
+		Rows:        make([]*dto.ImportRowResponse, 0, len(batch.Rows)),
+		CreatedAt:   batch.CreatedAt,
+		CommittedAt: batch.CommittedAt,
+		ExpiresAt:   batch.ExpiresAt,
+	}
+	for _, row := range batch.Rows {
+		switch row.Status {
+		case entity.ImportRowStatusInvalid:
+			response.InvalidRows++
+		case entity.ImportRowStatusImported:
+			response.ImportedRows++
+		case entity.ImportRowStatusDuplicate, entity.ImportRowStatusSuspected:
+			response.DuplicateRows++
+		}
+		response.Rows = append(response.Rows, &dto.ImportRowResponse{
+			Line:          row.Line,
+			OccurredAt:    row.OccurredAt,
+			Description:   row.Description,
+			Amount:        row.Amount,
+			Type:          string(row.Type),
+			Status:        string(row.Status),
+			Error:         row.Error,
+			TransactionID: row.TransactionID,
+			ExternalRef:   row.ExternalRef,
+		})
+	}
+	return response
+}
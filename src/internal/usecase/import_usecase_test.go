@@ -0,0 +1,308 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+func newImportTestUseCase() (*ImportUseCase, *transactionRepositoryStub, *accountRepositoryStub) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["acc"] = &entity.Account{ID: "acc", UserID: "user", Currency: entity.CurrencyBRL}
+	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
+		"market": {ID: "market", Type: entity.CategoryTypeExpense},
+		"salary": {ID: "salary", Type: entity.CategoryTypeIncome},
+	}}
+	transactions := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), newOutboxRepositoryStub(), nil, nil, "queue", nil)
+	uc := NewImportUseCase(
+		&importProfileRepositoryStub{storage: map[string]*entity.ImportProfile{}},
+		&importBatchRepositoryStub{storage: map[string]*entity.ImportBatch{}},
+		accountRepo,
+		categoryRepo,
+		txRepo,
+		transactions,
+	)
+	return uc, txRepo, accountRepo
+}
+
+func intPointer(value int) *int {
+	return &value
+}
+
+// TestParseCSVStatementBancoBrasileiro garante Latin-1, ponto e vírgula, data DD/MM/YYYY e vírgula decimal
+func TestParseCSVStatementBancoBrasileiro(t *testing.T) {
+	content := "Extrato conta corrente\nData;Histórico;Valor\n" +
+		"05/01/2024;Padaria São João;-1.234,56\n" +
+		"06/01/2024;Salário;5.000,00\n" +
+		"\n" +
+		"31/02/2024;Data inválida;10,00\n" +
+		"07/01/2024;Estorno;(12,30)\n"
+	data, err := charmap.ISO8859_1.NewEncoder().Bytes([]byte(content))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	profile := &entity.ImportProfile{
+		Delimiter:         ";",
+		Encoding:          entity.ImportEncodingLatin1,
+		SkipRows:          2,
+		DateColumn:        0,
+		DescriptionColumn: 1,
+		AmountColumn:      intPointer(2),
+		DateFormat:        "DD/MM/YYYY",
+		DecimalSeparator:  ",",
+		SignConvention:    entity.ImportSignNegativeExpense,
+	}
+	if !profile.IsValid() {
+		t.Fatalf("perfil deveria ser válido")
+	}
+
+	rows, err := parseCSVStatement(profile, data)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("esperava 4 linhas, obteve %d", len(rows))
+	}
+	if rows[0].Description != "Padaria São João" || rows[0].Type != entity.TransactionTypeExpense || rows[0].Amount.Cmp(entity.MustParseMoney("1234.56")) != 0 {
+		t.Fatalf("primeira linha inesperada: %+v", rows[0])
+	}
+	if !rows[0].OccurredAt.Equal(time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)) || rows[0].Line != 3 {
+		t.Fatalf("data ou linha inesperada: %+v", rows[0])
+	}
+	if rows[1].Type != entity.TransactionTypeIncome || rows[1].Amount.Cmp(entity.MoneyFromInt(5000)) != 0 {
+		t.Fatalf("segunda linha inesperada: %+v", rows[1])
+	}
+	if rows[2].Status != entity.ImportRowStatusInvalid || rows[2].Error == "" {
+		t.Fatalf("data inexistente deveria invalidar a linha: %+v", rows[2])
+	}
+	if rows[3].Type != entity.TransactionTypeExpense || rows[3].Amount.Cmp(entity.MustParseMoney("12.30")) != 0 {
+		t.Fatalf("valor entre parênteses deveria ser despesa: %+v", rows[3])
+	}
+}
+
+// TestParseStatementAmountSinais garante que parênteses e sinal no fim não invertem um valor que já tem sinal
+func TestParseStatementAmountSinais(t *testing.T) {
+	cases := []struct {
+		value            string
+		decimalSeparator string
+		want             string
+	}{
+		{"R$ -1.234,56", ",", "-1234.56"},
+		{"1.234,56", ",", "1234.56"},
+		{"(12.30)", ".", "-12.30"},
+		{"(-12.30)", ".", "-12.30"},
+		{"(12,30)", ",", "-12.30"},
+		{"45,00-", ",", "-45.00"},
+		{"-45,00-", ",", "-45.00"},
+		{"+45,00", ",", "45.00"},
+		{"(+45.00)", ".", "45.00"},
+		{"US$ 1,000.00", ".", "1000.00"},
+	}
+	for _, tc := range cases {
+		amount, err := parseStatementAmount(tc.value, tc.decimalSeparator)
+		if err != nil {
+			t.Fatalf("%q: não esperava erro: %v", tc.value, err)
+		}
+		if amount.Cmp(entity.MustParseMoney(tc.want)) != 0 {
+			t.Fatalf("%q: esperava %s, obteve %s", tc.value, tc.want, amount)
+		}
+	}
+	if _, err := parseStatementAmount("--", ","); err == nil {
+		t.Fatalf("valor sem dígitos deveria ser recusado")
+	}
+}
+
+// TestParseCSVStatementColunasDebitoCredito garante a convenção com colunas separadas
+func TestParseCSVStatementColunasDebitoCredito(t *testing.T) {
+	content := "date,description,debit,credit\n2024-03-01,Card payment,45.90,\n2024-03-02,Refund,,10.00\n"
+	profile := &entity.ImportProfile{
+		Delimiter:         ",",
+		Encoding:          entity.ImportEncodingUTF8,
+		SkipRows:          1,
+		DescriptionColumn: 1,
+		DebitColumn:       intPointer(2),
+		CreditColumn:      intPointer(3),
+		DateFormat:        "YYYY-MM-DD",
+		DecimalSeparator:  ".",
+		SignConvention:    entity.ImportSignDebitCreditColumns,
+	}
+
+	rows, err := parseCSVStatement(profile, []byte(content))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(rows) != 2 || rows[0].Type != entity.TransactionTypeExpense || rows[1].Type != entity.TransactionTypeIncome {
+		t.Fatalf("linhas inesperadas: %+v", rows)
+	}
+	if rows[0].Amount.Cmp(entity.MustParseMoney("45.90")) != 0 || rows[1].Amount.Cmp(entity.MoneyFromInt(10)) != 0 {
+		t.Fatalf("valores inesperados: %s %s", rows[0].Amount, rows[1].Amount)
+	}
+}
+
+// TestImportCommitSemDuplicar garante prévia sem efeito no saldo, seleção de linhas e confirmação repetida sem duplicar
+func TestImportCommitSemDuplicar(t *testing.T) {
+	uc, txRepo, accountRepo := newImportTestUseCase()
+	ctx := context.Background()
+
+	profile, err := uc.CreateProfile(ctx, "user", dto.CreateImportProfileRequest{
+		Name:              "Banco",
+		Delimiter:         ";",
+		SkipRows:          1,
+		DateColumn:        0,
+		DescriptionColumn: 1,
+		AmountColumn:      intPointer(2),
+		DateFormat:        "DD/MM/YYYY",
+		DecimalSeparator:  ",",
+		SignConvention:    string(entity.ImportSignNegativeExpense),
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	content := "Data;Descrição;Valor\n05/01/2024;Mercado;-100,00\n06/01/2024;Salário;3000,00\n07/01/2024;Cinema;-40,00\n"
+	preview, err := uc.PreviewCSV(ctx, "user", profile.ID, "acc", "extrato.csv", bytes.NewReader([]byte(content)))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if preview.TotalRows != 3 || preview.Status != string(entity.ImportBatchStatusPreview) || len(txRepo.created) != 0 {
+		t.Fatalf("prévia inesperada: %+v", preview)
+	}
+
+	if _, err := uc.CommitImport(ctx, "user", preview.ID, dto.CommitImportRequest{IncomeCategoryID: "salary"}); err != errors.ErrInvalidInput {
+		t.Fatalf("esperava ErrInvalidInput sem categoria de despesa, obteve %v", err)
+	}
+
+	request := dto.CommitImportRequest{ExpenseCategoryID: "market", IncomeCategoryID: "salary", Lines: []int{2, 3}}
+	committed, err := uc.CommitImport(ctx, "user", preview.ID, request)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if committed.ImportedRows != 2 || committed.Rows[2].Status != string(entity.ImportRowStatusSkipped) {
+		t.Fatalf("resultado inesperado: %+v", committed)
+	}
+	if accountRepo.storage["acc"].Balance.Cmp(entity.MoneyFromInt(2900)) != 0 {
+		t.Fatalf("saldo inesperado: %s", accountRepo.storage["acc"].Balance)
+	}
+
+	if _, err := uc.CommitImport(ctx, "user", preview.ID, request); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(txRepo.created) != 2 {
+		t.Fatalf("confirmar de novo não deveria duplicar transações, total %d", len(txRepo.created))
+	}
+}
+
+const ofxSGMLStatement = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+ENCODING:USASCII
+CHARSET:1252
+
+<OFX>
+<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0<SEVERITY>INFO</STATUS><DTSERVER>20240201120000[-3:BRT]<LANGUAGE>POR</SONRS></SIGNONMSGSRSV1>
+<BANKMSGSRSV1><STMTTRNRS><TRNUID>1<STMTRS>
+<CURDEF>BRL
+<BANKACCTFROM><BANKID>0341<ACCTID>12345-6<ACCTTYPE>CHECKING</BANKACCTFROM>
+<BANKTRANLIST><DTSTART>20240101<DTEND>20240131
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20240105100000[-3:BRT]<TRNAMT>-150.00<FITID>A1<MEMO>SUPERMERCADO P&amp;A</STMTTRN>
+<STMTTRN><TRNTYPE>CREDIT<DTPOSTED>20240110<TRNAMT>2500,00<FITID>A2<NAME>SALARIO</STMTTRN>
+</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+const ofxXMLStatement = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="211" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+  <BANKMSGSRSV1><STMTTRNRS><STMTRS>
+    <CURDEF>BRL</CURDEF>
+    <BANKTRANLIST>
+      <STMTTRN><TRNTYPE>CREDIT</TRNTYPE><DTPOSTED>20240110</DTPOSTED><TRNAMT>2500.00</TRNAMT><FITID>A2</FITID><NAME>SALARIO</NAME></STMTTRN>
+      <STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20240203</DTPOSTED><TRNAMT>-80.50</TRNAMT><FITID>A3</FITID><NAME>FARMÁCIA</NAME></STMTTRN>
+    </BANKTRANLIST>
+  </STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+// TestImportOFXExtratosSobrepostos garante OFX 1.x e 2.x e que lançamentos repetidos entre extratos não são gravados de novo
+func TestImportOFXExtratosSobrepostos(t *testing.T) {
+	uc, txRepo, accountRepo := newImportTestUseCase()
+	ctx := context.Background()
+	request := dto.CommitImportRequest{ExpenseCategoryID: "market", IncomeCategoryID: "salary"}
+
+	january, err := uc.PreviewOFX(ctx, "user", "acc", "janeiro.ofx", bytes.NewReader([]byte(ofxSGMLStatement)))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if january.TotalRows != 2 || january.Rows[0].Description != "SUPERMERCADO P&A" || january.Rows[1].Type != string(entity.TransactionTypeIncome) {
+		t.Fatalf("prévia inesperada: %+v", january.Rows)
+	}
+	if !january.Rows[0].OccurredAt.Equal(time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("data inesperada: %v", january.Rows[0].OccurredAt)
+	}
+	if _, err := uc.CommitImport(ctx, "user", january.ID, request); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	overlapping, err := uc.PreviewOFX(ctx, "user", "acc", "fevereiro.ofx", bytes.NewReader([]byte(ofxXMLStatement)))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if overlapping.DuplicateRows != 1 || overlapping.Rows[0].Status != string(entity.ImportRowStatusDuplicate) {
+		t.Fatalf("lançamento A2 deveria aparecer como duplicado: %+v", overlapping.Rows)
+	}
+	if _, err := uc.CommitImport(ctx, "user", overlapping.ID, request); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	if len(txRepo.created) != 3 {
+		t.Fatalf("esperava 3 transações sem duplicar o salário, obteve %d", len(txRepo.created))
+	}
+	if txRepo.created[2].ExternalRef != "ofx:acc:A3" || txRepo.created[2].Description != "FARMÁCIA" {
+		t.Fatalf("transação inesperada: %+v", txRepo.created[2])
+	}
+	if accountRepo.storage["acc"].Balance.Cmp(entity.MustParseMoney("2269.50")) != 0 {
+		t.Fatalf("saldo inesperado: %s", accountRepo.storage["acc"].Balance)
+	}
+}
+
+// TestImportOFXDuplicataSuspeita garante que lançamento já digitado à mão aparece como suspeito e só é gravado se selecionado
+func TestImportOFXDuplicataSuspeita(t *testing.T) {
+	uc, txRepo, _ := newImportTestUseCase()
+	ctx := context.Background()
+
+	if _, err := uc.transactionUseCase.RecordTransaction(ctx, "user", dto.CreateTransactionRequest{
+		AccountID:   "acc",
+		CategoryID:  "market",
+		Amount:      entity.MustParseMoney("80.50"),
+		Currency:    "BRL",
+		Description: "Farmácia",
+		OccurredAt:  time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC),
+	}); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	preview, err := uc.PreviewOFX(ctx, "user", "acc", "fevereiro.ofx", bytes.NewReader([]byte(ofxXMLStatement)))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	suspected := preview.Rows[1]
+	if suspected.Status != string(entity.ImportRowStatusSuspected) || suspected.TransactionID != txRepo.created[0].ID {
+		t.Fatalf("esperava linha suspeita apontando para a transação manual: %+v", suspected)
+	}
+
+	committed, err := uc.CommitImport(ctx, "user", preview.ID, dto.CommitImportRequest{ExpenseCategoryID: "market", IncomeCategoryID: "salary"})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if committed.ImportedRows != 1 || committed.Rows[1].Status != string(entity.ImportRowStatusSuspected) || len(txRepo.created) != 2 {
+		t.Fatalf("suspeita não selecionada não deveria ser gravada: %+v", committed)
+	}
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+func newInstallmentFixture(t *testing.T) (*TransactionUseCase, *accountRepositoryStub, *transactionRepositoryStub, *outboxRepositoryStub) {
+	t.Helper()
+	f := newTransactionFixture().withOutbox().withAccounts(
+		&entity.Account{ID: "card", UserID: "user", Type: entity.AccountTypeCredit, Currency: entity.CurrencyBRL},
+		&entity.Account{ID: "checking", UserID: "user", Type: entity.AccountTypeChecking, Currency: entity.CurrencyBRL},
+	).withCategories(
+		&entity.Category{ID: "cat", Type: entity.CategoryTypeExpense},
+	)
+	return f.transactions(), f.accountRepo, f.txRepo, f.outbox
+}
+
+// TestRecordTransactionInstallments garante uma parcela por mês, com o resto do arredondamento na primeira
+func TestRecordTransactionInstallments(t *testing.T) {
+	uc, accountRepo, txRepo, outbox := newInstallmentFixture(t)
+
+	response, err := uc.RecordTransaction(context.Background(), "user", dto.CreateTransactionRequest{
+		AccountID:    "card",
+		CategoryID:   "cat",
+		Amount:       entity.MoneyFromInt(100),
+		Currency:     "BRL",
+		Description:  "Notebook",
+		OccurredAt:   time.Date(2024, 1, 31, 15, 0, 0, 0, time.UTC),
+		ExternalRef:  "purchase-1",
+		Installments: 3,
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if response.Installment == nil || response.Installment.Number != 1 || response.Installment.Count != 3 {
+		t.Fatalf("resposta deveria trazer a primeira parcela: %+v", response.Installment)
+	}
+	if len(txRepo.created) != 3 || len(outbox.events) != 3 {
+		t.Fatalf("esperava 3 parcelas e 3 eventos, obtive %d e %d", len(txRepo.created), len(outbox.events))
+	}
+
+	expected := []struct {
+		amount     string
+		occurredAt time.Time
+		label      string
+		ref        string
+	}{
+		{"33.34", time.Date(2024, 1, 31, 15, 0, 0, 0, time.UTC), "1/3", "purchase-1"},
+		{"33.33", time.Date(2024, 2, 29, 15, 0, 0, 0, time.UTC), "2/3", "purchase-1#2"},
+		{"33.33", time.Date(2024, 3, 31, 15, 0, 0, 0, time.UTC), "3/3", "purchase-1#3"},
+	}
+	for i, installment := range txRepo.created {
+		want := expected[i]
+		if installment.Amount.String() != want.amount || !installment.OccurredAt.Equal(want.occurredAt) {
+			t.Fatalf("parcela %d inesperada: %s em %s", i+1, installment.Amount, installment.OccurredAt)
+		}
+		if installment.Metadata[entity.InstallmentMetadataKey] != want.label || installment.ExternalRef != want.ref {
+			t.Fatalf("parcela %d com metadados inesperados: %v %q", i+1, installment.Metadata, installment.ExternalRef)
+		}
+		if installment.Installment.GroupID != response.Installment.GroupID {
+			t.Fatalf("parcelas deveriam compartilhar o grupo")
+		}
+	}
+	if len(accountRepo.adjustments) != 1 || accountRepo.adjustments[0].Cmp(entity.MoneyFromInt(-100)) != 0 {
+		t.Fatalf("o limite deveria ser consumido pelo total da compra: %v", accountRepo.adjustments)
+	}
+}
+
+// TestRecordTransactionInstallmentsRequiresCreditAccount garante que só cartões aceitam parcelamento
+func TestRecordTransactionInstallmentsRequiresCreditAccount(t *testing.T) {
+	uc, _, txRepo, _ := newInstallmentFixture(t)
+
+	_, err := uc.RecordTransaction(context.Background(), "user", dto.CreateTransactionRequest{
+		AccountID:    "checking",
+		CategoryID:   "cat",
+		Amount:       entity.MoneyFromInt(100),
+		Currency:     "BRL",
+		OccurredAt:   time.Now().UTC(),
+		Installments: 2,
+	})
+	if !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("esperava ErrInvalidInput, obtive %v", err)
+	}
+	if len(txRepo.created) != 0 {
+		t.Fatalf("nenhuma transação deveria ser gravada")
+	}
+}
+
+// TestRecordTransactionInstallmentsValorPequeno garante que um total menor que uma unidade mínima da moeda por
+// parcela é recusado e que valores pequenos aceitos não geram parcelas zeradas ou negativas
+func TestRecordTransactionInstallmentsValorPequeno(t *testing.T) {
+	uc, _, txRepo, _ := newInstallmentFixture(t)
+	request := dto.CreateTransactionRequest{
+		AccountID:    "card",
+		CategoryID:   "cat",
+		Amount:       entity.MustParseMoney("0.02"),
+		Currency:     "BRL",
+		OccurredAt:   time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+		Installments: 3,
+	}
+	if _, err := uc.RecordTransaction(context.Background(), "user", request); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("esperava ErrInvalidInput, obtive %v", err)
+	}
+	if len(txRepo.created) != 0 {
+		t.Fatalf("nenhuma transação deveria ser gravada")
+	}
+
+	request.Amount = entity.MustParseMoney("1.20")
+	request.Installments = 48
+	if _, err := uc.RecordTransaction(context.Background(), "user", request); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(txRepo.created) != 48 || txRepo.created[0].Amount != entity.MustParseMoney("0.26") {
+		t.Fatalf("parcelas inesperadas: %d gravadas", len(txRepo.created))
+	}
+	for _, installment := range txRepo.created {
+		if !installment.Amount.IsPositive() {
+			t.Fatalf("parcela %d com valor %s", installment.Installment.Number, installment.Amount)
+		}
+	}
+}
+
+// TestCancelRemainingInstallments garante que só as parcelas futuras são anuladas e estornadas
+func TestCancelRemainingInstallments(t *testing.T) {
+	uc, accountRepo, txRepo, outbox := newInstallmentFixture(t)
+	ctx := context.Background()
+	// Compra no dia 1 de um ano atrás: 13 parcelas já lançadas e 11 futuras
+	now := time.Now().UTC()
+	purchase := time.Date(now.Year()-1, now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	response, err := uc.RecordTransaction(ctx, "user", dto.CreateTransactionRequest{
+		AccountID:    "card",
+		CategoryID:   "cat",
+		Amount:       entity.MoneyFromInt(240),
+		Currency:     "BRL",
+		OccurredAt:   purchase,
+		Installments: 24,
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	cancelled, err := uc.CancelRemainingInstallments(ctx, "user", response.ID, "devolução")
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(cancelled.CancelledTransactionIDs) != 11 || cancelled.Refunded.Cmp(entity.MoneyFromInt(110)) != 0 {
+		t.Fatalf("cancelamento inesperado: %d parcelas, %s", len(cancelled.CancelledTransactionIDs), cancelled.Refunded)
+	}
+	if balance := accountRepo.storage["card"].Balance; balance.Cmp(entity.MoneyFromInt(-130)) != 0 {
+		t.Fatalf("saldo inesperado após o cancelamento: %s", balance)
+	}
+	for _, installment := range txRepo.created {
+		voided := installment.Status == entity.TransactionStatusVoided
+		if voided != (installment.Installment.Number > 13) {
+			t.Fatalf("parcela %d com situação inesperada: %s", installment.Installment.Number, installment.Status)
+		}
+	}
+	if len(outbox.events) != 24+11 {
+		t.Fatalf("esperava um evento compensatório por parcela anulada, obtive %d eventos", len(outbox.events))
+	}
+
+	if _, err := uc.CancelRemainingInstallments(ctx, "user", response.ID, ""); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("esperava ErrConflict sem parcelas restantes, obtive %v", err)
+	}
+}
@@ -0,0 +1,595 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+const priceFileDateLayout = "2006-01-02"
+
+// InvestmentUseCase cuida dos ativos, do histórico de preços e das operações das contas de investimento. Cada
+// operação grava no caixa da conta uma transação comum: compra como saída, venda como entrada e provento como
+// receita, de modo que saldo, histórico de saldo e relatórios continuam vindo das transações
+type InvestmentUseCase struct {
+	accountRepo  repository.AccountRepository
+	securityRepo repository.SecurityRepository
+	priceRepo    repository.SecurityPriceRepository
+	tradeRepo    repository.InvestmentTradeRepository
+	categoryRepo repository.CategoryRepository
+	transactions *TransactionUseCase
+	now          func() time.Time
+}
+
+func NewInvestmentUseCase(accountRepo repository.AccountRepository, securityRepo repository.SecurityRepository, priceRepo repository.SecurityPriceRepository, tradeRepo repository.InvestmentTradeRepository, categoryRepo repository.CategoryRepository, transactions *TransactionUseCase) *InvestmentUseCase {
+	return &InvestmentUseCase{
+		accountRepo:  accountRepo,
+		securityRepo: securityRepo,
+		priceRepo:    priceRepo,
+		tradeRepo:    tradeRepo,
+		categoryRepo: categoryRepo,
+		transactions: transactions,
+		now:          func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (uc *InvestmentUseCase) CreateSecurity(ctx context.Context, userID string, request dto.CreateSecurityRequest) (*dto.SecurityResponse, error) {
+	symbol := normalizeSymbol(request.Symbol)
+	if symbol == "" {
+		return nil, errors.ErrInvalidInput
+	}
+	existing, err := uc.securityRepo.GetBySymbol(ctx, userID, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.ErrConflict
+	}
+
+	now := uc.now()
+	security := &entity.Security{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		Symbol:    symbol,
+		Name:      strings.TrimSpace(request.Name),
+		Currency:  entity.Currency(request.Currency),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := uc.securityRepo.Create(ctx, security); err != nil {
+		return nil, err
+	}
+	return toSecurityResponse(security), nil
+}
+
+// UpdateSecurity altera símbolo e nome; a moeda é fixa porque as operações já gravadas dependem dela
+func (uc *InvestmentUseCase) UpdateSecurity(ctx context.Context, userID string, securityID string, request dto.UpdateSecurityRequest) (*dto.SecurityResponse, error) {
+	security, err := uc.getSecurity(ctx, userID, securityID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Symbol != nil {
+		symbol := normalizeSymbol(*request.Symbol)
+		if symbol == "" {
+			return nil, errors.ErrInvalidInput
+		}
+		if symbol != security.Symbol {
+			existing, err := uc.securityRepo.GetBySymbol(ctx, userID, symbol)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil {
+				return nil, errors.ErrConflict
+			}
+		}
+		security.Symbol = symbol
+	}
+	if request.Name != nil {
+		security.Name = strings.TrimSpace(*request.Name)
+	}
+	security.UpdatedAt = uc.now()
+
+	if err := uc.securityRepo.Update(ctx, security); err != nil {
+		return nil, err
+	}
+	return toSecurityResponse(security), nil
+}
+
+// DeleteSecurity remove o ativo e o histórico de preços dele; ativos com operações são recusados com ErrConflict
+func (uc *InvestmentUseCase) DeleteSecurity(ctx context.Context, userID string, securityID string) error {
+	if _, err := uc.getSecurity(ctx, userID, securityID); err != nil {
+		return err
+	}
+	count, err := uc.tradeRepo.CountBySecurity(ctx, userID, securityID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.ErrConflict
+	}
+	if err := uc.priceRepo.DeleteBySecurity(ctx, userID, securityID); err != nil {
+		return err
+	}
+	return uc.securityRepo.Delete(ctx, securityID, userID)
+}
+
+func (uc *InvestmentUseCase) ListSecurities(ctx context.Context, userID string, limit int64, offset int64) ([]*dto.SecurityResponse, error) {
+	securities, err := uc.securityRepo.List(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	response := make([]*dto.SecurityResponse, 0, len(securities))
+	for _, security := range securities {
+		response = append(response, toSecurityResponse(security))
+	}
+	return response, nil
+}
+
+// SetPrice grava o preço de fechamento do ativo no dia, substituindo o que houver
+func (uc *InvestmentUseCase) SetPrice(ctx context.Context, userID string, securityID string, request dto.SetSecurityPriceRequest) (*dto.SecurityPriceResponse, error) {
+	if !request.Price.IsPositive() || request.Date.After(uc.now()) {
+		return nil, errors.ErrInvalidInput
+	}
+	if _, err := uc.getSecurity(ctx, userID, securityID); err != nil {
+		return nil, err
+	}
+	price := newSecurityPrice(userID, securityID, request.Date, request.Price, entity.PriceSourceManual, uc.now())
+	if err := uc.priceRepo.Upsert(ctx, price); err != nil {
+		return nil, err
+	}
+	return toSecurityPriceResponse(price), nil
+}
+
+func (uc *InvestmentUseCase) ListPrices(ctx context.Context, userID string, securityID string, from time.Time, to time.Time) ([]*dto.SecurityPriceResponse, error) {
+	if to.Before(from) {
+		return nil, errors.ErrInvalidInput
+	}
+	if _, err := uc.getSecurity(ctx, userID, securityID); err != nil {
+		return nil, err
+	}
+	prices, err := uc.priceRepo.List(ctx, userID, securityID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	response := make([]*dto.SecurityPriceResponse, 0, len(prices))
+	for _, price := range prices {
+		response = append(response, toSecurityPriceResponse(price))
+	}
+	return response, nil
+}
+
+// ImportPrices lê um CSV com as colunas symbol,date,price (ex.: PETR4,2024-01-31,38.42); a primeira linha pode
+// ser um cabeçalho. Linhas de ativos não cadastrados são ignoradas e devolvidas em UnknownSymbols; qualquer
+// linha malformada recusa o arquivo inteiro com ErrInvalidInput
+func (uc *InvestmentUseCase) ImportPrices(ctx context.Context, userID string, file io.Reader) (*dto.ImportPricesResponse, error) {
+	data, err := readImportFile(file)
+	if err != nil {
+		return nil, err
+	}
+	csvReader := csv.NewReader(bytes.NewReader(data))
+	csvReader.FieldsPerRecord = 3
+	csvReader.TrimLeadingSpace = true
+	csvReader.Comment = '#'
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, errors.ErrInvalidInput
+	}
+
+	now := uc.now()
+	securities := map[string]*entity.Security{}
+	unknown := map[string]bool{}
+	var prices []*entity.SecurityPrice
+	for i, record := range records {
+		if i == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "symbol") {
+			continue
+		}
+		symbol := normalizeSymbol(record[0])
+		date, err := time.Parse(priceFileDateLayout, strings.TrimSpace(record[1]))
+		if err != nil || date.After(now) {
+			return nil, errors.ErrInvalidInput
+		}
+		value, err := entity.ParseMoney(strings.TrimSpace(record[2]))
+		if err != nil || !value.IsPositive() {
+			return nil, errors.ErrInvalidInput
+		}
+
+		security, seen := securities[symbol]
+		if !seen {
+			security, err = uc.securityRepo.GetBySymbol(ctx, userID, symbol)
+			if err != nil {
+				return nil, err
+			}
+			securities[symbol] = security
+		}
+		if security == nil {
+			unknown[symbol] = true
+			continue
+		}
+		prices = append(prices, newSecurityPrice(userID, security.ID, date, value, entity.PriceSourceFile, now))
+	}
+
+	for _, price := range prices {
+		if err := uc.priceRepo.Upsert(ctx, price); err != nil {
+			return nil, err
+		}
+	}
+	response := &dto.ImportPricesResponse{Imported: len(prices)}
+	for symbol := range unknown {
+		response.UnknownSymbols = append(response.UnknownSymbols, symbol)
+	}
+	sort.Strings(response.UnknownSymbols)
+	return response, nil
+}
+
+// RecordTrade grava a operação e o lançamento correspondente no caixa da conta na mesma unidade de trabalho.
+// Vendas acima da quantidade em carteira na data da operação são recusadas com ErrInvalidInput
+func (uc *InvestmentUseCase) RecordTrade(ctx context.Context, userID string, accountID string, request dto.CreateTradeRequest) (*dto.TradeResponse, error) {
+	account, err := uc.getInvestmentAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.IsClosed() {
+		return nil, errors.ErrAccountClosed
+	}
+	security, err := uc.securityRepo.GetByID(ctx, request.SecurityID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if security == nil || security.Currency != account.Currency {
+		return nil, errors.ErrInvalidInput
+	}
+
+	now := uc.now()
+	trade := &entity.InvestmentTrade{
+		ID:         uuid.NewString(),
+		UserID:     userID,
+		AccountID:  account.ID,
+		SecurityID: security.ID,
+		Type:       entity.TradeType(request.Type),
+		Quantity:   request.Quantity,
+		Price:      request.Price,
+		Fees:       request.Fees,
+		OccurredAt: request.OccurredAt,
+		CreatedAt:  now,
+	}
+	if !validTrade(trade) {
+		return nil, errors.ErrInvalidInput
+	}
+
+	transaction := &entity.Transaction{
+		ID:                uuid.NewString(),
+		UserID:            userID,
+		AccountID:         account.ID,
+		Amount:            trade.CashAmount(),
+		Currency:          account.Currency,
+		Description:       strings.TrimSpace(request.Description),
+		OccurredAt:        trade.OccurredAt,
+		Status:            entity.TransactionStatusCompleted,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		Metadata:          map[string]string{},
+		InvestmentTradeID: trade.ID,
+	}
+	if transaction.Description == "" {
+		transaction.Description = tradeDescription(trade, security)
+	}
+	switch trade.Type {
+	case entity.TradeTypeBuy:
+		transaction.Type = entity.TransactionTypeTransferOut
+	case entity.TradeTypeSell:
+		transaction.Type = entity.TransactionTypeTransferIn
+	case entity.TradeTypeDividend:
+		// Provento é receita: entra nos relatórios pela categoria informada
+		category, err := uc.categoryRepo.GetByID(ctx, request.CategoryID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if category == nil || category.Type != entity.CategoryTypeIncome {
+			return nil, errors.ErrInvalidInput
+		}
+		transaction.CategoryID = category.ID
+		transaction.Type = entity.TransactionTypeIncome
+	}
+	if !transaction.Amount.IsPositive() {
+		return nil, errors.ErrInvalidInput
+	}
+
+	trades, err := uc.tradeRepo.ListByAccount(ctx, userID, account.ID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := entity.BuildHoldings(append(trades, trade), account.CostBasisMethod()); !ok {
+		return nil, errors.ErrInvalidInput
+	}
+
+	trade.TransactionID = transaction.ID
+	err = uc.transactions.recordWith(ctx, []*entity.Transaction{transaction}, func(txCtx context.Context) error {
+		return uc.tradeRepo.Create(txCtx, trade)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toTradeResponse(trade), nil
+}
+
+// DeleteTrade desfaz a operação anulando o lançamento no caixa. É recusada com ErrConflict quando as vendas
+// posteriores ficariam acima da quantidade em carteira ou quando o lançamento já foi conciliado
+func (uc *InvestmentUseCase) DeleteTrade(ctx context.Context, userID string, tradeID string) error {
+	trade, err := uc.tradeRepo.GetByID(ctx, tradeID, userID)
+	if err != nil {
+		return err
+	}
+	if trade == nil {
+		return errors.ErrNotFound
+	}
+	account, err := uc.getInvestmentAccount(ctx, userID, trade.AccountID)
+	if err != nil {
+		return err
+	}
+
+	trades, err := uc.tradeRepo.ListByAccount(ctx, userID, account.ID)
+	if err != nil {
+		return err
+	}
+	remaining := make([]*entity.InvestmentTrade, 0, len(trades))
+	for _, other := range trades {
+		if other.ID != trade.ID {
+			remaining = append(remaining, other)
+		}
+	}
+	if _, ok := entity.BuildHoldings(remaining, account.CostBasisMethod()); !ok {
+		return errors.ErrConflict
+	}
+
+	deleteTrade := func(txCtx context.Context) error {
+		return uc.tradeRepo.Delete(txCtx, trade.ID, userID)
+	}
+	transaction, err := uc.transactions.transactionRepo.GetByID(ctx, trade.TransactionID, userID)
+	if err != nil {
+		return err
+	}
+	if transaction == nil {
+		return deleteTrade(ctx)
+	}
+	return uc.transactions.voidWith(ctx, transaction, "investment trade deleted", deleteTrade)
+}
+
+func (uc *InvestmentUseCase) ListTrades(ctx context.Context, userID string, accountID string) ([]*dto.TradeResponse, error) {
+	if _, err := uc.getInvestmentAccount(ctx, userID, accountID); err != nil {
+		return nil, err
+	}
+	trades, err := uc.tradeRepo.ListByAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	response := make([]*dto.TradeResponse, 0, len(trades))
+	for _, trade := range trades {
+		response = append(response, toTradeResponse(trade))
+	}
+	return response, nil
+}
+
+// GetPortfolio apura as posições da conta ao preço mais recente conhecido: o do histórico de preços ou, se for
+// mais novo, o da última operação com o ativo
+func (uc *InvestmentUseCase) GetPortfolio(ctx context.Context, userID string, accountID string) (*dto.PortfolioResponse, error) {
+	account, err := uc.getInvestmentAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	trades, err := uc.tradeRepo.ListByAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	method := account.CostBasisMethod()
+	holdings, ok := entity.BuildHoldings(trades, method)
+	if !ok {
+		return nil, errors.ErrInternal
+	}
+
+	now := uc.now()
+	response := &dto.PortfolioResponse{
+		AccountID: account.ID,
+		Currency:  account.Currency.String(),
+		CostBasis: string(method),
+		Cash:      account.Balance,
+		Holdings:  make([]dto.HoldingResponse, 0, len(holdings)),
+	}
+	for _, holding := range holdings {
+		security, err := uc.securityRepo.GetByID(ctx, holding.SecurityID, userID)
+		if err != nil {
+			return nil, err
+		}
+		price, priceDate, err := uc.priceAt(ctx, userID, holding, now)
+		if err != nil {
+			return nil, err
+		}
+		marketValue := holding.MarketValue(price)
+		item := dto.HoldingResponse{
+			SecurityID:   holding.SecurityID,
+			Quantity:     holding.Quantity,
+			CostBasis:    holding.CostBasis,
+			Price:        price,
+			PriceDate:    priceDate,
+			MarketValue:  marketValue,
+			RealizedGain: holding.RealizedGain,
+			Dividends:    holding.Dividends,
+		}
+		if security != nil {
+			item.Symbol = security.Symbol
+		}
+		if holding.Quantity.IsPositive() {
+			item.AverageCost = holding.CostBasis.MulRatio(entity.MoneyFromInt(1), holding.Quantity)
+			item.UnrealizedGain = marketValue.Sub(holding.CostBasis)
+		}
+		for _, lot := range holding.Lots {
+			item.Lots = append(item.Lots, dto.LotResponse{TradeID: lot.TradeID, AcquiredAt: lot.AcquiredAt, Quantity: lot.Quantity, Cost: lot.Cost})
+		}
+
+		response.MarketValue = response.MarketValue.Add(marketValue)
+		response.UnrealizedGain = response.UnrealizedGain.Add(item.UnrealizedGain)
+		response.RealizedGain = response.RealizedGain.Add(holding.RealizedGain)
+		response.Dividends = response.Dividends.Add(holding.Dividends)
+		response.Holdings = append(response.Holdings, item)
+	}
+	sort.Slice(response.Holdings, func(i, j int) bool {
+		return response.Holdings[i].Symbol < response.Holdings[j].Symbol
+	})
+	response.TotalValue = response.Cash.Add(response.MarketValue)
+	return response, nil
+}
+
+// MarketValues devolve o valor de mercado das posições da conta em cada data, na moeda da conta, considerando
+// só as operações até aquela data. Contas que não são de investimento valem zero
+func (uc *InvestmentUseCase) MarketValues(ctx context.Context, account *entity.Account, dates []time.Time) ([]entity.Money, error) {
+	values := make([]entity.Money, len(dates))
+	if account.Type != entity.AccountTypeInvestment {
+		return values, nil
+	}
+	trades, err := uc.tradeRepo.ListByAccount(ctx, account.UserID, account.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(trades) == 0 {
+		return values, nil
+	}
+
+	for i, at := range dates {
+		var until []*entity.InvestmentTrade
+		for _, trade := range trades {
+			if !trade.OccurredAt.After(at) {
+				until = append(until, trade)
+			}
+		}
+		holdings, ok := entity.BuildHoldings(until, account.CostBasisMethod())
+		if !ok {
+			return nil, errors.ErrInternal
+		}
+		for _, holding := range holdings {
+			if !holding.Quantity.IsPositive() {
+				continue
+			}
+			price, _, err := uc.priceAt(ctx, account.UserID, holding, at)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = values[i].Add(holding.MarketValue(price))
+		}
+	}
+	return values, nil
+}
+
+// priceAt escolhe entre o preço do histórico até at e o da última operação, ficando com o mais recente
+func (uc *InvestmentUseCase) priceAt(ctx context.Context, userID string, holding *entity.Holding, at time.Time) (entity.Money, time.Time, error) {
+	price, err := uc.priceRepo.FindOnOrBefore(ctx, userID, holding.SecurityID, at)
+	if err != nil {
+		return entity.ZeroMoney, time.Time{}, err
+	}
+	if price == nil || price.Date.Before(entity.StartOfDay(holding.LastPriceAt)) {
+		return holding.LastPrice, holding.LastPriceAt, nil
+	}
+	return price.Price, price.Date, nil
+}
+
+func (uc *InvestmentUseCase) getSecurity(ctx context.Context, userID string, securityID string) (*entity.Security, error) {
+	security, err := uc.securityRepo.GetByID(ctx, securityID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if security == nil {
+		return nil, errors.ErrNotFound
+	}
+	return security, nil
+}
+
+func (uc *InvestmentUseCase) getInvestmentAccount(ctx context.Context, userID string, accountID string) (*entity.Account, error) {
+	account, err := uc.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, errors.ErrNotFound
+	}
+	if account.Type != entity.AccountTypeInvestment {
+		return nil, errors.ErrInvalidInput
+	}
+	return account, nil
+}
+
+// validTrade exige quantidade e preço positivos em compras e vendas e só o valor bruto em proventos
+func validTrade(trade *entity.InvestmentTrade) bool {
+	if !trade.Type.IsValid() || trade.Fees.IsNegative() || !trade.Price.IsPositive() || trade.OccurredAt.IsZero() {
+		return false
+	}
+	if trade.Type == entity.TradeTypeDividend {
+		return trade.Quantity.IsZero()
+	}
+	return trade.Quantity.IsPositive()
+}
+
+func tradeDescription(trade *entity.InvestmentTrade, security *entity.Security) string {
+	switch trade.Type {
+	case entity.TradeTypeBuy:
+		return "Compra de " + trade.Quantity.String() + " " + security.Symbol
+	case entity.TradeTypeSell:
+		return "Venda de " + trade.Quantity.String() + " " + security.Symbol
+	default:
+		return "Proventos de " + security.Symbol
+	}
+}
+
+func normalizeSymbol(symbol string) string {
+	return strings.ToUpper(strings.TrimSpace(symbol))
+}
+
+func newSecurityPrice(userID string, securityID string, date time.Time, price entity.Money, source entity.PriceSource, now time.Time) *entity.SecurityPrice {
+	day := entity.StartOfDay(date)
+	return &entity.SecurityPrice{
+		ID:         entity.SecurityPriceID(securityID, day),
+		UserID:     userID,
+		SecurityID: securityID,
+		Date:       day,
+		Price:      price,
+		Source:     source,
+		UpdatedAt:  now,
+	}
+}
+
+func toSecurityResponse(security *entity.Security) *dto.SecurityResponse {
+	return &dto.SecurityResponse{
+		ID:        security.ID,
+		Symbol:    security.Symbol,
+		Name:      security.Name,
+		Currency:  security.Currency.String(),
+		CreatedAt: security.CreatedAt,
+	}
+}
+
+func toSecurityPriceResponse(price *entity.SecurityPrice) *dto.SecurityPriceResponse {
+	return &dto.SecurityPriceResponse{Date: price.Date, Price: price.Price, Source: string(price.Source)}
+}
+
+func toTradeResponse(trade *entity.InvestmentTrade) *dto.TradeResponse {
+	return &dto.TradeResponse{
+		ID:            trade.ID,
+		AccountID:     trade.AccountID,
+		SecurityID:    trade.SecurityID,
+		Type:          string(trade.Type),
+		Quantity:      trade.Quantity,
+		Price:         trade.Price,
+		Fees:          trade.Fees,
+		CashAmount:    trade.CashAmount(),
+		TransactionID: trade.TransactionID,
+		OccurredAt:    trade.OccurredAt,
+	}
+}
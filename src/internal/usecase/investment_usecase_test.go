@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+type investmentFixture struct {
+	uc           *InvestmentUseCase
+	transactions *TransactionUseCase
+	accountRepo  *accountRepositoryStub
+	txRepo       *transactionRepositoryStub
+	tradeRepo    *investmentTradeRepositoryStub
+	priceRepo    *securityPriceRepositoryStub
+	security     *dto.SecurityResponse
+}
+
+func newInvestmentFixture(t *testing.T, method entity.CostBasisMethod) *investmentFixture {
+	t.Helper()
+	f := newTransactionFixture().withAccounts(
+		&entity.Account{ID: "broker", UserID: "user", Type: entity.AccountTypeInvestment, Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(10000), Investment: &entity.Investment{CostBasis: method}},
+		&entity.Account{ID: "checking", UserID: "user", Type: entity.AccountTypeChecking, Currency: entity.CurrencyBRL},
+	).withCategories(
+		&entity.Category{ID: "dividends", Type: entity.CategoryTypeIncome},
+		&entity.Category{ID: "market", Type: entity.CategoryTypeExpense},
+	)
+	tradeRepo := newInvestmentTradeRepositoryStub()
+	priceRepo := newSecurityPriceRepositoryStub()
+	uc := NewInvestmentUseCase(f.accountRepo, newSecurityRepositoryStub(), priceRepo, tradeRepo, f.categoryRepo, f.transactions())
+
+	security, err := uc.CreateSecurity(context.Background(), "user", dto.CreateSecurityRequest{Symbol: " petr4 ", Name: "Petrobras PN", Currency: "BRL"})
+	if err != nil {
+		t.Fatalf("não esperava erro ao cadastrar o ativo: %v", err)
+	}
+	return &investmentFixture{uc: uc, transactions: f.transactions(), accountRepo: f.accountRepo, txRepo: f.txRepo, tradeRepo: tradeRepo, priceRepo: priceRepo, security: security}
+}
+
+// recordTrades grava duas compras e uma venda de 150 cotas
+func (f *investmentFixture) recordTrades(t *testing.T) []*dto.TradeResponse {
+	t.Helper()
+	var trades []*dto.TradeResponse
+	for _, request := range []dto.CreateTradeRequest{
+		{Type: "buy", Quantity: entity.MoneyFromInt(100), Price: entity.MoneyFromInt(10), Fees: entity.MoneyFromInt(5), OccurredAt: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{Type: "buy", Quantity: entity.MoneyFromInt(100), Price: entity.MoneyFromInt(20), OccurredAt: time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{Type: "sell", Quantity: entity.MoneyFromInt(150), Price: entity.MoneyFromInt(30), Fees: entity.MoneyFromInt(10), OccurredAt: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)},
+	} {
+		request.SecurityID = f.security.ID
+		trade, err := f.uc.RecordTrade(context.Background(), "user", "broker", request)
+		if err != nil {
+			t.Fatalf("não esperava erro ao registrar %s: %v", request.Type, err)
+		}
+		trades = append(trades, trade)
+	}
+	return trades
+}
+
+// TestInvestmentUseCaseTradesAndPortfolio garante o caixa, o custo FIFO, o resultado e o valor de mercado da carteira
+func TestInvestmentUseCaseTradesAndPortfolio(t *testing.T) {
+	f := newInvestmentFixture(t, entity.CostBasisFIFO)
+	ctx := context.Background()
+	if f.security.Symbol != "PETR4" {
+		t.Fatalf("símbolo deveria ser normalizado, obtive %q", f.security.Symbol)
+	}
+	trades := f.recordTrades(t)
+
+	if trades[0].CashAmount.Cmp(entity.MoneyFromInt(1005)) != 0 || trades[2].CashAmount.Cmp(entity.MoneyFromInt(4490)) != 0 {
+		t.Fatalf("valores no caixa inesperados: %s e %s", trades[0].CashAmount, trades[2].CashAmount)
+	}
+	purchase := f.txRepo.storage[trades[0].TransactionID]
+	if purchase == nil || purchase.Type != entity.TransactionTypeTransferOut || purchase.InvestmentTradeID != trades[0].ID {
+		t.Fatalf("compra deveria gerar uma saída vinculada à operação: %+v", purchase)
+	}
+
+	_, err := f.uc.RecordTrade(ctx, "user", "broker", dto.CreateTradeRequest{SecurityID: f.security.ID, Type: "dividend", Price: entity.MoneyFromInt(40), CategoryID: "dividends", OccurredAt: time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatalf("não esperava erro no provento: %v", err)
+	}
+	if _, err := f.uc.SetPrice(ctx, "user", f.security.ID, dto.SetSecurityPriceRequest{Date: time.Date(2024, 4, 1, 18, 0, 0, 0, time.UTC), Price: entity.MoneyFromInt(25)}); err != nil {
+		t.Fatalf("não esperava erro ao gravar o preço: %v", err)
+	}
+
+	portfolio, err := f.uc.GetPortfolio(ctx, "user", "broker")
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	// 10000 - 1005 - 2000 + 4490 + 40
+	if portfolio.Cash.Cmp(entity.MoneyFromInt(11525)) != 0 {
+		t.Fatalf("caixa inesperado: %s", portfolio.Cash)
+	}
+	if len(portfolio.Holdings) != 1 {
+		t.Fatalf("esperava uma posição, obtive %d", len(portfolio.Holdings))
+	}
+	holding := portfolio.Holdings[0]
+	// FIFO: as 150 vendidas custaram o primeiro lote inteiro (1005) mais 50 cotas do segundo (1000)
+	if holding.Quantity.Cmp(entity.MoneyFromInt(50)) != 0 || holding.CostBasis.Cmp(entity.MoneyFromInt(1000)) != 0 {
+		t.Fatalf("posição inesperada: %s cotas a %s", holding.Quantity, holding.CostBasis)
+	}
+	if holding.RealizedGain.Cmp(entity.MoneyFromInt(2485)) != 0 || holding.Dividends.Cmp(entity.MoneyFromInt(40)) != 0 {
+		t.Fatalf("resultado inesperado: %s realizado, %s em proventos", holding.RealizedGain, holding.Dividends)
+	}
+	if holding.Price.Cmp(entity.MoneyFromInt(25)) != 0 || holding.MarketValue.Cmp(entity.MoneyFromInt(1250)) != 0 || holding.UnrealizedGain.Cmp(entity.MoneyFromInt(250)) != 0 {
+		t.Fatalf("valor de mercado inesperado: %s a %s (%s)", holding.MarketValue, holding.Price, holding.UnrealizedGain)
+	}
+	if len(holding.Lots) != 1 || holding.Lots[0].TradeID != trades[1].ID {
+		t.Fatalf("deveria restar parte do segundo lote: %+v", holding.Lots)
+	}
+	if portfolio.TotalValue.Cmp(entity.MoneyFromInt(12775)) != 0 {
+		t.Fatalf("valor total inesperado: %s", portfolio.TotalValue)
+	}
+
+	if _, err := f.uc.RecordTrade(ctx, "user", "broker", dto.CreateTradeRequest{SecurityID: f.security.ID, Type: "sell", Quantity: entity.MoneyFromInt(60), Price: entity.MoneyFromInt(25), OccurredAt: time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)}); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("venda acima da posição deveria ser recusada: %v", err)
+	}
+	if _, err := f.uc.RecordTrade(ctx, "user", "checking", dto.CreateTradeRequest{SecurityID: f.security.ID, Type: "buy", Quantity: entity.MoneyFromInt(1), Price: entity.MoneyFromInt(25), OccurredAt: time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)}); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("conta corrente não deveria aceitar operações: %v", err)
+	}
+	if err := f.transactions.VoidTransaction(ctx, "user", purchase.ID, ""); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("lançamento de operação só deveria mudar pela operação: %v", err)
+	}
+	if err := f.uc.DeleteSecurity(ctx, "user", f.security.ID); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("ativo com operações não deveria ser removido: %v", err)
+	}
+
+	values, err := f.uc.MarketValues(ctx, f.accountRepo.storage["broker"], []time.Time{
+		time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	// Em fevereiro vale o preço da última compra; em abril, o do histórico
+	if values[0].Cmp(entity.MoneyFromInt(4000)) != 0 || values[1].Cmp(entity.MoneyFromInt(1250)) != 0 {
+		t.Fatalf("valores de mercado inesperados: %v", values)
+	}
+}
+
+// TestInvestmentUseCaseAverageCost garante o custo médio ponderado nas vendas
+func TestInvestmentUseCaseAverageCost(t *testing.T) {
+	f := newInvestmentFixture(t, entity.CostBasisAverage)
+	f.recordTrades(t)
+
+	portfolio, err := f.uc.GetPortfolio(context.Background(), "user", "broker")
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	holding := portfolio.Holdings[0]
+	// Custo total 3005 por 200 cotas; as 150 vendidas custaram 2253.75
+	if holding.CostBasis.String() != "751.25" || holding.RealizedGain.String() != "2236.25" {
+		t.Fatalf("custo médio inesperado: %s de custo, %s realizado", holding.CostBasis, holding.RealizedGain)
+	}
+	if len(holding.Lots) != 0 || portfolio.CostBasis != string(entity.CostBasisAverage) {
+		t.Fatalf("custo médio não deveria manter lotes: %+v", holding.Lots)
+	}
+}
+
+// TestInvestmentUseCaseDeleteTrade garante que desfazer a operação estorna o caixa e não deixa vendas a descoberto
+func TestInvestmentUseCaseDeleteTrade(t *testing.T) {
+	f := newInvestmentFixture(t, entity.CostBasisFIFO)
+	ctx := context.Background()
+	trades := f.recordTrades(t)
+
+	if err := f.uc.DeleteTrade(ctx, "user", trades[0].ID); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("remover a compra deixaria a venda a descoberto: %v", err)
+	}
+	if err := f.uc.DeleteTrade(ctx, "user", trades[2].ID); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if _, ok := f.tradeRepo.storage[trades[2].ID]; ok {
+		t.Fatalf("operação deveria ser removida")
+	}
+	if f.txRepo.storage[trades[2].TransactionID].Status != entity.TransactionStatusVoided {
+		t.Fatalf("lançamento da venda deveria ser anulado")
+	}
+	if balance := f.accountRepo.storage["broker"].Balance; balance.Cmp(entity.MoneyFromInt(6995)) != 0 {
+		t.Fatalf("caixa inesperado após desfazer a venda: %s", balance)
+	}
+}
+
+// TestInvestmentUseCaseImportPrices garante a importação do arquivo de preços e o relato de ativos desconhecidos
+func TestInvestmentUseCaseImportPrices(t *testing.T) {
+	f := newInvestmentFixture(t, entity.CostBasisFIFO)
+	ctx := context.Background()
+
+	file := "symbol,date,price\nPETR4,2024-01-30,37.10\npetr4,2024-01-31,38.42\nVALE3,2024-01-31,66.00\n"
+	response, err := f.uc.ImportPrices(ctx, "user", strings.NewReader(file))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if response.Imported != 2 || len(response.UnknownSymbols) != 1 || response.UnknownSymbols[0] != "VALE3" {
+		t.Fatalf("resumo inesperado: %+v", response)
+	}
+	prices, err := f.uc.ListPrices(ctx, "user", f.security.ID, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(prices) != 2 || prices[1].Price.String() != "38.42" || prices[1].Source != string(entity.PriceSourceFile) {
+		t.Fatalf("preços inesperados: %+v", prices)
+	}
+
+	if _, err := f.uc.ImportPrices(ctx, "user", strings.NewReader("PETR4,31/01/2024,38.42\n")); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("data malformada deveria recusar o arquivo: %v", err)
+	}
+}
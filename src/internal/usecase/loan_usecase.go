@@ -0,0 +1,379 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+// LoanUseCase cuida das contas de empréstimo e financiamento: cronograma de amortização, pagamento de parcelas,
+// amortizações extraordinárias e simulações. Os pagamentos viram transações comuns, então saldo, histórico de
+// saldo e patrimônio líquido continuam vindo das transações
+type LoanUseCase struct {
+	accountRepo  repository.AccountRepository
+	categoryRepo repository.CategoryRepository
+	transactions *TransactionUseCase
+	now          func() time.Time
+}
+
+func NewLoanUseCase(accountRepo repository.AccountRepository, categoryRepo repository.CategoryRepository, transactions *TransactionUseCase) *LoanUseCase {
+	return &LoanUseCase{
+		accountRepo:  accountRepo,
+		categoryRepo: categoryRepo,
+		transactions: transactions,
+		now:          func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (uc *LoanUseCase) GetLoan(ctx context.Context, userID string, accountID string) (*dto.LoanResponse, error) {
+	account, err := uc.getLoanAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	response := toLoanResponse(account)
+	return &response, nil
+}
+
+// PayInstallment paga a próxima parcela a partir de outra conta da mesma moeda. A parcela inteira sai da conta de
+// origem como transferência; os juros são lançados como despesa na conta do empréstimo, na categoria informada
+func (uc *LoanUseCase) PayInstallment(ctx context.Context, userID string, accountID string, request dto.PayLoanInstallmentRequest) (*dto.LoanPaymentResponse, error) {
+	account, from, err := uc.getPaymentAccounts(ctx, userID, accountID, request.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	category, err := uc.categoryRepo.GetByID(ctx, request.CategoryID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil || category.Type != entity.CategoryTypeExpense {
+		return nil, errors.ErrInvalidInput
+	}
+
+	previous := *account.Loan
+	loan := previous
+	row := loan.PayInstallment(account.Currency.MinorUnits())
+	occurredAt := uc.occurredAt(request.OccurredAt)
+	description := strings.TrimSpace(request.Description)
+	if description == "" {
+		description = fmt.Sprintf("%s - parcela %d/%d", account.Name, row.Number, loan.PaidInstallments+loan.RemainingInstallments)
+	}
+
+	paymentID := uuid.NewString()
+	transactions := uc.paymentLegs(userID, from, account, row.Payment, description, occurredAt, paymentID)
+	if row.Interest.IsPositive() {
+		// Os juros saem do próprio empréstimo: a transferência credita a parcela inteira e a despesa devolve os
+		// juros, de modo que o saldo devedor só cai pelo valor amortizado
+		interest := uc.newLoanTransaction(userID, account, entity.TransactionTypeExpense, row.Interest, description, occurredAt, paymentID)
+		interest.CategoryID = category.ID
+		transactions = append(transactions, interest)
+	}
+
+	loan.RecordPayment(previous, paymentID, transactionIDs(transactions))
+	if err := uc.record(ctx, account, &loan, transactions); err != nil {
+		return nil, err
+	}
+	return &dto.LoanPaymentResponse{
+		PaymentID:      paymentID,
+		Number:         row.Number,
+		Principal:      row.Principal,
+		Interest:       row.Interest,
+		Outstanding:    loan.Outstanding,
+		TransactionIDs: transactionIDs(transactions),
+		Loan:           toLoanResponse(account),
+	}, nil
+}
+
+// Prepay faz uma amortização extraordinária: o valor inteiro abate o saldo devedor, sem juros
+func (uc *LoanUseCase) Prepay(ctx context.Context, userID string, accountID string, request dto.PrepayLoanRequest) (*dto.LoanPaymentResponse, error) {
+	mode := prepaymentMode(request.Mode)
+	if !request.Amount.IsPositive() || !mode.IsValid() {
+		return nil, errors.ErrInvalidInput
+	}
+	account, from, err := uc.getPaymentAccounts(ctx, userID, accountID, request.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Amount.Cmp(account.Loan.Outstanding) > 0 {
+		return nil, errors.ErrInvalidInput
+	}
+
+	previous := *account.Loan
+	loan := previous
+	loan.Prepay(request.Amount, mode, account.Currency.MinorUnits())
+	occurredAt := uc.occurredAt(request.OccurredAt)
+	description := strings.TrimSpace(request.Description)
+	if description == "" {
+		description = fmt.Sprintf("%s - amortização extraordinária", account.Name)
+	}
+
+	paymentID := uuid.NewString()
+	transactions := uc.paymentLegs(userID, from, account, request.Amount, description, occurredAt, paymentID)
+	loan.RecordPayment(previous, paymentID, transactionIDs(transactions))
+	if err := uc.record(ctx, account, &loan, transactions); err != nil {
+		return nil, err
+	}
+	return &dto.LoanPaymentResponse{
+		PaymentID:      paymentID,
+		Principal:      request.Amount,
+		Interest:       entity.ZeroMoney,
+		Outstanding:    loan.Outstanding,
+		TransactionIDs: transactionIDs(transactions),
+		Loan:           toLoanResponse(account),
+	}, nil
+}
+
+// Simulate compara o cronograma atual com o de uma amortização extraordinária agora e/ou todo mês, sem gravar nada
+func (uc *LoanUseCase) Simulate(ctx context.Context, userID string, accountID string, request dto.SimulateLoanRequest) (*dto.LoanSimulationResponse, error) {
+	mode := prepaymentMode(request.Mode)
+	if request.Extra.IsNegative() || request.MonthlyExtra.IsNegative() || !mode.IsValid() {
+		return nil, errors.ErrInvalidInput
+	}
+	account, err := uc.getLoanAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	decimals := account.Currency.MinorUnits()
+
+	current := account.Loan.Project(entity.ZeroMoney, entity.PrepaymentReduceTerm, decimals)
+	simulated := *account.Loan
+	if request.Extra.IsPositive() {
+		simulated.Prepay(request.Extra, mode, decimals)
+	}
+	rows := simulated.Project(request.MonthlyExtra, mode, decimals)
+
+	response := &dto.LoanSimulationResponse{
+		Current:   toLoanProjection(current),
+		Simulated: toLoanProjection(rows),
+		Schedule:  toAmortizationRows(rows),
+	}
+	response.InterestSaved = response.Current.TotalInterest.Sub(response.Simulated.TotalInterest)
+	response.InstallmentsSaved = response.Current.Installments - response.Simulated.Installments
+	return response, nil
+}
+
+// ReversePayment estorna o pagamento mais recente do empréstimo, parcela ou amortização extraordinária: anula as
+// transações dele e volta o empréstimo ao estado de antes. Pagamentos anteriores só podem ser estornados depois
+// dos mais recentes, e um pagamento com transação conciliada não pode ser estornado
+func (uc *LoanUseCase) ReversePayment(ctx context.Context, userID string, accountID string, paymentID string) (*dto.LoanResponse, error) {
+	account, err := uc.getLoanAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.IsClosed() {
+		return nil, errors.ErrAccountClosed
+	}
+	last := account.Loan.LastPayment()
+	if last == nil || last.PaymentID != paymentID {
+		for _, payment := range account.Loan.Payments {
+			if payment.PaymentID == paymentID {
+				return nil, errors.ErrConflict
+			}
+		}
+		return nil, errors.ErrNotFound
+	}
+
+	transactions := make([]*entity.Transaction, 0, len(last.TransactionIDs))
+	for _, id := range last.TransactionIDs {
+		transaction, err := uc.transactions.transactionRepo.GetByID(ctx, id, userID)
+		if err != nil {
+			return nil, err
+		}
+		if transaction != nil && transaction.LoanPaymentID == paymentID {
+			transactions = append(transactions, transaction)
+		}
+	}
+
+	expected := *account.Loan
+	loan := expected
+	loan.RevertLastPayment()
+	err = uc.transactions.voidAllWith(ctx, transactions, "loan payment reversed", func(txCtx context.Context) error {
+		return uc.accountRepo.UpdateLoan(txCtx, account.ID, userID, expected, &loan)
+	})
+	if err != nil {
+		return nil, err
+	}
+	account.Loan = &loan
+	response := toLoanResponse(account)
+	return &response, nil
+}
+
+// record grava as transações do pagamento e o novo estado do empréstimo na mesma unidade de trabalho. O estado só
+// é gravado se o empréstimo ainda estiver como foi lido, então pagamentos concorrentes dão ErrConflict em vez de
+// um sobrescrever o outro
+func (uc *LoanUseCase) record(ctx context.Context, account *entity.Account, loan *entity.Loan, transactions []*entity.Transaction) error {
+	expected := *account.Loan
+	err := uc.transactions.recordWith(ctx, transactions, func(txCtx context.Context) error {
+		return uc.accountRepo.UpdateLoan(txCtx, account.ID, account.UserID, expected, loan)
+	})
+	if err != nil {
+		return err
+	}
+	account.Loan = loan
+	return nil
+}
+
+// paymentLegs monta a transferência da conta de origem para a conta do empréstimo
+func (uc *LoanUseCase) paymentLegs(userID string, from *entity.Account, account *entity.Account, amount entity.Money, description string, occurredAt time.Time, paymentID string) []*entity.Transaction {
+	outgoing := uc.newLoanTransaction(userID, from, entity.TransactionTypeTransferOut, amount, description, occurredAt, paymentID)
+	incoming := uc.newLoanTransaction(userID, account, entity.TransactionTypeTransferIn, amount, description, occurredAt, paymentID)
+	transferID := uuid.NewString()
+	outgoing.TransferID = transferID
+	incoming.TransferID = transferID
+	outgoing.LinkedTransactionID = incoming.ID
+	incoming.LinkedTransactionID = outgoing.ID
+	return []*entity.Transaction{outgoing, incoming}
+}
+
+func (uc *LoanUseCase) newLoanTransaction(userID string, account *entity.Account, transactionType entity.TransactionType, amount entity.Money, description string, occurredAt time.Time, paymentID string) *entity.Transaction {
+	now := uc.now()
+	return &entity.Transaction{
+		ID:            uuid.NewString(),
+		UserID:        userID,
+		AccountID:     account.ID,
+		Type:          transactionType,
+		Amount:        amount,
+		Currency:      account.Currency,
+		Description:   description,
+		OccurredAt:    occurredAt,
+		Status:        entity.TransactionStatusCompleted,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		Metadata:      map[string]string{},
+		LoanPaymentID: paymentID,
+	}
+}
+
+func (uc *LoanUseCase) occurredAt(requested *time.Time) time.Time {
+	if requested == nil || requested.IsZero() {
+		return uc.now()
+	}
+	return requested.UTC()
+}
+
+func (uc *LoanUseCase) getLoanAccount(ctx context.Context, userID string, accountID string) (*entity.Account, error) {
+	account, err := uc.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, errors.ErrNotFound
+	}
+	if account.Type != entity.AccountTypeLoan || account.Loan == nil {
+		return nil, errors.ErrInvalidInput
+	}
+	return account, nil
+}
+
+// getPaymentAccounts valida a conta do empréstimo e a de origem do pagamento: empréstimo quitado ou contas
+// encerradas dão ErrConflict; a origem precisa ser outra conta na mesma moeda
+func (uc *LoanUseCase) getPaymentAccounts(ctx context.Context, userID string, accountID string, fromAccountID string) (*entity.Account, *entity.Account, error) {
+	account, err := uc.getLoanAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if fromAccountID == account.ID {
+		return nil, nil, errors.ErrInvalidInput
+	}
+	from, err := uc.accountRepo.GetByID(ctx, fromAccountID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if from == nil {
+		return nil, nil, errors.ErrNotFound
+	}
+	if from.Currency != account.Currency {
+		return nil, nil, errors.ErrInvalidInput
+	}
+	if account.IsClosed() || from.IsClosed() {
+		return nil, nil, errors.ErrAccountClosed
+	}
+	if account.Loan.IsPaidOff() {
+		return nil, nil, errors.ErrConflict
+	}
+	return account, from, nil
+}
+
+func prepaymentMode(mode string) entity.PrepaymentMode {
+	if mode == "" {
+		return entity.PrepaymentReduceTerm
+	}
+	return entity.PrepaymentMode(mode)
+}
+
+// roundRate arredonda uma taxa em percentual para 4 casas decimais
+func roundRate(rate float64) float64 {
+	return math.Round(rate*10000) / 10000
+}
+
+func transactionIDs(transactions []*entity.Transaction) []string {
+	ids := make([]string, 0, len(transactions))
+	for _, transaction := range transactions {
+		ids = append(ids, transaction.ID)
+	}
+	return ids
+}
+
+func toLoanResponse(account *entity.Account) dto.LoanResponse {
+	loan := account.Loan
+	rows := loan.Project(entity.ZeroMoney, entity.PrepaymentReduceTerm, account.Currency.MinorUnits())
+	projection := toLoanProjection(rows)
+	response := dto.LoanResponse{
+		AccountID:             account.ID,
+		Currency:              string(account.Currency),
+		System:                string(loan.System),
+		AnnualRate:            loan.AnnualRate,
+		MonthlyRate:           roundRate(loan.MonthlyRate() * 100),
+		Principal:             loan.Principal,
+		Outstanding:           loan.Outstanding,
+		PaidInstallments:      loan.PaidInstallments,
+		RemainingInstallments: loan.RemainingInstallments,
+		RemainingInterest:     projection.TotalInterest,
+		PayoffDate:            projection.PayoffDate,
+		Schedule:              toAmortizationRows(rows),
+	}
+	if len(response.Schedule) > 0 {
+		response.NextPayment = &response.Schedule[0]
+	}
+	if last := loan.LastPayment(); last != nil {
+		response.LastPaymentID = last.PaymentID
+	}
+	return response
+}
+
+func toLoanProjection(rows []entity.AmortizationRow) dto.LoanProjection {
+	projection := dto.LoanProjection{Installments: len(rows), TotalInterest: entity.ZeroMoney, NextPayment: entity.ZeroMoney}
+	for _, row := range rows {
+		projection.TotalInterest = projection.TotalInterest.Add(row.Interest)
+	}
+	if len(rows) > 0 {
+		payoff := rows[len(rows)-1].DueDate
+		projection.PayoffDate = &payoff
+		projection.NextPayment = rows[0].Payment
+	}
+	return projection
+}
+
+func toAmortizationRows(rows []entity.AmortizationRow) []dto.AmortizationRowResponse {
+	response := make([]dto.AmortizationRowResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, dto.AmortizationRowResponse{
+			Number:    row.Number,
+			DueDate:   row.DueDate,
+			Payment:   row.Payment,
+			Principal: row.Principal,
+			Interest:  row.Interest,
+			Extra:     row.Extra,
+			Balance:   row.Balance,
+		})
+	}
+	return response
+}
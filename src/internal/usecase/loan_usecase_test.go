@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+type loanFixture struct {
+	uc           *LoanUseCase
+	transactions *TransactionUseCase
+	accountRepo  *accountRepositoryStub
+	txRepo       *transactionRepositoryStub
+	accountID    string
+}
+
+// newLoanFixture cria, pelo caso de uso de contas, um financiamento SAC de 100000 em 10 parcelas a 1% ao mês
+func newLoanFixture(t *testing.T) *loanFixture {
+	t.Helper()
+	f := newTransactionFixture().withAccounts(
+		&entity.Account{ID: "checking", UserID: "user", Type: entity.AccountTypeChecking, Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(50000)},
+		&entity.Account{ID: "dollars", UserID: "user", Type: entity.AccountTypeChecking, Currency: entity.CurrencyUSD},
+	).withCategories(
+		&entity.Category{ID: "interest", Type: entity.CategoryTypeExpense},
+		&entity.Category{ID: "salary", Type: entity.CategoryTypeIncome},
+	)
+
+	account, err := NewAccountUseCase(f.accountRepo, nil, nil, nil, nil, nil, nil, nil, nil).CreateAccount(context.Background(), "user", dto.CreateAccountRequest{
+		Name:     "Financiamento",
+		Type:     "loan",
+		Currency: "BRL",
+		Loan: &dto.LoanTerms{
+			Principal:    entity.MoneyFromInt(100000),
+			AnnualRate:   onePercentMonthlyRate(),
+			TermMonths:   10,
+			FirstDueDate: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+		},
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro ao criar o empréstimo: %v", err)
+	}
+	uc := NewLoanUseCase(f.accountRepo, f.categoryRepo, f.transactions())
+	return &loanFixture{uc: uc, transactions: f.transactions(), accountRepo: f.accountRepo, txRepo: f.txRepo, accountID: account.ID}
+}
+
+// onePercentMonthlyRate é a taxa anual, em percentual, equivalente a 1% ao mês
+func onePercentMonthlyRate() float64 {
+	return (math.Pow(1.01, 12) - 1) * 100
+}
+
+// TestLoanUseCasePayInstallment garante a divisão entre amortização e juros e o saldo das duas contas
+func TestLoanUseCasePayInstallment(t *testing.T) {
+	f := newLoanFixture(t)
+	ctx := context.Background()
+
+	loan := f.accountRepo.storage[f.accountID]
+	if loan.Balance.Cmp(entity.MoneyFromInt(-100000)) != 0 {
+		t.Fatalf("saldo inicial deveria ser o valor financiado negativo, obtive %s", loan.Balance)
+	}
+
+	payment, err := f.uc.PayInstallment(ctx, "user", f.accountID, dto.PayLoanInstallmentRequest{FromAccountID: "checking", CategoryID: "interest"})
+	if err != nil {
+		t.Fatalf("não esperava erro ao pagar a parcela: %v", err)
+	}
+	if payment.Number != 1 || payment.Principal.String() != "10000.00" || payment.Interest.String() != "1000.00" || len(payment.TransactionIDs) != 3 {
+		t.Fatalf("pagamento inesperado: %+v", payment)
+	}
+	if loan.Balance.Cmp(entity.MoneyFromInt(-90000)) != 0 || f.accountRepo.storage["checking"].Balance.Cmp(entity.MoneyFromInt(39000)) != 0 {
+		t.Fatalf("saldos inesperados: empréstimo %s, corrente %s", loan.Balance, f.accountRepo.storage["checking"].Balance)
+	}
+	if payment.Loan.PaidInstallments != 1 || payment.Loan.RemainingInstallments != 9 || payment.Loan.NextPayment.Payment.String() != "10900.00" {
+		t.Fatalf("situação do empréstimo inesperada: %+v", payment.Loan)
+	}
+
+	for _, id := range payment.TransactionIDs {
+		transaction := f.txRepo.storage[id]
+		if transaction == nil || transaction.LoanPaymentID == "" {
+			t.Fatalf("transação %s deveria estar vinculada ao pagamento", id)
+		}
+		if transaction.Type == entity.TransactionTypeExpense && (transaction.AccountID != f.accountID || transaction.CategoryID != "interest") {
+			t.Fatalf("juros deveriam ser despesa na conta do empréstimo: %+v", transaction)
+		}
+	}
+	if err := f.transactions.VoidTransaction(ctx, "user", payment.TransactionIDs[0], "engano"); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("transações do pagamento não deveriam ser anuladas, obtive %v", err)
+	}
+}
+
+// TestLoanUseCasePrepayAndSimulate garante a amortização extraordinária e a economia simulada
+func TestLoanUseCasePrepayAndSimulate(t *testing.T) {
+	f := newLoanFixture(t)
+	ctx := context.Background()
+
+	simulation, err := f.uc.Simulate(ctx, "user", f.accountID, dto.SimulateLoanRequest{Extra: entity.MoneyFromInt(20000)})
+	if err != nil {
+		t.Fatalf("não esperava erro ao simular: %v", err)
+	}
+	if simulation.Current.Installments != 10 || simulation.Simulated.Installments != 8 || simulation.InstallmentsSaved != 2 || !simulation.InterestSaved.IsPositive() {
+		t.Fatalf("simulação inesperada: %+v", simulation)
+	}
+	if f.accountRepo.storage[f.accountID].Loan.RemainingInstallments != 10 {
+		t.Fatalf("simulação não deveria alterar o empréstimo")
+	}
+
+	prepayment, err := f.uc.Prepay(ctx, "user", f.accountID, dto.PrepayLoanRequest{FromAccountID: "checking", Amount: entity.MoneyFromInt(20000)})
+	if err != nil {
+		t.Fatalf("não esperava erro na amortização extraordinária: %v", err)
+	}
+	if prepayment.Outstanding.String() != "80000.00" || prepayment.Loan.RemainingInstallments != 8 || len(prepayment.TransactionIDs) != 2 {
+		t.Fatalf("amortização inesperada: %+v", prepayment)
+	}
+	if prepayment.Loan.RemainingInterest.Cmp(simulation.Simulated.TotalInterest) != 0 {
+		t.Fatalf("juros restantes deveriam bater com a simulação: %s e %s", prepayment.Loan.RemainingInterest, simulation.Simulated.TotalInterest)
+	}
+
+	if _, err := f.uc.Prepay(ctx, "user", f.accountID, dto.PrepayLoanRequest{FromAccountID: "checking", Amount: entity.MoneyFromInt(80000)}); err != nil {
+		t.Fatalf("não esperava erro ao quitar: %v", err)
+	}
+	if _, err := f.uc.PayInstallment(ctx, "user", f.accountID, dto.PayLoanInstallmentRequest{FromAccountID: "checking", CategoryID: "interest"}); err != domainerrors.ErrConflict {
+		t.Fatalf("empréstimo quitado deveria recusar pagamentos, obtive %v", err)
+	}
+	if balance := f.accountRepo.storage[f.accountID].Balance; !balance.IsZero() {
+		t.Fatalf("saldo do empréstimo quitado deveria ser zero, obtive %s", balance)
+	}
+}
+
+// TestLoanUseCaseGuards garante as validações de conta de origem, categoria e termos
+func TestLoanUseCaseGuards(t *testing.T) {
+	f := newLoanFixture(t)
+	ctx := context.Background()
+
+	cases := map[string]dto.PayLoanInstallmentRequest{
+		"moeda diferente":       {FromAccountID: "dollars", CategoryID: "interest"},
+		"própria conta":         {FromAccountID: f.accountID, CategoryID: "interest"},
+		"categoria de receita":  {FromAccountID: "checking", CategoryID: "salary"},
+		"categoria inexistente": {FromAccountID: "checking", CategoryID: "missing"},
+	}
+	for name, request := range cases {
+		if _, err := f.uc.PayInstallment(ctx, "user", f.accountID, request); err != domainerrors.ErrInvalidInput {
+			t.Fatalf("%s: esperava ErrInvalidInput, obtive %v", name, err)
+		}
+	}
+	if _, err := f.uc.GetLoan(ctx, "user", "checking"); err != domainerrors.ErrInvalidInput {
+		t.Fatalf("conta que não é de empréstimo deveria ser recusada, obtive %v", err)
+	}
+	if _, err := f.uc.Prepay(ctx, "user", f.accountID, dto.PrepayLoanRequest{FromAccountID: "checking", Amount: entity.MoneyFromInt(100001)}); err != domainerrors.ErrInvalidInput {
+		t.Fatalf("amortização acima do saldo devedor deveria ser recusada, obtive %v", err)
+	}
+
+	accounts := NewAccountUseCase(f.accountRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+	if _, err := accounts.CreateAccount(ctx, "user", dto.CreateAccountRequest{Name: "Sem termos", Type: "loan", Currency: "BRL"}); err != domainerrors.ErrInvalidInput {
+		t.Fatalf("empréstimo sem termos deveria ser recusado, obtive %v", err)
+	}
+	loanType := "checking"
+	if _, err := accounts.UpdateAccount(ctx, "user", f.accountID, dto.UpdateAccountRequest{Type: &loanType}); err != domainerrors.ErrInvalidInput {
+		t.Fatalf("empréstimo não deveria mudar de tipo, obtive %v", err)
+	}
+}
+
+// TestLoanUseCaseReversePayment garante que o estorno anula as transações e volta o empréstimo ao estado anterior,
+// sempre do pagamento mais recente para o mais antigo
+func TestLoanUseCaseReversePayment(t *testing.T) {
+	f := newLoanFixture(t)
+	ctx := context.Background()
+
+	payment, err := f.uc.PayInstallment(ctx, "user", f.accountID, dto.PayLoanInstallmentRequest{FromAccountID: "checking", CategoryID: "interest"})
+	if err != nil {
+		t.Fatalf("não esperava erro ao pagar a parcela: %v", err)
+	}
+	prepayment, err := f.uc.Prepay(ctx, "user", f.accountID, dto.PrepayLoanRequest{FromAccountID: "checking", Amount: entity.MoneyFromInt(20000)})
+	if err != nil {
+		t.Fatalf("não esperava erro na amortização extraordinária: %v", err)
+	}
+	if prepayment.Loan.LastPaymentID != prepayment.PaymentID {
+		t.Fatalf("amortização deveria ser o pagamento mais recente: %+v", prepayment.Loan)
+	}
+
+	if _, err := f.uc.ReversePayment(ctx, "user", f.accountID, payment.PaymentID); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("pagamento anterior ao mais recente não deveria ser estornado, obtive %v", err)
+	}
+	if _, err := f.uc.ReversePayment(ctx, "user", f.accountID, "missing"); !errors.Is(err, domainerrors.ErrNotFound) {
+		t.Fatalf("esperava ErrNotFound para pagamento inexistente, obtive %v", err)
+	}
+
+	loan, err := f.uc.ReversePayment(ctx, "user", f.accountID, prepayment.PaymentID)
+	if err != nil {
+		t.Fatalf("não esperava erro ao estornar a amortização: %v", err)
+	}
+	if loan.Outstanding.String() != "90000.00" || loan.RemainingInstallments != 9 || loan.LastPaymentID != payment.PaymentID {
+		t.Fatalf("empréstimo deveria voltar ao estado depois da primeira parcela: %+v", loan)
+	}
+	for _, id := range prepayment.TransactionIDs {
+		if f.txRepo.storage[id].Status != entity.TransactionStatusVoided {
+			t.Fatalf("transação %s da amortização deveria estar anulada", id)
+		}
+	}
+
+	loan, err = f.uc.ReversePayment(ctx, "user", f.accountID, payment.PaymentID)
+	if err != nil {
+		t.Fatalf("não esperava erro ao estornar a parcela: %v", err)
+	}
+	if loan.Outstanding.String() != "100000.00" || loan.PaidInstallments != 0 || loan.RemainingInstallments != 10 || loan.LastPaymentID != "" {
+		t.Fatalf("empréstimo deveria voltar ao estado inicial: %+v", loan)
+	}
+	if f.accountRepo.storage[f.accountID].Balance.Cmp(entity.MoneyFromInt(-100000)) != 0 || f.accountRepo.storage["checking"].Balance.Cmp(entity.MoneyFromInt(50000)) != 0 {
+		t.Fatalf("saldos deveriam voltar ao inicial: empréstimo %s, corrente %s", f.accountRepo.storage[f.accountID].Balance, f.accountRepo.storage["checking"].Balance)
+	}
+}
+
+// TestLoanUseCaseStaleLoan garante que um pagamento montado sobre um empréstimo desatualizado é recusado sem
+// gravar as transações
+func TestLoanUseCaseStaleLoan(t *testing.T) {
+	f := newLoanFixture(t)
+	ctx := context.Background()
+
+	stale := *f.accountRepo.storage[f.accountID]
+	staleLoan := *stale.Loan
+	stale.Loan = &staleLoan
+	if _, err := f.uc.PayInstallment(ctx, "user", f.accountID, dto.PayLoanInstallmentRequest{FromAccountID: "checking", CategoryID: "interest"}); err != nil {
+		t.Fatalf("não esperava erro ao pagar a parcela: %v", err)
+	}
+
+	next := staleLoan
+	row := next.PayInstallment(stale.Currency.MinorUnits())
+	legs := f.uc.paymentLegs("user", f.accountRepo.storage["checking"], &stale, row.Payment, "parcela", time.Now().UTC(), "stale")
+	if err := f.uc.record(ctx, &stale, &next, legs); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("esperava ErrConflict com o empréstimo desatualizado, obtive %v", err)
+	}
+	if f.accountRepo.storage[f.accountID].Loan.PaidInstallments != 1 || f.accountRepo.storage["checking"].Balance.Cmp(entity.MoneyFromInt(39000)) != 0 {
+		t.Fatalf("pagamento recusado não deveria alterar o empréstimo nem os saldos")
+	}
+	if f.txRepo.storage[legs[0].ID] != nil {
+		t.Fatalf("transações do pagamento recusado não deveriam ser gravadas")
+	}
+}
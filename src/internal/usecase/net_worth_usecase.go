@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+// NetWorthUseCase soma os saldos de todas as contas do usuário, inclusive as encerradas, convertidos para a moeda
+// padrão dele: ativos (corrente, poupança, dinheiro, investimentos) menos passivos (cartão de crédito). Contas de
+// investimento valem o caixa mais as posições a preço de mercado
+type NetWorthUseCase struct {
+	accountRepo    repository.AccountRepository
+	balanceHistory *BalanceHistoryUseCase
+	exchangeRates  *ExchangeRateUseCase
+	investments    *InvestmentUseCase
+	now            func() time.Time
+}
+
+func NewNetWorthUseCase(accountRepo repository.AccountRepository, balanceHistory *BalanceHistoryUseCase, exchangeRates *ExchangeRateUseCase, investments *InvestmentUseCase) *NetWorthUseCase {
+	return &NetWorthUseCase{
+		accountRepo:    accountRepo,
+		balanceHistory: balanceHistory,
+		exchangeRates:  exchangeRates,
+		investments:    investments,
+		now:            func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// GetNetWorth devolve o patrimônio líquido atual, convertendo cada saldo com a cotação do dia
+func (uc *NetWorthUseCase) GetNetWorth(ctx context.Context, userID string, currency string) (*dto.NetWorthResponse, error) {
+	accounts, err := uc.accountRepo.List(ctx, userID, true, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	target := reportCurrency(currency)
+	now := uc.now()
+	netWorth := entity.NewNetWorth()
+	response := &dto.NetWorthResponse{
+		Currency: target.String(),
+		Date:     now,
+		Accounts: make([]dto.NetWorthAccount, 0, len(accounts)),
+	}
+	for _, account := range accounts {
+		marketValues, err := uc.marketValues(ctx, account, []time.Time{now})
+		if err != nil {
+			return nil, err
+		}
+		converted, err := uc.exchangeRates.Convert(ctx, account.Balance.Add(marketValues[0]), account.Currency, target, now)
+		if err != nil {
+			return nil, err
+		}
+		netWorth.Add(account.Type, converted)
+		response.Accounts = append(response.Accounts, dto.NetWorthAccount{
+			AccountID:        account.ID,
+			Name:             account.Name,
+			Type:             string(account.Type),
+			Currency:         account.Currency.String(),
+			Balance:          account.Balance,
+			MarketValue:      marketValues[0],
+			ConvertedBalance: converted,
+		})
+	}
+
+	response.Assets = netWorth.Assets
+	response.Liabilities = netWorth.Liabilities
+	response.NetWorth = netWorth.Total()
+	response.ByType = netWorthByType(netWorth)
+	return response, nil
+}
+
+// GetNetWorthHistory monta a série do patrimônio a partir do histórico de saldo de cada conta. Cada ponto
+// converte os saldos com a cotação do fim do seu período
+func (uc *NetWorthUseCase) GetNetWorthHistory(ctx context.Context, userID string, currency string, from time.Time, to time.Time, interval string) (*dto.NetWorthHistoryResponse, error) {
+	period := entity.BalanceInterval(interval)
+	if period == "" {
+		period = entity.BalanceIntervalMonth
+	}
+	if !period.IsValid() || to.Before(from) {
+		return nil, errors.ErrInvalidInput
+	}
+	accounts, err := uc.accountRepo.List(ctx, userID, true, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	target := reportCurrency(currency)
+	var points []*entity.NetWorth
+	var dates []time.Time
+	for start := period.PeriodStart(from); !start.After(to); start = period.Next(start) {
+		if len(points) == maxBalanceHistoryPoints {
+			return nil, errors.ErrInvalidInput
+		}
+		points = append(points, entity.NewNetWorth())
+		dates = append(dates, start)
+	}
+
+	for _, account := range accounts {
+		history, err := uc.balanceHistory.GetBalanceHistory(ctx, userID, account.ID, from, to, string(period))
+		if err != nil {
+			return nil, err
+		}
+		ends := make([]time.Time, len(history.Points))
+		for i, point := range history.Points {
+			ends[i] = period.Next(point.Date)
+			if ends[i].After(to) {
+				ends[i] = to
+			}
+		}
+		marketValues, err := uc.marketValues(ctx, account, ends)
+		if err != nil {
+			return nil, err
+		}
+		for i, point := range history.Points {
+			at := ends[i]
+			converted, err := uc.exchangeRates.Convert(ctx, point.Balance.Add(marketValues[i]), account.Currency, target, at)
+			if err != nil {
+				return nil, err
+			}
+			points[i].Add(account.Type, converted)
+		}
+	}
+
+	response := &dto.NetWorthHistoryResponse{
+		Currency: target.String(),
+		Interval: string(period),
+		From:     from,
+		To:       to,
+		Points:   make([]dto.NetWorthPoint, 0, len(points)),
+	}
+	for i, netWorth := range points {
+		response.Points = append(response.Points, dto.NetWorthPoint{
+			Date:        dates[i],
+			Assets:      netWorth.Assets,
+			Liabilities: netWorth.Liabilities,
+			NetWorth:    netWorth.Total(),
+			ByType:      netWorthByType(netWorth),
+		})
+	}
+	return response, nil
+}
+
+// marketValues devolve o valor das posições da conta em cada data; zero quando investimentos não estão habilitados
+func (uc *NetWorthUseCase) marketValues(ctx context.Context, account *entity.Account, dates []time.Time) ([]entity.Money, error) {
+	if uc.investments == nil {
+		return make([]entity.Money, len(dates)), nil
+	}
+	return uc.investments.MarketValues(ctx, account, dates)
+}
+
+func netWorthByType(netWorth *entity.NetWorth) map[string]entity.Money {
+	byType := make(map[string]entity.Money, len(netWorth.ByType))
+	for accountType, balance := range netWorth.ByType {
+		byType[string(accountType)] = balance
+	}
+	return byType
+}
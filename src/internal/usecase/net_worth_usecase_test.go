@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+func newNetWorthFixture(t *testing.T) *NetWorthUseCase {
+	t.Helper()
+	f := newTransactionFixture().withAccounts(
+		&entity.Account{ID: "checking", UserID: "user", Type: entity.AccountTypeChecking, Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(1000)},
+		&entity.Account{ID: "savings", UserID: "user", Type: entity.AccountTypeSavings, Currency: entity.CurrencyUSD, Balance: entity.MoneyFromInt(100)},
+		&entity.Account{ID: "card", UserID: "user", Type: entity.AccountTypeCredit, Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(-300)},
+	).withTransactions("card",
+		&entity.Transaction{ID: "purchase", Type: entity.TransactionTypeExpense, Amount: entity.MoneyFromInt(300), OccurredAt: time.Date(2024, 2, 10, 12, 0, 0, 0, time.UTC)},
+	)
+
+	rates := &exchangeRateRepositoryStub{}
+	rates.add(entity.CurrencyUSD, entity.CurrencyBRL, 5.0, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	rates.add(entity.CurrencyUSD, entity.CurrencyBRL, 6.0, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	uc := NewNetWorthUseCase(f.accountRepo, NewBalanceHistoryUseCase(f.accountRepo, f.txRepo, f.categoryRepo, nil, 0), NewExchangeRateUseCase(rates, nil), nil)
+	uc.now = func() time.Time { return time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC) }
+	return uc
+}
+
+// TestGetNetWorthConvertsAndSubtractsLiabilities garante a conversão para a moeda padrão e o cartão como passivo
+func TestGetNetWorthConvertsAndSubtractsLiabilities(t *testing.T) {
+	uc := newNetWorthFixture(t)
+
+	response, err := uc.GetNetWorth(context.Background(), "user", "BRL")
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if response.Assets.Cmp(entity.MoneyFromInt(1600)) != 0 || response.Liabilities.Cmp(entity.MoneyFromInt(300)) != 0 {
+		t.Fatalf("ativos e passivos inesperados: %s e %s", response.Assets, response.Liabilities)
+	}
+	if response.NetWorth.Cmp(entity.MoneyFromInt(1300)) != 0 {
+		t.Fatalf("patrimônio líquido inesperado: %s", response.NetWorth)
+	}
+	if response.ByType["savings"].Cmp(entity.MoneyFromInt(600)) != 0 || len(response.Accounts) != 3 {
+		t.Fatalf("detalhamento inesperado: %v, %d contas", response.ByType, len(response.Accounts))
+	}
+}
+
+// TestGetNetWorthHistoryMonthly garante um ponto por mês com os saldos reconstruídos e a cotação do fim de cada mês
+func TestGetNetWorthHistoryMonthly(t *testing.T) {
+	uc := newNetWorthFixture(t)
+	ctx := context.Background()
+
+	history, err := uc.GetNetWorthHistory(ctx, "user", "BRL", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), "")
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if history.Interval != "month" || len(history.Points) != 3 {
+		t.Fatalf("esperava 3 pontos mensais, obtive %d (%s)", len(history.Points), history.Interval)
+	}
+	want := []struct {
+		assets      int64
+		liabilities int64
+	}{
+		{1500, 0},
+		{1600, 300},
+		{1600, 300},
+	}
+	for i, point := range history.Points {
+		if point.Assets.Cmp(entity.MoneyFromInt(want[i].assets)) != 0 || point.Liabilities.Cmp(entity.MoneyFromInt(want[i].liabilities)) != 0 {
+			t.Fatalf("ponto %d inesperado: ativos %s, passivos %s", i, point.Assets, point.Liabilities)
+		}
+	}
+	if history.Points[0].NetWorth.Cmp(entity.MoneyFromInt(1500)) != 0 || history.Points[1].NetWorth.Cmp(entity.MoneyFromInt(1300)) != 0 {
+		t.Fatalf("patrimônio inesperado: %s e %s", history.Points[0].NetWorth, history.Points[1].NetWorth)
+	}
+
+	if _, err := uc.GetNetWorthHistory(ctx, "user", "BRL", time.Now(), time.Now(), "quarter"); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("esperava ErrInvalidInput para intervalo inválido, obtive %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/port"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+const (
+	defaultOutboxBatchSize   = 50
+	defaultOutboxMaxAttempts = 10
+	outboxLease              = 30 * time.Second
+	outboxBaseBackoff        = 2 * time.Second
+	outboxMaxBackoff         = 15 * time.Minute
+)
+
+// OutboxRelay publica na fila os eventos gravados no outbox, com novas tentativas em backoff exponencial
+type OutboxRelay struct {
+	outboxRepo  repository.OutboxRepository
+	publisher   port.QueuePublisher
+	batchSize   int
+	maxAttempts int
+	now         func() time.Time
+}
+
+func NewOutboxRelay(outboxRepo repository.OutboxRepository, publisher port.QueuePublisher, batchSize int, maxAttempts int) *OutboxRelay {
+	if batchSize <= 0 {
+		batchSize = defaultOutboxBatchSize
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = defaultOutboxMaxAttempts
+	}
+	return &OutboxRelay{
+		outboxRepo:  outboxRepo,
+		publisher:   publisher,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		now:         func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// RelayPending publica um lote de eventos pendentes e devolve quantos foram enviados.
+// Falhas de publicação são reagendadas; após maxAttempts o evento fica como failed para análise.
+func (r *OutboxRelay) RelayPending(ctx context.Context) (int, error) {
+	events, err := r.outboxRepo.ClaimPending(ctx, r.now(), outboxLease, r.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, event := range events {
+		publishErr := r.publisher.Publish(ctx, event.QueueName, port.QueueMessage{
+			ID:         event.ID,
+			Payload:    event.Payload,
+			Attributes: event.Attributes,
+		})
+		if publishErr == nil {
+			if err := r.outboxRepo.MarkSent(ctx, event.ID, r.now()); err != nil {
+				return sent, err
+			}
+			sent++
+			continue
+		}
+
+		if err := r.scheduleRetry(ctx, event, publishErr); err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+func (r *OutboxRelay) scheduleRetry(ctx context.Context, event *entity.OutboxEvent, publishErr error) error {
+	attempts := event.Attempts + 1
+	if attempts >= r.maxAttempts {
+		return r.outboxRepo.MarkFailed(ctx, event.ID, attempts, publishErr.Error())
+	}
+	return r.outboxRepo.MarkRetry(ctx, event.ID, attempts, r.now().Add(outboxBackoff(attempts)), publishErr.Error())
+}
+
+// outboxBackoff dobra a espera a cada tentativa, limitada a outboxMaxBackoff
+func outboxBackoff(attempts int) time.Duration {
+	delay := outboxBaseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= outboxMaxBackoff {
+			return outboxMaxBackoff
+		}
+	}
+	return delay
+}
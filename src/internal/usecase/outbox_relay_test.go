@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+func newPendingOutboxEvent(id string) *entity.OutboxEvent {
+	return &entity.OutboxEvent{
+		ID:            id,
+		EventType:     "TRANSACTION_RECORDED",
+		QueueName:     "financial-queue",
+		Payload:       []byte(`{"eventId":"` + id + `"}`),
+		Attributes:    map[string]string{"eventType": "TRANSACTION_RECORDED"},
+		Status:        entity.OutboxStatusPending,
+		NextAttemptAt: time.Now().UTC().Add(-time.Second),
+	}
+}
+
+// TestOutboxRelayPublicaEMarcaEnviado garante publicação na ordem do outbox e marcação como enviado
+func TestOutboxRelayPublicaEMarcaEnviado(t *testing.T) {
+	outbox := newOutboxRepositoryStub()
+	outbox.events = []*entity.OutboxEvent{newPendingOutboxEvent("evt-1"), newPendingOutboxEvent("evt-2")}
+	queue := &queuePublisherStub{}
+
+	relay := NewOutboxRelay(outbox, queue, 10, 3)
+	sent, err := relay.RelayPending(context.Background())
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if sent != 2 || len(queue.messages) != 2 {
+		t.Fatalf("esperava 2 eventos publicados, obteve %d", sent)
+	}
+	if queue.messages[0].ID != "evt-1" || queue.messages[1].ID != "evt-2" {
+		t.Fatalf("ordem de publicação inesperada: %#v", queue.messages)
+	}
+	for _, event := range outbox.events {
+		if event.Status != entity.OutboxStatusSent || event.SentAt == nil {
+			t.Fatalf("evento %s deveria estar marcado como enviado", event.ID)
+		}
+	}
+
+	sent, err = relay.RelayPending(context.Background())
+	if err != nil || sent != 0 {
+		t.Fatalf("eventos enviados não deveriam ser republicados, enviados=%d erro=%v", sent, err)
+	}
+}
+
+// TestOutboxRelayReagendaEMarcaFalha garante backoff em falhas e status failed ao esgotar tentativas
+func TestOutboxRelayReagendaEMarcaFalha(t *testing.T) {
+	outbox := newOutboxRepositoryStub()
+	outbox.events = []*entity.OutboxEvent{newPendingOutboxEvent("evt-1")}
+	queue := &queuePublisherStub{err: errors.New("sqs unavailable")}
+
+	relay := NewOutboxRelay(outbox, queue, 10, 2)
+	sent, err := relay.RelayPending(context.Background())
+	if err != nil {
+		t.Fatalf("falha de publicação não deveria interromper o relay: %v", err)
+	}
+	event := outbox.events[0]
+	if sent != 0 || event.Status != entity.OutboxStatusPending || event.Attempts != 1 {
+		t.Fatalf("evento deveria continuar pendente após a primeira falha: %#v", event)
+	}
+	if !event.NextAttemptAt.After(time.Now().UTC()) || event.LastError == "" {
+		t.Fatalf("nova tentativa deveria ser agendada no futuro com o erro registrado")
+	}
+
+	event.NextAttemptAt = time.Now().UTC().Add(-time.Second)
+	if _, err := relay.RelayPending(context.Background()); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if event.Status != entity.OutboxStatusFailed || event.Attempts != 2 {
+		t.Fatalf("evento deveria falhar ao esgotar tentativas: %#v", event)
+	}
+}
+
+// TestOutboxBackoff garante crescimento exponencial limitado
+func TestOutboxBackoff(t *testing.T) {
+	if outboxBackoff(1) != outboxBaseBackoff || outboxBackoff(3) != 4*outboxBaseBackoff {
+		t.Fatalf("backoff inesperado: %v %v", outboxBackoff(1), outboxBackoff(3))
+	}
+	if outboxBackoff(30) != outboxMaxBackoff {
+		t.Fatalf("backoff deveria ser limitado a %v", outboxMaxBackoff)
+	}
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+// createdAtCursorSort é a ordenação das listagens por chave de contas, orçamentos e metas
+const createdAtCursorSort = "-createdAt"
+
+// encodePageCursor transforma o cursor em um token opaco para o cliente
+func encodePageCursor(cursor repository.PageCursor) string {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageCursor lê o token devolvido em nextCursor; token vazio pede a primeira página
+func decodePageCursor(token string, sort string) (*repository.PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.ErrInvalidInput
+	}
+	var cursor repository.PageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" || cursor.Sort != sort {
+		return nil, errors.ErrInvalidInput
+	}
+	return &cursor, nil
+}
+
+// splitPage descarta o item extra buscado além de limit, que só indica se existe uma próxima página
+func splitPage[T any](items []T, limit int64) ([]T, bool) {
+	if limit <= 0 || int64(len(items)) <= limit {
+		return items, false
+	}
+	return items[:limit], true
+}
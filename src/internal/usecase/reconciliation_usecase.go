@@ -0,0 +1,279 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+// ReconciliationUseCase confere as transações de uma conta contra o extrato do banco. Marcar uma transação como
+// conferida pode ser desfeito enquanto a conciliação estiver aberta; ao concluir, as conferidas ficam travadas.
+type ReconciliationUseCase struct {
+	reconciliationRepo repository.ReconciliationRepository
+	accountRepo        repository.AccountRepository
+	transactionRepo    repository.TransactionRepository
+	categoryRepo       repository.CategoryRepository
+	unitOfWork         repository.UnitOfWork
+}
+
+func NewReconciliationUseCase(
+	reconciliationRepo repository.ReconciliationRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	categoryRepo repository.CategoryRepository,
+	unitOfWork repository.UnitOfWork,
+) *ReconciliationUseCase {
+	return &ReconciliationUseCase{
+		reconciliationRepo: reconciliationRepo,
+		accountRepo:        accountRepo,
+		transactionRepo:    transactionRepo,
+		categoryRepo:       categoryRepo,
+		unitOfWork:         unitOfWork,
+	}
+}
+
+// StartReconciliation abre uma conciliação para a conta; a data do extrato não pode ser anterior à da última concluída
+func (uc *ReconciliationUseCase) StartReconciliation(ctx context.Context, userID string, accountID string, request dto.StartReconciliationRequest) (*dto.ReconciliationResponse, error) {
+	account, err := uc.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, errors.ErrNotFound
+	}
+	open, err := uc.reconciliationRepo.GetOpen(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if open != nil {
+		return nil, errors.ErrConflict
+	}
+	latest, err := uc.reconciliationRepo.List(ctx, userID, accountID, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(latest) > 0 && request.StatementDate.Before(latest[0].StatementDate) {
+		return nil, errors.ErrInvalidInput
+	}
+
+	now := time.Now().UTC()
+	reconciliation := &entity.Reconciliation{
+		ID:               uuid.NewString(),
+		UserID:           userID,
+		AccountID:        accountID,
+		StatementDate:    request.StatementDate,
+		StatementBalance: request.StatementBalance,
+		Status:           entity.ReconciliationStatusOpen,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if err := uc.reconciliationRepo.Create(ctx, reconciliation); err != nil {
+		return nil, err
+	}
+	return uc.toResponse(ctx, account, reconciliation)
+}
+
+func (uc *ReconciliationUseCase) GetReconciliation(ctx context.Context, userID string, reconciliationID string) (*dto.ReconciliationResponse, error) {
+	reconciliation, account, err := uc.load(ctx, userID, reconciliationID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toResponse(ctx, account, reconciliation)
+}
+
+func (uc *ReconciliationUseCase) ListReconciliations(ctx context.Context, userID string, accountID string, limit int64, offset int64) ([]*dto.ReconciliationResponse, error) {
+	account, err := uc.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, errors.ErrNotFound
+	}
+	reconciliations, err := uc.reconciliationRepo.List(ctx, userID, accountID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	response := make([]*dto.ReconciliationResponse, 0, len(reconciliations))
+	for _, reconciliation := range reconciliations {
+		item, err := uc.toResponse(ctx, account, reconciliation)
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, item)
+	}
+	return response, nil
+}
+
+// ClearTransactions marca ou desmarca transações como conferidas; só aceita candidatas da conciliação aberta
+func (uc *ReconciliationUseCase) ClearTransactions(ctx context.Context, userID string, reconciliationID string, request dto.ClearTransactionsRequest) (*dto.ReconciliationResponse, error) {
+	reconciliation, account, err := uc.loadOpen(ctx, userID, reconciliationID)
+	if err != nil {
+		return nil, err
+	}
+	for _, transactionID := range request.TransactionIDs {
+		transaction, err := uc.transactionRepo.GetByID(ctx, transactionID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if transaction == nil {
+			return nil, errors.ErrNotFound
+		}
+		if !isReconciliationCandidate(reconciliation, transaction) {
+			return nil, errors.ErrInvalidInput
+		}
+	}
+
+	cleared := request.Cleared == nil || *request.Cleared
+	if err := uc.transactionRepo.SetCleared(ctx, userID, request.TransactionIDs, cleared); err != nil {
+		return nil, err
+	}
+	return uc.toResponse(ctx, account, reconciliation)
+}
+
+// CompleteReconciliation trava as transações conferidas; exige que o saldo conferido seja igual ao do extrato
+func (uc *ReconciliationUseCase) CompleteReconciliation(ctx context.Context, userID string, reconciliationID string) (*dto.ReconciliationResponse, error) {
+	reconciliation, account, err := uc.loadOpen(ctx, userID, reconciliationID)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := uc.listUnreconciled(ctx, userID, account.ID)
+	if err != nil {
+		return nil, err
+	}
+	if entity.ClearedBalance(account.Balance, transactions).Cmp(reconciliation.StatementBalance) != 0 {
+		return nil, errors.ErrConflict
+	}
+
+	var clearedIDs []string
+	for _, transaction := range transactions {
+		if transaction.Cleared {
+			clearedIDs = append(clearedIDs, transaction.ID)
+		}
+	}
+	now := time.Now().UTC()
+	reconciliation.Status = entity.ReconciliationStatusCompleted
+	reconciliation.TransactionIDs = clearedIDs
+	reconciliation.CompletedAt = &now
+	reconciliation.UpdatedAt = now
+	err = uc.unitOfWork.Do(ctx, func(txCtx context.Context) error {
+		if len(clearedIDs) > 0 {
+			if err := uc.transactionRepo.MarkReconciled(txCtx, userID, clearedIDs, reconciliation.ID); err != nil {
+				return err
+			}
+		}
+		return uc.reconciliationRepo.Update(txCtx, reconciliation)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toReconciliationSummary(reconciliation), nil
+}
+
+// CancelReconciliation descarta a conciliação aberta; as marcações de conferida são mantidas para a próxima
+func (uc *ReconciliationUseCase) CancelReconciliation(ctx context.Context, userID string, reconciliationID string) error {
+	if _, _, err := uc.loadOpen(ctx, userID, reconciliationID); err != nil {
+		return err
+	}
+	return uc.reconciliationRepo.Delete(ctx, reconciliationID, userID)
+}
+
+func (uc *ReconciliationUseCase) load(ctx context.Context, userID string, reconciliationID string) (*entity.Reconciliation, *entity.Account, error) {
+	reconciliation, err := uc.reconciliationRepo.GetByID(ctx, reconciliationID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if reconciliation == nil {
+		return nil, nil, errors.ErrNotFound
+	}
+	account, err := uc.accountRepo.GetByID(ctx, reconciliation.AccountID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if account == nil {
+		return nil, nil, errors.ErrNotFound
+	}
+	return reconciliation, account, nil
+}
+
+func (uc *ReconciliationUseCase) loadOpen(ctx context.Context, userID string, reconciliationID string) (*entity.Reconciliation, *entity.Account, error) {
+	reconciliation, account, err := uc.load(ctx, userID, reconciliationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if reconciliation.Status != entity.ReconciliationStatusOpen {
+		return nil, nil, errors.ErrConflict
+	}
+	return reconciliation, account, nil
+}
+
+// listUnreconciled lista as transações ainda não conciliadas da conta com o tipo dos registros antigos resolvido,
+// para que o saldo conferido desfaça cada uma com o sinal certo
+func (uc *ReconciliationUseCase) listUnreconciled(ctx context.Context, userID string, accountID string) ([]*entity.Transaction, error) {
+	transactions, err := uc.transactionRepo.ListUnreconciled(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := resolveTransactionTypes(ctx, uc.categoryRepo, transactions); err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
+
+// isReconciliationCandidate aceita transações não anuladas e não conciliadas da conta até a data do extrato
+func isReconciliationCandidate(reconciliation *entity.Reconciliation, transaction *entity.Transaction) bool {
+	return transaction.AccountID == reconciliation.AccountID &&
+		transaction.Status != entity.TransactionStatusVoided &&
+		!transaction.IsReconciled() &&
+		!transaction.OccurredAt.After(reconciliation.StatementDate)
+}
+
+// toResponse calcula o saldo conferido e lista as candidatas de uma conciliação aberta
+func (uc *ReconciliationUseCase) toResponse(ctx context.Context, account *entity.Account, reconciliation *entity.Reconciliation) (*dto.ReconciliationResponse, error) {
+	response := toReconciliationSummary(reconciliation)
+	if reconciliation.Status != entity.ReconciliationStatusOpen {
+		return response, nil
+	}
+
+	transactions, err := uc.listUnreconciled(ctx, reconciliation.UserID, account.ID)
+	if err != nil {
+		return nil, err
+	}
+	response.ClearedBalance = entity.ClearedBalance(account.Balance, transactions)
+	response.Difference = reconciliation.StatementBalance.Sub(response.ClearedBalance)
+	response.Transactions = []dto.ReconciliationTransaction{}
+	for _, transaction := range transactions {
+		if !isReconciliationCandidate(reconciliation, transaction) {
+			continue
+		}
+		response.Transactions = append(response.Transactions, dto.ReconciliationTransaction{
+			ID:          transaction.ID,
+			Type:        string(transaction.Type),
+			Amount:      transaction.Amount,
+			Description: transaction.Description,
+			OccurredAt:  transaction.OccurredAt,
+			Cleared:     transaction.Cleared,
+		})
+	}
+	return response, nil
+}
+
+// toReconciliationSummary monta a resposta sem as candidatas; numa conciliação concluída o saldo conferido é o do extrato
+func toReconciliationSummary(reconciliation *entity.Reconciliation) *dto.ReconciliationResponse {
+	return &dto.ReconciliationResponse{
+		ID:               reconciliation.ID,
+		AccountID:        reconciliation.AccountID,
+		Status:           string(reconciliation.Status),
+		StatementDate:    reconciliation.StatementDate,
+		StatementBalance: reconciliation.StatementBalance,
+		ClearedBalance:   reconciliation.StatementBalance,
+		TransactionIDs:   reconciliation.TransactionIDs,
+		CreatedAt:        reconciliation.CreatedAt,
+		CompletedAt:      reconciliation.CompletedAt,
+	}
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+func newReconciliationFixture(t *testing.T) (*ReconciliationUseCase, *TransactionUseCase, *transactionRepositoryStub) {
+	t.Helper()
+	// Saldo de abertura 1000 mais as transações abaixo
+	f := newTransactionFixture().withAccounts(
+		&entity.Account{ID: "checking", UserID: "user", Type: entity.AccountTypeChecking, Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(1270)},
+	).withCategories(
+		&entity.Category{ID: "cat", Type: entity.CategoryTypeExpense},
+		&entity.Category{ID: "salary", Type: entity.CategoryTypeIncome},
+	).withTransactions("checking",
+		// Registro antigo, sem tipo: é uma receita pela categoria
+		&entity.Transaction{ID: "salary", CategoryID: "salary", Amount: entity.MoneyFromInt(500), OccurredAt: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)},
+		&entity.Transaction{ID: "market", CategoryID: "cat", Type: entity.TransactionTypeExpense, Amount: entity.MoneyFromInt(120), OccurredAt: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+		&entity.Transaction{ID: "check", CategoryID: "cat", Type: entity.TransactionTypeExpense, Amount: entity.MoneyFromInt(80), OccurredAt: time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)},
+		&entity.Transaction{ID: "february", CategoryID: "cat", Type: entity.TransactionTypeExpense, Amount: entity.MoneyFromInt(30), OccurredAt: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)},
+	)
+	return NewReconciliationUseCase(newReconciliationRepositoryStub(), f.accountRepo, f.txRepo, f.categoryRepo, f.unitOfWork), f.transactions(), f.txRepo
+}
+
+// TestReconciliationUseCaseFlow garante o cálculo da diferença, a conclusão e a trava das transações conciliadas
+func TestReconciliationUseCaseFlow(t *testing.T) {
+	uc, transactions, txRepo := newReconciliationFixture(t)
+	ctx := context.Background()
+
+	started, err := uc.StartReconciliation(ctx, "user", "checking", dto.StartReconciliationRequest{
+		StatementDate:    time.Date(2024, 1, 31, 23, 59, 59, 0, time.UTC),
+		StatementBalance: entity.MoneyFromInt(1380),
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if started.ClearedBalance.Cmp(entity.MoneyFromInt(1000)) != 0 || started.Difference.Cmp(entity.MoneyFromInt(380)) != 0 {
+		t.Fatalf("saldo conferido inesperado: %s (diferença %s)", started.ClearedBalance, started.Difference)
+	}
+	if len(started.Transactions) != 3 {
+		t.Fatalf("esperava 3 candidatas até a data do extrato, obtive %d", len(started.Transactions))
+	}
+	if _, err := uc.StartReconciliation(ctx, "user", "checking", dto.StartReconciliationRequest{StatementDate: time.Now()}); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("esperava ErrConflict com conciliação aberta, obtive %v", err)
+	}
+
+	if _, err := uc.ClearTransactions(ctx, "user", started.ID, dto.ClearTransactionsRequest{TransactionIDs: []string{"february"}}); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("transação depois do extrato não deveria ser aceita: %v", err)
+	}
+	if _, err := uc.CompleteReconciliation(ctx, "user", started.ID); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("esperava ErrConflict com diferença, obtive %v", err)
+	}
+
+	cleared, err := uc.ClearTransactions(ctx, "user", started.ID, dto.ClearTransactionsRequest{TransactionIDs: []string{"salary", "market"}})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if !cleared.Difference.IsZero() {
+		t.Fatalf("diferença deveria zerar, obtive %s", cleared.Difference)
+	}
+
+	completed, err := uc.CompleteReconciliation(ctx, "user", started.ID)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if completed.Status != string(entity.ReconciliationStatusCompleted) || len(completed.TransactionIDs) != 2 {
+		t.Fatalf("conclusão inesperada: %+v", completed)
+	}
+	if !txRepo.storage["salary"].IsReconciled() || txRepo.storage["check"].IsReconciled() {
+		t.Fatalf("só as transações conferidas deveriam ser travadas")
+	}
+
+	if err := transactions.VoidTransaction(ctx, "user", "salary", ""); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("transação conciliada não deveria ser anulada: %v", err)
+	}
+	amount := entity.MoneyFromInt(200)
+	if _, err := transactions.UpdateTransaction(ctx, "user", "market", dto.UpdateTransactionRequest{Amount: &amount}); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("valor de transação conciliada não deveria mudar: %v", err)
+	}
+	description := "Supermercado"
+	if _, err := transactions.UpdateTransaction(ctx, "user", "market", dto.UpdateTransactionRequest{Description: &description}); err != nil {
+		t.Fatalf("descrição de transação conciliada deveria ser editável: %v", err)
+	}
+}
@@ -0,0 +1,368 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+const defaultRecurringBatchSize = 100
+
+// maxRecurringFailures é o número de execuções seguidas com falha depois do qual a recorrência é pausada, para que
+// recorrências quebradas não ocupem o lote do agendador para sempre
+const maxRecurringFailures = 5
+
+type RecurringTransactionUseCase struct {
+	recurringRepo      repository.RecurringTransactionRepository
+	categoryRepo       repository.CategoryRepository
+	transactionUseCase *TransactionUseCase
+	batchSize          int64
+}
+
+func NewRecurringTransactionUseCase(recurringRepo repository.RecurringTransactionRepository, categoryRepo repository.CategoryRepository, transactionUseCase *TransactionUseCase, batchSize int) *RecurringTransactionUseCase {
+	if batchSize <= 0 {
+		batchSize = defaultRecurringBatchSize
+	}
+	return &RecurringTransactionUseCase{
+		recurringRepo:      recurringRepo,
+		categoryRepo:       categoryRepo,
+		transactionUseCase: transactionUseCase,
+		batchSize:          int64(batchSize),
+	}
+}
+
+// CreateRecurring valida conta e categoria já na criação, para que uma referência errada não apareça só quando o
+// agendador tentar lançar a primeira ocorrência
+func (uc *RecurringTransactionUseCase) CreateRecurring(ctx context.Context, userID string, request dto.CreateRecurringTransactionRequest) (*dto.RecurringTransactionResponse, error) {
+	if err := uc.ensureAccount(ctx, userID, request.AccountID); err != nil {
+		return nil, err
+	}
+	if err := uc.ensureCategory(ctx, userID, request.CategoryID); err != nil {
+		return nil, err
+	}
+
+	startDate := entity.RecurrenceDate(request.StartDate)
+	// Sem dia da semana/mês explícito a recorrência segue o dia da data inicial
+	rule := entity.RecurrenceRule{
+		Frequency:  entity.RecurrenceFrequency(request.Frequency),
+		Interval:   request.Interval,
+		Weekday:    startDate.Weekday(),
+		DayOfMonth: request.DayOfMonth,
+	}
+	if rule.Interval == 0 {
+		rule.Interval = 1
+	}
+	if request.Weekday != nil {
+		rule.Weekday = time.Weekday(*request.Weekday)
+	}
+	if rule.DayOfMonth == 0 {
+		rule.DayOfMonth = startDate.Day()
+	}
+	if !rule.IsValid() {
+		return nil, errors.ErrInvalidInput
+	}
+	if request.EndDate != nil && request.EndDate.Before(startDate) {
+		return nil, errors.ErrInvalidInput
+	}
+
+	now := time.Now().UTC()
+	recurring := &entity.RecurringTransaction{
+		ID:          uuid.NewString(),
+		UserID:      userID,
+		AccountID:   request.AccountID,
+		CategoryID:  request.CategoryID,
+		Amount:      request.Amount,
+		Currency:    entity.Currency(request.Currency),
+		Description: request.Description,
+		Tags:        request.Tags,
+		Rule:        rule,
+		StartDate:   startDate,
+		EndDate:     request.EndDate,
+		Status:      entity.RecurringStatusActive,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	recurring.ScheduleFrom(startDate)
+
+	if err := uc.recurringRepo.Create(ctx, recurring); err != nil {
+		return nil, err
+	}
+	return toRecurringTransactionResponse(recurring), nil
+}
+
+func (uc *RecurringTransactionUseCase) UpdateRecurring(ctx context.Context, userID string, recurringID string, request dto.UpdateRecurringTransactionRequest) (*dto.RecurringTransactionResponse, error) {
+	recurring, err := uc.getRecurring(ctx, userID, recurringID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.CategoryID != nil && *request.CategoryID != recurring.CategoryID {
+		if err := uc.ensureCategory(ctx, userID, *request.CategoryID); err != nil {
+			return nil, err
+		}
+		recurring.CategoryID = *request.CategoryID
+	}
+	if request.AccountID != nil && *request.AccountID != recurring.AccountID {
+		if err := uc.ensureAccount(ctx, userID, *request.AccountID); err != nil {
+			return nil, err
+		}
+		recurring.AccountID = *request.AccountID
+	}
+	if request.Amount != nil {
+		recurring.Amount = *request.Amount
+	}
+	if request.Currency != nil {
+		recurring.Currency = entity.Currency(*request.Currency)
+	}
+	if request.Description != nil {
+		recurring.Description = *request.Description
+	}
+	if request.Tags != nil {
+		recurring.Tags = request.Tags
+	}
+	if request.EndDate != nil {
+		if request.EndDate.Before(recurring.StartDate) {
+			return nil, errors.ErrInvalidInput
+		}
+		recurring.EndDate = request.EndDate
+		// Alterar a data final pode encerrar ou reabrir a recorrência; pausadas continuam pausadas
+		if recurring.Status != entity.RecurringStatusPaused {
+			recurring.Status = entity.RecurringStatusActive
+			recurring.ScheduleFrom(resumePoint(recurring))
+		}
+	}
+	recurring.UpdatedAt = time.Now().UTC()
+
+	if err := uc.recurringRepo.Update(ctx, recurring); err != nil {
+		return nil, err
+	}
+	return toRecurringTransactionResponse(recurring), nil
+}
+
+func (uc *RecurringTransactionUseCase) GetRecurring(ctx context.Context, userID string, recurringID string) (*dto.RecurringTransactionResponse, error) {
+	recurring, err := uc.getRecurring(ctx, userID, recurringID)
+	if err != nil {
+		return nil, err
+	}
+	return toRecurringTransactionResponse(recurring), nil
+}
+
+func (uc *RecurringTransactionUseCase) ListRecurring(ctx context.Context, userID string, limit int64, offset int64) ([]*dto.RecurringTransactionResponse, error) {
+	items, err := uc.recurringRepo.List(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.RecurringTransactionResponse, 0, len(items))
+	for _, recurring := range items {
+		response = append(response, toRecurringTransactionResponse(recurring))
+	}
+	return response, nil
+}
+
+func (uc *RecurringTransactionUseCase) DeleteRecurring(ctx context.Context, userID string, recurringID string) error {
+	return uc.recurringRepo.Delete(ctx, recurringID, userID)
+}
+
+// PauseRecurring suspende a geração de ocorrências até ResumeRecurring
+func (uc *RecurringTransactionUseCase) PauseRecurring(ctx context.Context, userID string, recurringID string) (*dto.RecurringTransactionResponse, error) {
+	recurring, err := uc.getRecurring(ctx, userID, recurringID)
+	if err != nil {
+		return nil, err
+	}
+	if recurring.Status != entity.RecurringStatusActive {
+		return nil, errors.ErrConflict
+	}
+
+	recurring.Status = entity.RecurringStatusPaused
+	recurring.UpdatedAt = time.Now().UTC()
+	if err := uc.recurringRepo.Update(ctx, recurring); err != nil {
+		return nil, err
+	}
+	return toRecurringTransactionResponse(recurring), nil
+}
+
+// ResumeRecurring reativa a recorrência a partir de hoje; ocorrências do período pausado não são geradas
+func (uc *RecurringTransactionUseCase) ResumeRecurring(ctx context.Context, userID string, recurringID string) (*dto.RecurringTransactionResponse, error) {
+	recurring, err := uc.getRecurring(ctx, userID, recurringID)
+	if err != nil {
+		return nil, err
+	}
+	if recurring.Status != entity.RecurringStatusPaused {
+		return nil, errors.ErrConflict
+	}
+
+	now := time.Now().UTC()
+	recurring.Status = entity.RecurringStatusActive
+	recurring.FailureCount = 0
+	from := resumePoint(recurring)
+	if from.Before(now) {
+		from = now
+	}
+	recurring.ScheduleFrom(from)
+	recurring.UpdatedAt = now
+	if err := uc.recurringRepo.Update(ctx, recurring); err != nil {
+		return nil, err
+	}
+	return toRecurringTransactionResponse(recurring), nil
+}
+
+// SkipNextOccurrence pula a próxima ocorrência sem gerar a transação
+func (uc *RecurringTransactionUseCase) SkipNextOccurrence(ctx context.Context, userID string, recurringID string) (*dto.RecurringTransactionResponse, error) {
+	recurring, err := uc.getRecurring(ctx, userID, recurringID)
+	if err != nil {
+		return nil, err
+	}
+	if recurring.Status != entity.RecurringStatusActive {
+		return nil, errors.ErrConflict
+	}
+
+	recurring.ScheduleFrom(recurring.NextOccurrence.AddDate(0, 0, 1))
+	recurring.UpdatedAt = time.Now().UTC()
+	if err := uc.recurringRepo.Update(ctx, recurring); err != nil {
+		return nil, err
+	}
+	return toRecurringTransactionResponse(recurring), nil
+}
+
+// MaterializeDue grava as ocorrências vencidas até now via RecordTransaction. Cada ocorrência usa uma
+// ExternalRef determinística, então execuções repetidas ou concorrentes não duplicam transações.
+// Falhas ficam em LastError e a ocorrência é tentada de novo na próxima execução; conta ou categoria que deixaram de
+// valer pausam a recorrência na hora e as demais falhas a pausam depois de maxRecurringFailures execuções seguidas.
+func (uc *RecurringTransactionUseCase) MaterializeDue(ctx context.Context, now time.Time) (int, error) {
+	due, err := uc.recurringRepo.ListDue(ctx, now, uc.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	recorded := 0
+	for _, recurring := range due {
+		for recurring.Status == entity.RecurringStatusActive && !recurring.NextOccurrence.After(now) {
+			occurrence := recurring.NextOccurrence
+			_, err := uc.transactionUseCase.RecordTransaction(ctx, recurring.UserID, dto.CreateTransactionRequest{
+				AccountID:   recurring.AccountID,
+				CategoryID:  recurring.CategoryID,
+				Amount:      recurring.Amount,
+				Currency:    recurring.Currency.String(),
+				Description: recurring.Description,
+				OccurredAt:  occurrence,
+				Tags:        recurring.Tags,
+				ExternalRef: recurring.ExternalRef(occurrence),
+				// A ExternalRef já garante uma transação por ocorrência; ocorrências próximas com o mesmo valor não são duplicatas
+				OnDuplicate: string(entity.DuplicatePolicyAllow),
+			})
+			// Conta encerrada não recebe mais ocorrências, e conta ou categoria removida ou inválida não vai passar a
+			// valer sozinha: a recorrência é pausada até o usuário corrigir e reativar
+			if errors.Is(err, errors.ErrAccountClosed) || errors.Is(err, errors.ErrNotFound) || errors.Is(err, errors.ErrInvalidInput) {
+				recurring.Status = entity.RecurringStatusPaused
+				recurring.LastError = err.Error()
+				break
+			}
+			// ErrConflict indica que outra instância gravou a mesma ocorrência ao mesmo tempo
+			if err != nil && !errors.Is(err, errors.ErrConflict) {
+				recurring.LastError = err.Error()
+				recurring.FailureCount++
+				if recurring.FailureCount >= maxRecurringFailures {
+					recurring.Status = entity.RecurringStatusPaused
+				}
+				break
+			}
+
+			recorded++
+			recurring.LastOccurrence = &occurrence
+			recurring.LastError = ""
+			recurring.FailureCount = 0
+			recurring.ScheduleFrom(occurrence.AddDate(0, 0, 1))
+		}
+
+		recurring.UpdatedAt = time.Now().UTC()
+		if err := uc.recurringRepo.Update(ctx, recurring); err != nil {
+			return recorded, err
+		}
+	}
+	return recorded, nil
+}
+
+func (uc *RecurringTransactionUseCase) getRecurring(ctx context.Context, userID string, recurringID string) (*entity.RecurringTransaction, error) {
+	recurring, err := uc.recurringRepo.GetByID(ctx, recurringID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if recurring == nil {
+		return nil, errors.ErrNotFound
+	}
+	return recurring, nil
+}
+
+// ensureAccount exige que a conta exista, seja do usuário e esteja aberta
+func (uc *RecurringTransactionUseCase) ensureAccount(ctx context.Context, userID string, accountID string) error {
+	account, err := uc.transactionUseCase.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return errors.ErrInvalidInput
+	}
+	if account.IsClosed() {
+		return errors.ErrAccountClosed
+	}
+	return nil
+}
+
+// ensureCategory exige que a categoria exista e seja do usuário
+func (uc *RecurringTransactionUseCase) ensureCategory(ctx context.Context, userID string, categoryID string) error {
+	category, err := uc.categoryRepo.GetByID(ctx, categoryID, userID)
+	if err != nil {
+		return err
+	}
+	if category == nil {
+		return errors.ErrInvalidInput
+	}
+	return nil
+}
+
+// resumePoint é o primeiro dia ainda não atendido pela recorrência
+func resumePoint(recurring *entity.RecurringTransaction) time.Time {
+	if recurring.LastOccurrence != nil {
+		return recurring.LastOccurrence.AddDate(0, 0, 1)
+	}
+	return recurring.StartDate
+}
+
+func toRecurringTransactionResponse(recurring *entity.RecurringTransaction) *dto.RecurringTransactionResponse {
+	response := &dto.RecurringTransactionResponse{
+		ID:             recurring.ID,
+		AccountID:      recurring.AccountID,
+		CategoryID:     recurring.CategoryID,
+		Amount:         recurring.Amount,
+		Currency:       recurring.Currency.String(),
+		Description:    recurring.Description,
+		Tags:           recurring.Tags,
+		Frequency:      string(recurring.Rule.Frequency),
+		Interval:       recurring.Rule.Interval,
+		StartDate:      recurring.StartDate,
+		EndDate:        recurring.EndDate,
+		LastOccurrence: recurring.LastOccurrence,
+		Status:         string(recurring.Status),
+		LastError:      recurring.LastError,
+		FailureCount:   recurring.FailureCount,
+	}
+	switch recurring.Rule.Frequency {
+	case entity.RecurrenceWeekly:
+		weekday := int(recurring.Rule.Weekday)
+		response.Weekday = &weekday
+	case entity.RecurrenceMonthly:
+		response.DayOfMonth = recurring.Rule.DayOfMonth
+	}
+	if recurring.Status != entity.RecurringStatusEnded {
+		next := recurring.NextOccurrence
+		response.NextOccurrence = &next
+	}
+	return response
+}
@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+func newRecurringTestUseCase() (*RecurringTransactionUseCase, *recurringRepositoryStub, *transactionRepositoryStub, *accountRepositoryStub) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["acc"] = &entity.Account{ID: "acc", UserID: "user", Currency: entity.CurrencyBRL}
+	accountRepo.storage["other-acc"] = &entity.Account{ID: "other-acc", UserID: "other", Currency: entity.CurrencyBRL}
+	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
+		"rent":       {ID: "rent", UserID: "user", Type: entity.CategoryTypeExpense},
+		"other-rent": {ID: "other-rent", UserID: "other", Type: entity.CategoryTypeExpense},
+	}}
+	transactions := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), newOutboxRepositoryStub(), nil, nil, "queue", nil)
+	recurringRepo := newRecurringRepositoryStub()
+	return NewRecurringTransactionUseCase(recurringRepo, categoryRepo, transactions, 10), recurringRepo, txRepo, accountRepo
+}
+
+// TestRecurringMaterializeDueIdempotente garante que ocorrências vencidas são gravadas uma única vez
+func TestRecurringMaterializeDueIdempotente(t *testing.T) {
+	uc, recurringRepo, txRepo, accountRepo := newRecurringTestUseCase()
+	ctx := context.Background()
+
+	created, err := uc.CreateRecurring(ctx, "user", dto.CreateRecurringTransactionRequest{
+		AccountID:  "acc",
+		CategoryID: "rent",
+		Amount:     entity.MoneyFromInt(1500),
+		Currency:   "BRL",
+		Frequency:  string(entity.RecurrenceMonthly),
+		DayOfMonth: 31,
+		StartDate:  time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if created.NextOccurrence == nil || !created.NextOccurrence.Equal(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("primeira ocorrência inesperada: %v", created.NextOccurrence)
+	}
+
+	now := time.Date(2024, 3, 5, 8, 0, 0, 0, time.UTC)
+	recorded, err := uc.MaterializeDue(ctx, now)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if recorded != 2 || len(txRepo.created) != 2 {
+		t.Fatalf("esperava ocorrências de janeiro e fevereiro, obteve %d", recorded)
+	}
+	if !txRepo.created[1].OccurredAt.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("fevereiro deveria cair no último dia do mês, obteve %v", txRepo.created[1].OccurredAt)
+	}
+	if accountRepo.storage["acc"].Balance.Cmp(entity.MoneyFromInt(-3000)) != 0 {
+		t.Fatalf("saldo inesperado: %s", accountRepo.storage["acc"].Balance)
+	}
+
+	// Simula uma execução concorrente que ainda vê a ocorrência de fevereiro como pendente
+	recurring := recurringRepo.storage[created.ID]
+	recurring.NextOccurrence = time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+	if _, err := uc.MaterializeDue(ctx, now); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(txRepo.created) != 2 {
+		t.Fatalf("ocorrência repetida não deveria gerar nova transação, total %d", len(txRepo.created))
+	}
+	if !recurring.NextOccurrence.Equal(time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("próxima ocorrência inesperada: %v", recurring.NextOccurrence)
+	}
+}
+
+// TestRecurringSkipPauseEDataFinal garante pular ocorrência, pausar sem gerar transações e encerrar na data final
+func TestRecurringSkipPauseEDataFinal(t *testing.T) {
+	uc, recurringRepo, txRepo, _ := newRecurringTestUseCase()
+	ctx := context.Background()
+	endDate := time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	created, err := uc.CreateRecurring(ctx, "user", dto.CreateRecurringTransactionRequest{
+		AccountID:  "acc",
+		CategoryID: "rent",
+		Amount:     entity.MoneyFromInt(30),
+		Currency:   "BRL",
+		Frequency:  string(entity.RecurrenceWeekly),
+		StartDate:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:    &endDate,
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	skipped, err := uc.SkipNextOccurrence(ctx, "user", created.ID)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if !skipped.NextOccurrence.Equal(time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("após pular deveria ir para 08/01, obteve %v", skipped.NextOccurrence)
+	}
+
+	if _, err := uc.PauseRecurring(ctx, "user", created.ID); err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if recorded, _ := uc.MaterializeDue(ctx, time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC)); recorded != 0 {
+		t.Fatalf("recorrência pausada não deveria gerar transações, gerou %d", recorded)
+	}
+
+	recurringRepo.storage[created.ID].Status = entity.RecurringStatusActive
+	recorded, err := uc.MaterializeDue(ctx, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if recorded != 2 || len(txRepo.created) != 2 {
+		t.Fatalf("esperava ocorrências de 08/01 e 15/01 até a data final, obteve %d", recorded)
+	}
+	if recurringRepo.storage[created.ID].Status != entity.RecurringStatusEnded {
+		t.Fatalf("recorrência deveria estar encerrada após a data final, status %s", recurringRepo.storage[created.ID].Status)
+	}
+}
+
+// TestRecurringMaterializeDueContaEncerrada garante que conta encerrada pausa a recorrência sem contar ocorrências
+func TestRecurringMaterializeDueContaEncerrada(t *testing.T) {
+	uc, recurringRepo, txRepo, accountRepo := newRecurringTestUseCase()
+	ctx := context.Background()
+
+	created, err := uc.CreateRecurring(ctx, "user", dto.CreateRecurringTransactionRequest{
+		AccountID:  "acc",
+		CategoryID: "rent",
+		Amount:     entity.MoneyFromInt(1500),
+		Currency:   "BRL",
+		Frequency:  string(entity.RecurrenceMonthly),
+		DayOfMonth: 10,
+		StartDate:  time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	closedAt := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+	accountRepo.storage["acc"].ClosedAt = &closedAt
+
+	recorded, err := uc.MaterializeDue(ctx, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	recurring := recurringRepo.storage[created.ID]
+	if recorded != 0 || len(txRepo.created) != 0 || recurring.LastOccurrence != nil {
+		t.Fatalf("conta encerrada não deveria contar ocorrências: %d gravadas, última %v", recorded, recurring.LastOccurrence)
+	}
+	if recurring.Status != entity.RecurringStatusPaused || recurring.LastError == "" {
+		t.Fatalf("recorrência deveria ser pausada com o erro registrado: %s %q", recurring.Status, recurring.LastError)
+	}
+}
+
+// TestRecurringMaterializeDueFalhasPausam garante que recorrências que não conseguem lançar são pausadas e deixam de
+// ocupar o lote do agendador: referência removida pausa na hora, outras falhas depois de maxRecurringFailures execuções
+func TestRecurringMaterializeDueFalhasPausam(t *testing.T) {
+	uc, recurringRepo, txRepo, _ := newRecurringTestUseCase()
+	uc.batchSize = 1
+	ctx := context.Background()
+	request := func(startDay int) dto.CreateRecurringTransactionRequest {
+		return dto.CreateRecurringTransactionRequest{
+			AccountID:  "acc",
+			CategoryID: "rent",
+			Amount:     entity.MoneyFromInt(1500),
+			Currency:   "BRL",
+			Frequency:  string(entity.RecurrenceMonthly),
+			StartDate:  time.Date(2024, 1, startDay, 0, 0, 0, 0, time.UTC),
+		}
+	}
+	broken, err := uc.CreateRecurring(ctx, "user", request(1))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	healthy, err := uc.CreateRecurring(ctx, "user", request(10))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	// A categoria foi removida depois da criação
+	recurringRepo.storage[broken.ID].CategoryID = "removed"
+
+	now := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	if recorded, err := uc.MaterializeDue(ctx, now); err != nil || recorded != 0 {
+		t.Fatalf("a recorrência quebrada não deveria lançar: %d gravadas, erro %v", recorded, err)
+	}
+	if recurring := recurringRepo.storage[broken.ID]; recurring.Status != entity.RecurringStatusPaused || recurring.LastError == "" {
+		t.Fatalf("categoria removida deveria pausar a recorrência na hora: %s %q", recurring.Status, recurring.LastError)
+	}
+	if recorded, err := uc.MaterializeDue(ctx, now); err != nil || recorded != 1 {
+		t.Fatalf("a recorrência saudável deveria lançar na execução seguinte: %d gravadas, erro %v", recorded, err)
+	}
+
+	// Falha que pode ser passageira: a recorrência segue ativa até o limite de execuções seguidas
+	txRepo.createErr = errors.New("banco indisponível")
+	later := time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)
+	for run := 1; run <= maxRecurringFailures; run++ {
+		if _, err := uc.MaterializeDue(ctx, later); err != nil {
+			t.Fatalf("falha ao lançar não deveria interromper o agendador: %v", err)
+		}
+		recurring := recurringRepo.storage[healthy.ID]
+		if recurring.FailureCount != run || (run < maxRecurringFailures) != (recurring.Status == entity.RecurringStatusActive) {
+			t.Fatalf("execução %d: falhas %d, status %s", run, recurring.FailureCount, recurring.Status)
+		}
+	}
+
+	txRepo.createErr = nil
+	resumed, err := uc.ResumeRecurring(ctx, "user", healthy.ID)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if resumed.Status != string(entity.RecurringStatusActive) || resumed.FailureCount != 0 {
+		t.Fatalf("reativar deveria zerar as falhas: %+v", resumed)
+	}
+}
+
+// TestRecurringReferenciasInvalidas garante que conta e categoria inexistentes, de outro usuário ou conta encerrada
+// são recusadas já na criação e na alteração
+func TestRecurringReferenciasInvalidas(t *testing.T) {
+	uc, recurringRepo, _, accountRepo := newRecurringTestUseCase()
+	ctx := context.Background()
+	request := func(accountID string, categoryID string) dto.CreateRecurringTransactionRequest {
+		return dto.CreateRecurringTransactionRequest{
+			AccountID:  accountID,
+			CategoryID: categoryID,
+			Amount:     entity.MoneyFromInt(1500),
+			Currency:   "BRL",
+			Frequency:  string(entity.RecurrenceMonthly),
+			StartDate:  time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	cases := map[string]dto.CreateRecurringTransactionRequest{
+		"conta inexistente":          request("missing", "rent"),
+		"conta de outro usuário":     request("other-acc", "rent"),
+		"categoria inexistente":      request("acc", "missing"),
+		"categoria de outro usuário": request("acc", "other-rent"),
+	}
+	for name, tc := range cases {
+		if _, err := uc.CreateRecurring(ctx, "user", tc); !errors.Is(err, domainerrors.ErrInvalidInput) {
+			t.Fatalf("%s: esperava ErrInvalidInput, obteve %v", name, err)
+		}
+	}
+
+	created, err := uc.CreateRecurring(ctx, "user", request("acc", "rent"))
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	otherAccount, otherCategory := "other-acc", "other-rent"
+	if _, err := uc.UpdateRecurring(ctx, "user", created.ID, dto.UpdateRecurringTransactionRequest{AccountID: &otherAccount}); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("esperava ErrInvalidInput ao trocar para conta de outro usuário, obteve %v", err)
+	}
+	if _, err := uc.UpdateRecurring(ctx, "user", created.ID, dto.UpdateRecurringTransactionRequest{CategoryID: &otherCategory}); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("esperava ErrInvalidInput ao trocar para categoria de outro usuário, obteve %v", err)
+	}
+	if recurring := recurringRepo.storage[created.ID]; recurring.AccountID != "acc" || recurring.CategoryID != "rent" {
+		t.Fatalf("alteração recusada não deveria mudar a recorrência: %+v", recurring)
+	}
+
+	closedAt := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+	accountRepo.storage["acc"].ClosedAt = &closedAt
+	if _, err := uc.CreateRecurring(ctx, "user", request("acc", "rent")); !errors.Is(err, domainerrors.ErrAccountClosed) {
+		t.Fatalf("esperava ErrAccountClosed para conta encerrada, obteve %v", err)
+	}
+}
@@ -5,24 +5,34 @@ import (
 	"time"
 
 	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
 	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
 )
 
 type ReportUseCase struct {
-	reportRepo repository.ReportRepository
+	reportRepo    repository.ReportRepository
+	exchangeRates *ExchangeRateUseCase
 }
 
-func NewReportUseCase(reportRepo repository.ReportRepository) *ReportUseCase {
-	return &ReportUseCase{reportRepo: reportRepo}
+func NewReportUseCase(reportRepo repository.ReportRepository, exchangeRates *ExchangeRateUseCase) *ReportUseCase {
+	return &ReportUseCase{reportRepo: reportRepo, exchangeRates: exchangeRates}
 }
 
-func (uc *ReportUseCase) GetSummary(ctx context.Context, userID string, from, to time.Time) (*dto.SummaryReportResponse, error) {
-	report, err := uc.reportRepo.AggregateSummary(ctx, userID, from, to)
+// GetSummary monta o resumo do período convertendo cada transação para a moeda padrão do usuário
+// com a cotação da data em que ocorreu
+func (uc *ReportUseCase) GetSummary(ctx context.Context, userID string, currency string, from, to time.Time) (*dto.SummaryReportResponse, error) {
+	data, err := uc.reportRepo.LoadSummaryData(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := uc.aggregate(ctx, data, reportCurrency(currency))
 	if err != nil {
 		return nil, err
 	}
 
 	return &dto.SummaryReportResponse{
+		Currency:           report.Currency.String(),
 		TotalIncome:        report.TotalIncome,
 		TotalExpense:       report.TotalExpense,
 		NetBalance:         report.NetBalance,
@@ -31,3 +41,69 @@ func (uc *ReportUseCase) GetSummary(ctx context.Context, userID string, from, to
 		GoalProgress:       report.GoalProgress,
 	}, nil
 }
+
+func (uc *ReportUseCase) aggregate(ctx context.Context, data *entity.SummaryData, currency entity.Currency) (*entity.SummaryReport, error) {
+	report := &entity.SummaryReport{
+		Currency:           currency,
+		SpendingByCategory: map[string]entity.Money{},
+		BudgetUsage:        map[string]float64{},
+		GoalProgress:       map[string]float64{},
+	}
+
+	for _, transaction := range data.Transactions {
+		// Transações rateadas contam cada linha na sua própria categoria
+		for _, split := range transaction.CategoryAmounts() {
+			amount, err := uc.exchangeRates.Convert(ctx, split.Amount, transaction.Currency, currency, transaction.OccurredAt)
+			if err != nil {
+				return nil, err
+			}
+
+			category := data.Categories[split.CategoryID]
+			if category.Type == entity.CategoryTypeIncome {
+				report.TotalIncome = report.TotalIncome.Add(amount)
+			} else {
+				report.TotalExpense = report.TotalExpense.Add(amount)
+				name := category.Name
+				if name == "" {
+					name = split.CategoryID
+				}
+				report.SpendingByCategory[name] = report.SpendingByCategory[name].Add(amount)
+			}
+		}
+	}
+	report.NetBalance = report.TotalIncome.Sub(report.TotalExpense)
+
+	// Uso de orçamento e progresso de metas são proporções na moeda do próprio documento
+	for _, budget := range data.Budgets {
+		if budget.Amount.IsZero() {
+			continue
+		}
+		category := data.Categories[budget.CategoryID]
+		name := category.Name
+		if name == "" {
+			name = budget.CategoryID
+		}
+		report.BudgetUsage[name] = budget.Spent.Ratio(budget.Amount) * 100
+	}
+
+	for _, goal := range data.Goals {
+		if goal.TargetAmount.IsZero() {
+			continue
+		}
+		name := goal.Name
+		if name == "" {
+			name = goal.ID
+		}
+		report.GoalProgress[name] = goal.CurrentAmount.Ratio(goal.TargetAmount) * 100
+	}
+
+	return report, nil
+}
+
+// reportCurrency usa a moeda padrão do usuário e, na falta dela, a primeira moeda habilitada
+func reportCurrency(currency string) entity.Currency {
+	if currency != "" {
+		return entity.Currency(currency)
+	}
+	return entity.EnabledCurrencies()[0]
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+)
+
+// TestReportSummaryConverteParaMoedaPadrao garante que transações em moedas diferentes são somadas na moeda do usuário
+func TestReportSummaryConverteParaMoedaPadrao(t *testing.T) {
+	january := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	february := time.Date(2024, 2, 15, 12, 0, 0, 0, time.UTC)
+	rates := &exchangeRateRepositoryStub{}
+	rates.add(entity.CurrencyUSD, entity.CurrencyBRL, 5.0, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	rates.add(entity.CurrencyUSD, entity.CurrencyBRL, 6.0, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	reports := &reportRepositoryStub{data: &entity.SummaryData{
+		Transactions: []*entity.Transaction{
+			{CategoryID: "salary", Amount: entity.MoneyFromInt(1000), Currency: entity.CurrencyUSD, OccurredAt: january},
+			{CategoryID: "food", Amount: entity.MoneyFromInt(100), Currency: entity.CurrencyUSD, OccurredAt: february},
+			{CategoryID: "food", Amount: entity.MoneyFromInt(200), Currency: entity.CurrencyBRL, OccurredAt: february},
+		},
+		Categories: map[string]entity.Category{
+			"salary": {ID: "salary", Name: "Salário", Type: entity.CategoryTypeIncome},
+			"food":   {ID: "food", Name: "Alimentação", Type: entity.CategoryTypeExpense},
+		},
+	}}
+
+	uc := NewReportUseCase(reports, NewExchangeRateUseCase(rates, nil))
+	summary, err := uc.GetSummary(context.Background(), "user-1", "BRL", january, february)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if summary.Currency != "BRL" {
+		t.Fatalf("esperava resumo em BRL, obteve %s", summary.Currency)
+	}
+	if summary.TotalIncome.Cmp(entity.MoneyFromInt(5000)) != 0 {
+		t.Fatalf("receita deveria usar a cotação de janeiro, obteve %s", summary.TotalIncome)
+	}
+	if summary.TotalExpense.Cmp(entity.MoneyFromInt(800)) != 0 {
+		t.Fatalf("despesa deveria somar 600 + 200, obteve %s", summary.TotalExpense)
+	}
+	if summary.NetBalance.Cmp(entity.MoneyFromInt(4200)) != 0 {
+		t.Fatalf("saldo inesperado: %s", summary.NetBalance)
+	}
+	if summary.SpendingByCategory["Alimentação"].Cmp(entity.MoneyFromInt(800)) != 0 {
+		t.Fatalf("gasto por categoria inesperado: %v", summary.SpendingByCategory)
+	}
+}
@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
+)
+
+const (
+	// interestCategoryName é a categoria de receita do sistema em que os juros da poupança são lançados; é criada
+	// para o usuário no primeiro lançamento
+	interestCategoryName = "Interest"
+
+	defaultSavingsProjectionMonths = 12
+	maxSavingsProjectionMonths     = 600
+)
+
+// SavingsUseCase cuida do rendimento das contas poupança: a rotina de juros lança, a cada mês fechado, os juros
+// apurados sobre o saldo diário como uma receita comum, e a projeção estima o saldo futuro pela taxa atual
+type SavingsUseCase struct {
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	categoryRepo    repository.CategoryRepository
+	transactions    *TransactionUseCase
+	batchSize       int64
+	now             func() time.Time
+}
+
+func NewSavingsUseCase(accountRepo repository.AccountRepository, transactionRepo repository.TransactionRepository, categoryRepo repository.CategoryRepository, transactions *TransactionUseCase, batchSize int) *SavingsUseCase {
+	if batchSize <= 0 {
+		batchSize = 200
+	}
+	return &SavingsUseCase{
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+		transactions:    transactions,
+		batchSize:       int64(batchSize),
+		now:             func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// AccrueInterest lança os juros dos meses fechados até now em todas as contas poupança com rendimento. Cada
+// lançamento usa uma ExternalRef determinística, então execuções repetidas ou concorrentes não duplicam juros.
+// Contas encerradas deixam de render. A falha em uma conta não impede as demais: as contas com falha são
+// tentadas de novo na próxima execução e o erro devolvido cita a primeira delas
+func (uc *SavingsUseCase) AccrueInterest(ctx context.Context, now time.Time) (int, error) {
+	today := entity.StartOfDay(now)
+	categories := map[string]string{}
+	posted := 0
+	failed := 0
+	var firstErr error
+	afterID := ""
+	for {
+		accounts, err := uc.accountRepo.ListAll(ctx, afterID, uc.batchSize)
+		if err != nil {
+			return posted, err
+		}
+		for _, account := range accounts {
+			if account.Type != entity.AccountTypeSavings || account.Savings == nil || account.IsClosed() {
+				continue
+			}
+			count, err := uc.accrue(ctx, account, today, categories)
+			posted += count
+			if err != nil {
+				failed++
+				if firstErr == nil {
+					firstErr = fmt.Errorf("account %s: %w", account.ID, err)
+				}
+			}
+		}
+		if int64(len(accounts)) < uc.batchSize {
+			break
+		}
+		afterID = accounts[len(accounts)-1].ID
+	}
+	if failed > 0 {
+		return posted, fmt.Errorf("savings interest failed for %d account(s), first %w", failed, firstErr)
+	}
+	return posted, nil
+}
+
+// accrue lança os juros de cada período fechado da conta, um de cada vez: os juros de um mês entram no saldo
+// do mês seguinte, então saldo e transações são relidos a cada período
+func (uc *SavingsUseCase) accrue(ctx context.Context, account *entity.Account, today time.Time, categories map[string]string) (int, error) {
+	posted := 0
+	for account.Savings != nil && !entity.InterestPeriodEnd(account.Savings.AccruedThrough).After(today) {
+		savings := *account.Savings
+		start := savings.AccruedThrough
+		end := entity.InterestPeriodEnd(start)
+
+		later, err := uc.transactionRepo.List(ctx, account.UserID, repository.TransactionFilter{
+			AccountIDs: []string{account.ID},
+			From:       start,
+		}, 0, 0)
+		if err != nil {
+			return posted, err
+		}
+		if err := resolveTransactionTypes(ctx, uc.categoryRepo, later); err != nil {
+			return posted, err
+		}
+		var closing []entity.Money
+		for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+			closing = append(closing, entity.BalanceBefore(account.Balance, later, day.AddDate(0, 0, 1)))
+		}
+
+		interest := savings.PeriodInterest(start, closing, account.Currency.MinorUnits())
+		if interest.IsPositive() {
+			categoryID, err := uc.interestCategory(ctx, account.UserID, categories)
+			if err != nil {
+				return posted, err
+			}
+			_, err = uc.transactions.RecordTransaction(ctx, account.UserID, dto.CreateTransactionRequest{
+				AccountID:   account.ID,
+				CategoryID:  categoryID,
+				Amount:      interest,
+				Currency:    account.Currency.String(),
+				Description: "Juros " + start.Format("01/2006"),
+				OccurredAt:  end,
+				ExternalRef: entity.SavingsInterestRef(account.ID, start),
+				OnDuplicate: string(entity.DuplicatePolicyAllow),
+				SkipRules:   true,
+			})
+			// Conta encerrada durante a rotina deixa de render; as demais contas seguem
+			if errors.Is(err, errors.ErrAccountClosed) {
+				return posted, nil
+			}
+			// ErrConflict indica que outra instância lançou os mesmos juros ao mesmo tempo
+			if err != nil && !errors.Is(err, errors.ErrConflict) {
+				return posted, err
+			}
+			posted++
+		}
+
+		// Só o início dos juros pendentes é gravado: encerramento ou mudança de taxa feitos durante a rotina não
+		// são sobrescritos com a conta lida no início. ErrConflict indica que outra instância já avançou o período
+		err = uc.accountRepo.AdvanceSavingsAccrual(ctx, account.ID, account.UserID, start, end)
+		if errors.Is(err, errors.ErrConflict) {
+			return posted, nil
+		}
+		if err != nil {
+			return posted, err
+		}
+		refreshed, err := uc.accountRepo.GetByID(ctx, account.ID, account.UserID)
+		if err != nil {
+			return posted, err
+		}
+		if refreshed == nil || refreshed.IsClosed() {
+			return posted, nil
+		}
+		account = refreshed
+	}
+	return posted, nil
+}
+
+// interestCategory devolve a categoria de receita "Interest" do usuário, criando-a quando ainda não existe
+func (uc *SavingsUseCase) interestCategory(ctx context.Context, userID string, cache map[string]string) (string, error) {
+	if id, ok := cache[userID]; ok {
+		return id, nil
+	}
+	categories, err := uc.categoryRepo.List(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	for _, category := range categories {
+		if category.Type == entity.CategoryTypeIncome && strings.EqualFold(category.Name, interestCategoryName) {
+			cache[userID] = category.ID
+			return category.ID, nil
+		}
+	}
+
+	now := uc.now()
+	category := &entity.Category{
+		ID:          uuid.NewString(),
+		UserID:      userID,
+		Name:        interestCategoryName,
+		Type:        entity.CategoryTypeIncome,
+		Description: "Juros das contas poupança, lançados automaticamente",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := uc.categoryRepo.Create(ctx, category); err != nil {
+		return "", err
+	}
+	cache[userID] = category.ID
+	return category.ID, nil
+}
+
+// Project projeta o saldo da poupança pelos próximos months lançamentos de juros (padrão 12), supondo o saldo
+// atual constante e a taxa atual
+func (uc *SavingsUseCase) Project(ctx context.Context, userID string, accountID string, months int) (*dto.SavingsProjectionResponse, error) {
+	if months == 0 {
+		months = defaultSavingsProjectionMonths
+	}
+	if months < 1 || months > maxSavingsProjectionMonths {
+		return nil, errors.ErrInvalidInput
+	}
+	account, err := uc.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, errors.ErrNotFound
+	}
+	if account.Type != entity.AccountTypeSavings || account.Savings == nil {
+		return nil, errors.ErrInvalidInput
+	}
+
+	rows := account.Savings.Project(account.Balance, months, account.Currency.MinorUnits())
+	response := &dto.SavingsProjectionResponse{
+		AccountID:           account.ID,
+		Currency:            account.Currency.String(),
+		AnnualRate:          account.Savings.AnnualRate,
+		Compounding:         string(account.Savings.Compounding),
+		EffectiveAnnualRate: roundRate(account.Savings.EffectiveAnnualRate()),
+		AccruedThrough:      account.Savings.AccruedThrough,
+		Balance:             account.Balance,
+		TotalInterest:       entity.ZeroMoney,
+		FinalBalance:        account.Balance,
+		Months:              make([]dto.SavingsProjectionRow, 0, len(rows)),
+	}
+	for _, row := range rows {
+		response.Months = append(response.Months, dto.SavingsProjectionRow{Date: row.Date, Interest: row.Interest, Balance: row.Balance})
+		response.TotalInterest = response.TotalInterest.Add(row.Interest)
+		response.FinalBalance = row.Balance
+	}
+	return response, nil
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+// newSavingsFixture cria uma poupança a 12% ao ano rendendo desde 16/04/2024, com um depósito de 2000 em 26/04
+func newSavingsFixture(t *testing.T) (*SavingsUseCase, *accountRepositoryStub, *transactionRepositoryStub, *categoryRepositoryStub) {
+	t.Helper()
+	savings := &entity.SavingsInterest{AnnualRate: 12, Compounding: entity.CompoundingMonthly, AccruedThrough: time.Date(2024, 4, 16, 0, 0, 0, 0, time.UTC)}
+	closedSavings := *savings
+	closedAt := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	f := newTransactionFixture().withAccounts(
+		&entity.Account{ID: "savings", UserID: "user", Type: entity.AccountTypeSavings, Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(10000), Savings: savings},
+		&entity.Account{ID: "closed", UserID: "user", Type: entity.AccountTypeSavings, Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(5000), Savings: &closedSavings, ClosedAt: &closedAt},
+		&entity.Account{ID: "checking", UserID: "user", Type: entity.AccountTypeChecking, Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(5000)},
+	).withCategories(
+		&entity.Category{ID: "salary", Name: "Salário", Type: entity.CategoryTypeIncome},
+	).withTransactions("savings",
+		&entity.Transaction{ID: "deposit", CategoryID: "salary", Type: entity.TransactionTypeIncome, Amount: entity.MoneyFromInt(2000), OccurredAt: time.Date(2024, 4, 26, 12, 0, 0, 0, time.UTC)},
+	)
+	return NewSavingsUseCase(f.accountRepo, f.txRepo, f.categoryRepo, f.transactions(), 2), f.accountRepo, f.txRepo, f.categoryRepo
+}
+
+// TestSavingsUseCaseAccrueInterest garante os juros sobre o saldo diário, a capitalização mês a mês e a idempotência
+func TestSavingsUseCaseAccrueInterest(t *testing.T) {
+	uc, accountRepo, txRepo, categoryRepo := newSavingsFixture(t)
+	ctx := context.Background()
+	now := time.Date(2024, 6, 10, 8, 0, 0, 0, time.UTC)
+
+	posted, err := uc.AccrueInterest(ctx, now)
+	if err != nil {
+		t.Fatalf("não esperava erro ao lançar juros: %v", err)
+	}
+	if posted != 2 {
+		t.Fatalf("esperava os juros de abril e maio, obtive %d lançamentos", posted)
+	}
+
+	// Abril: 10 dias com 8000 e 5 com 10000 a 1% ao mês de 30 dias; maio: 10043.33 o mês inteiro
+	april, _ := txRepo.GetByExternalRef(ctx, "user", entity.SavingsInterestRef("savings", time.Date(2024, 4, 16, 0, 0, 0, 0, time.UTC)))
+	may, _ := txRepo.GetByExternalRef(ctx, "user", entity.SavingsInterestRef("savings", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)))
+	if april == nil || may == nil || april.Amount.String() != "43.33" || may.Amount.String() != "100.43" {
+		t.Fatalf("juros inesperados: abril %+v, maio %+v", april, may)
+	}
+	if !april.OccurredAt.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) || april.Type != entity.TransactionTypeIncome {
+		t.Fatalf("juros de abril deveriam ser receita lançada em 01/05: %+v", april)
+	}
+	category := categoryRepo.categories[april.CategoryID]
+	if category == nil || category.Name != "Interest" || category.Type != entity.CategoryTypeIncome || may.CategoryID != april.CategoryID {
+		t.Fatalf("juros deveriam entrar na categoria de sistema Interest: %+v", category)
+	}
+
+	account := accountRepo.storage["savings"]
+	if account.Balance.String() != "10143.76" || !account.Savings.AccruedThrough.Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("saldo ou período inesperados: %s até %s", account.Balance, account.Savings.AccruedThrough)
+	}
+	if accountRepo.storage["closed"].Balance.String() != "5000.00" {
+		t.Fatalf("conta encerrada não deveria render")
+	}
+
+	posted, err = uc.AccrueInterest(ctx, now)
+	if err != nil || posted != 0 || accountRepo.storage["savings"].Balance.String() != "10143.76" {
+		t.Fatalf("rodar de novo não deveria lançar juros: %d lançamentos, erro %v", posted, err)
+	}
+}
+
+// savingsAccountRepositoryStub devolve cópias das contas em ListAll, como o Mongo, aplica change logo depois da
+// leitura, para simular uma alteração feita durante a rotina, e falha o avanço dos juros das contas em failing
+type savingsAccountRepositoryStub struct {
+	*accountRepositoryStub
+	change  func()
+	failing map[string]bool
+}
+
+func (s *savingsAccountRepositoryStub) ListAll(ctx context.Context, afterID string, limit int64) ([]*entity.Account, error) {
+	accounts, err := s.accountRepositoryStub.ListAll(ctx, afterID, limit)
+	copies := make([]*entity.Account, 0, len(accounts))
+	for _, account := range accounts {
+		copied := *account
+		copies = append(copies, &copied)
+	}
+	if s.change != nil {
+		s.change()
+		s.change = nil
+	}
+	return copies, err
+}
+
+func (s *savingsAccountRepositoryStub) AdvanceSavingsAccrual(ctx context.Context, id string, userID string, previous time.Time, next time.Time) error {
+	if s.failing[id] {
+		return errors.New("falha ao gravar")
+	}
+	return s.accountRepositoryStub.AdvanceSavingsAccrual(ctx, id, userID, previous, next)
+}
+
+// TestSavingsUseCaseAccrueInterestAlteracaoConcorrente garante que a rotina só avança o período dos juros, sem
+// sobrescrever a taxa alterada enquanto ela rodava, e que a falha em uma conta não impede as demais
+func TestSavingsUseCaseAccrueInterestAlteracaoConcorrente(t *testing.T) {
+	_, accountRepo, txRepo, categoryRepo := newSavingsFixture(t)
+	ctx := context.Background()
+	brokenSavings := *accountRepo.storage["savings"].Savings
+	accountRepo.Create(ctx, &entity.Account{ID: "a-broken", UserID: "user", Type: entity.AccountTypeSavings, Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(1000), Savings: &brokenSavings})
+	repo := &savingsAccountRepositoryStub{
+		accountRepositoryStub: accountRepo,
+		failing:               map[string]bool{"a-broken": true},
+		change: func() {
+			savings := *accountRepo.storage["savings"].Savings
+			savings.AnnualRate = 6
+			accountRepo.storage["savings"].Savings = &savings
+		},
+	}
+	transactions := NewTransactionUseCase(txRepo, repo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, nil, "", nil)
+	uc := NewSavingsUseCase(repo, txRepo, categoryRepo, transactions, 10)
+
+	posted, err := uc.AccrueInterest(ctx, time.Date(2024, 6, 10, 8, 0, 0, 0, time.UTC))
+	if err == nil || !strings.Contains(err.Error(), "a-broken") {
+		t.Fatalf("esperava o erro da conta com falha, obtive %v", err)
+	}
+	if posted != 3 {
+		t.Fatalf("as demais contas deveriam render apesar da falha: %d lançamentos", posted)
+	}
+	savings := accountRepo.storage["savings"].Savings
+	if savings.AnnualRate != 6 || !savings.AccruedThrough.Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("a taxa alterada durante a rotina deveria ser mantida: %+v", savings)
+	}
+	if !accountRepo.storage["a-broken"].Savings.AccruedThrough.Equal(time.Date(2024, 4, 16, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("conta com falha deveria continuar pendente: %+v", accountRepo.storage["a-broken"].Savings)
+	}
+}
+
+// TestSavingsUseCaseProjection garante a projeção pela taxa atual e as validações
+func TestSavingsUseCaseProjection(t *testing.T) {
+	uc, _, _, _ := newSavingsFixture(t)
+	ctx := context.Background()
+
+	projection, err := uc.Project(ctx, "user", "savings", 3)
+	if err != nil {
+		t.Fatalf("não esperava erro na projeção: %v", err)
+	}
+	if len(projection.Months) != 3 || !projection.Months[0].Date.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("meses inesperados: %+v", projection.Months)
+	}
+	if projection.Months[0].Interest.String() != "50.00" || projection.FinalBalance.String() != "10252.01" || projection.TotalInterest.String() != "252.01" {
+		t.Fatalf("projeção inesperada: %+v", projection)
+	}
+	if projection.EffectiveAnnualRate != 12.6825 {
+		t.Fatalf("taxa efetiva inesperada: %v", projection.EffectiveAnnualRate)
+	}
+
+	if defaults, _ := uc.Project(ctx, "user", "savings", 0); defaults == nil || len(defaults.Months) != 12 {
+		t.Fatalf("projeção padrão deveria ter 12 meses")
+	}
+	if _, err := uc.Project(ctx, "user", "savings", 601); err != domainerrors.ErrInvalidInput {
+		t.Fatalf("prazo acima do máximo deveria ser recusado, obtive %v", err)
+	}
+	if _, err := uc.Project(ctx, "user", "checking", 12); err != domainerrors.ErrInvalidInput {
+		t.Fatalf("conta sem rendimento deveria ser recusada, obtive %v", err)
+	}
+}
+
+// TestAccountUseCaseSavingsSettings garante que o rendimento só vale para poupanças
+func TestAccountUseCaseSavingsSettings(t *testing.T) {
+	uc := NewAccountUseCase(newAccountRepositoryStub(), nil, nil, nil, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	account, err := uc.CreateAccount(ctx, "user", dto.CreateAccountRequest{Name: "Poupança", Type: "savings", Currency: "BRL", Savings: &dto.SavingsSettings{AnnualRate: 6.17}})
+	if err != nil {
+		t.Fatalf("não esperava erro ao criar a poupança: %v", err)
+	}
+	if account.Savings == nil || account.Savings.Compounding != "monthly" {
+		t.Fatalf("capitalização padrão deveria ser mensal: %+v", account.Savings)
+	}
+	if _, err := uc.CreateAccount(ctx, "user", dto.CreateAccountRequest{Name: "Corrente", Type: "checking", Currency: "BRL", Savings: &dto.SavingsSettings{AnnualRate: 1}}); err != domainerrors.ErrInvalidInput {
+		t.Fatalf("rendimento em conta corrente deveria ser recusado, obtive %v", err)
+	}
+
+	checking := "checking"
+	updated, err := uc.UpdateAccount(ctx, "user", account.ID, dto.UpdateAccountRequest{Type: &checking})
+	if err != nil || updated.Savings != nil {
+		t.Fatalf("deixar de ser poupança deveria descartar o rendimento: %+v, erro %v", updated, err)
+	}
+}
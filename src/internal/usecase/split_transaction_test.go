@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
+	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+)
+
+func newSplitCategoryRepository() *categoryRepositoryStub {
+	return &categoryRepositoryStub{categories: map[string]*entity.Category{
+		"groceries": {ID: "groceries", Name: "Mercado", Type: entity.CategoryTypeExpense},
+		"household": {ID: "household", Name: "Casa", Type: entity.CategoryTypeExpense},
+		"pharmacy":  {ID: "pharmacy", Name: "Farmácia", Type: entity.CategoryTypeExpense},
+		"salary":    {ID: "salary", Name: "Salário", Type: entity.CategoryTypeIncome},
+	}}
+}
+
+// TestTransactionUseCaseRecordTransactionRateio garante que o rateio é gravado e enviado ao processador de orçamentos
+func TestTransactionUseCaseRecordTransactionRateio(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	outbox := newOutboxRepositoryStub()
+	uc := NewTransactionUseCase(txRepo, accountRepo, newSplitCategoryRepository(), nil, newUnitOfWorkStub(accountRepo, txRepo), outbox, nil, nil, "queue", nil)
+
+	response, err := uc.RecordTransaction(context.Background(), "user", dto.CreateTransactionRequest{
+		AccountID:  "acc",
+		Amount:     entity.MustParseMoney("150.00"),
+		Currency:   "BRL",
+		OccurredAt: time.Now(),
+		Splits: []dto.TransactionSplit{
+			{CategoryID: "groceries", Amount: entity.MustParseMoney("100.00")},
+			{CategoryID: "household", Amount: entity.MustParseMoney("30.00")},
+			{CategoryID: "pharmacy", Amount: entity.MustParseMoney("20.00"), Note: " vitaminas "},
+		},
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if response.CategoryID != "groceries" || len(response.Splits) != 3 || response.Splits[2].Note != "vitaminas" {
+		t.Fatalf("categoria principal deveria ser a da primeira linha: %+v", response)
+	}
+	if len(accountRepo.adjustments) != 1 || accountRepo.adjustments[0] != entity.MustParseMoney("-150.00") {
+		t.Fatalf("saldo deveria ser ajustado pelo total: %v", accountRepo.adjustments)
+	}
+
+	var payload struct {
+		Splits []dto.TransactionSplit `json:"splits"`
+	}
+	if err := json.Unmarshal(outbox.events[0].Payload, &payload); err != nil {
+		t.Fatalf("payload inválido: %v", err)
+	}
+	if len(payload.Splits) != 3 || payload.Splits[1].CategoryID != "household" {
+		t.Fatalf("evento deveria levar as linhas do rateio: %+v", payload.Splits)
+	}
+}
+
+// TestTransactionUseCaseRecordTransactionRateioInvalido garante que o rateio soma o total e não mistura receita e despesa
+func TestTransactionUseCaseRecordTransactionRateioInvalido(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	uc := NewTransactionUseCase(txRepo, accountRepo, newSplitCategoryRepository(), nil, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, nil, "queue", nil)
+
+	invalid := map[string][]dto.TransactionSplit{
+		"soma diferente": {
+			{CategoryID: "groceries", Amount: entity.MustParseMoney("100.00")},
+			{CategoryID: "household", Amount: entity.MustParseMoney("40.00")},
+		},
+		"tipos misturados": {
+			{CategoryID: "groceries", Amount: entity.MustParseMoney("100.00")},
+			{CategoryID: "salary", Amount: entity.MustParseMoney("50.00")},
+		},
+		"categoria inexistente": {
+			{CategoryID: "groceries", Amount: entity.MustParseMoney("100.00")},
+			{CategoryID: "unknown", Amount: entity.MustParseMoney("50.00")},
+		},
+	}
+	for name, splits := range invalid {
+		_, err := uc.RecordTransaction(context.Background(), "user", dto.CreateTransactionRequest{
+			AccountID:  "acc",
+			Amount:     entity.MustParseMoney("150.00"),
+			Currency:   "BRL",
+			OccurredAt: time.Now(),
+			Splits:     splits,
+		})
+		if !errors.Is(err, domainerrors.ErrInvalidInput) {
+			t.Errorf("%s: esperava ErrInvalidInput, obteve %v", name, err)
+		}
+	}
+	if len(txRepo.created) != 0 {
+		t.Fatalf("nenhuma transação deveria ser gravada")
+	}
+}
+
+// TestTransactionUseCaseUpdateTransactionRateio garante que alterar o valor exige um rateio que some o novo total
+func TestTransactionUseCaseUpdateTransactionRateio(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	outbox := newOutboxRepositoryStub()
+	// O stub devolve o próprio ponteiro gravado, então cada cenário parte de uma cópia nova
+	store := func() {
+		txRepo.storage["txn"] = &entity.Transaction{
+			ID: "txn", UserID: "user", AccountID: "acc", CategoryID: "groceries", Type: entity.TransactionTypeExpense,
+			Amount: entity.MoneyFromInt(150), Currency: entity.CurrencyBRL, Status: entity.TransactionStatusCompleted,
+			Splits: []entity.TransactionSplit{
+				{CategoryID: "groceries", Amount: entity.MoneyFromInt(100)},
+				{CategoryID: "pharmacy", Amount: entity.MoneyFromInt(50)},
+			},
+		}
+	}
+	store()
+	uc := NewTransactionUseCase(txRepo, accountRepo, newSplitCategoryRepository(), nil, newUnitOfWorkStub(accountRepo, txRepo), outbox, nil, nil, "queue", nil)
+	ctx := context.Background()
+
+	amount := entity.MoneyFromInt(200)
+	if _, err := uc.UpdateTransaction(ctx, "user", "txn", dto.UpdateTransactionRequest{Amount: &amount}); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("valor que não bate com o rateio deveria ser recusado, obteve %v", err)
+	}
+	store()
+
+	response, err := uc.UpdateTransaction(ctx, "user", "txn", dto.UpdateTransactionRequest{Splits: []dto.TransactionSplit{
+		{CategoryID: "groceries", Amount: entity.MoneyFromInt(120)},
+		{CategoryID: "household", Amount: entity.MoneyFromInt(30)},
+	}})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(response.Splits) != 2 || response.Splits[1].CategoryID != "household" {
+		t.Fatalf("rateio não foi substituído: %+v", response.Splits)
+	}
+	if len(outbox.events) != 2 {
+		t.Fatalf("mudança no rateio deveria estornar e reaplicar o orçamento, eventos: %d", len(outbox.events))
+	}
+
+	response, err = uc.UpdateTransaction(ctx, "user", "txn", dto.UpdateTransactionRequest{Splits: []dto.TransactionSplit{}})
+	if err != nil || len(response.Splits) != 0 {
+		t.Fatalf("lista vazia deveria remover o rateio: %+v (%v)", response, err)
+	}
+}
+
+// TestReportSummaryRateio garante que cada linha do rateio entra no gasto da sua categoria
+func TestReportSummaryRateio(t *testing.T) {
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	reports := &reportRepositoryStub{data: &entity.SummaryData{
+		Transactions: []*entity.Transaction{
+			{CategoryID: "groceries", Amount: entity.MoneyFromInt(150), Currency: entity.CurrencyBRL, OccurredAt: day, Splits: []entity.TransactionSplit{
+				{CategoryID: "groceries", Amount: entity.MoneyFromInt(100)},
+				{CategoryID: "pharmacy", Amount: entity.MoneyFromInt(50)},
+			}},
+			{CategoryID: "pharmacy", Amount: entity.MoneyFromInt(10), Currency: entity.CurrencyBRL, OccurredAt: day},
+		},
+		Categories: map[string]entity.Category{
+			"groceries": {ID: "groceries", Name: "Mercado", Type: entity.CategoryTypeExpense},
+			"pharmacy":  {ID: "pharmacy", Name: "Farmácia", Type: entity.CategoryTypeExpense},
+		},
+	}}
+
+	uc := NewReportUseCase(reports, NewExchangeRateUseCase(&exchangeRateRepositoryStub{}, nil))
+	summary, err := uc.GetSummary(context.Background(), "user", "BRL", day, day)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if summary.TotalExpense.Cmp(entity.MoneyFromInt(160)) != 0 {
+		t.Fatalf("despesa total inesperada: %s", summary.TotalExpense)
+	}
+	if summary.SpendingByCategory["Mercado"].Cmp(entity.MoneyFromInt(100)) != 0 || summary.SpendingByCategory["Farmácia"].Cmp(entity.MoneyFromInt(60)) != 0 {
+		t.Fatalf("gasto por categoria deveria seguir o rateio: %v", summary.SpendingByCategory)
+	}
+}
@@ -3,16 +3,21 @@ package usecase
 import (
 	"context"
 	"io"
+	"slices"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
+	"github.com/vasconcellos/financial-control/src/internal/domain/errors"
 	"github.com/vasconcellos/financial-control/src/internal/domain/port"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
 )
 
 type accountRepositoryStub struct {
 	created     []*entity.Account
 	storage     map[string]*entity.Account
-	adjustments []float64
+	adjustments []entity.Money
 	lastLimit   int64
 	lastOffset  int64
 }
@@ -37,16 +42,21 @@ func (s *accountRepositoryStub) Delete(ctx context.Context, id string, userID st
 	return nil
 }
 
+// GetByID só devolve a conta de outro usuário quando o fixture não define UserID
 func (s *accountRepositoryStub) GetByID(ctx context.Context, id string, userID string) (*entity.Account, error) {
-	return s.storage[id], nil
+	account := s.storage[id]
+	if account != nil && account.UserID != "" && account.UserID != userID {
+		return nil, nil
+	}
+	return account, nil
 }
 
-func (s *accountRepositoryStub) List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Account, error) {
+func (s *accountRepositoryStub) List(ctx context.Context, userID string, includeClosed bool, limit int64, offset int64) ([]*entity.Account, error) {
 	s.lastLimit = limit
 	s.lastOffset = offset
 	var filtered []*entity.Account
 	for _, account := range s.storage {
-		if account.UserID == userID {
+		if account.UserID == userID && (includeClosed || !account.IsClosed()) {
 			filtered = append(filtered, account)
 		}
 	}
@@ -65,21 +75,94 @@ func (s *accountRepositoryStub) List(ctx context.Context, userID string, limit i
 	return filtered[startIdx:endIdx], nil
 }
 
-func (s *accountRepositoryStub) AdjustBalance(ctx context.Context, id string, userID string, amount float64) error {
+// ListAfter ordena como o repositório Mongo: created_at e _id decrescentes, a partir do cursor
+func (s *accountRepositoryStub) ListAfter(ctx context.Context, userID string, includeClosed bool, after *repository.PageCursor, limit int64) ([]*entity.Account, error) {
+	var filtered []*entity.Account
+	for _, account := range s.storage {
+		if account.UserID == userID && (includeClosed || !account.IsClosed()) {
+			filtered = append(filtered, account)
+		}
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		if !filtered[i].CreatedAt.Equal(filtered[j].CreatedAt) {
+			return filtered[i].CreatedAt.After(filtered[j].CreatedAt)
+		}
+		return filtered[i].ID > filtered[j].ID
+	})
+
+	if after != nil {
+		createdAt, err := after.Time()
+		if err != nil {
+			return nil, errors.ErrInvalidInput
+		}
+		var page []*entity.Account
+		for _, account := range filtered {
+			if account.CreatedAt.Before(createdAt) || (account.CreatedAt.Equal(createdAt) && account.ID < after.ID) {
+				page = append(page, account)
+			}
+		}
+		filtered = page
+	}
+	if limit > 0 && int64(len(filtered)) > limit {
+		filtered = filtered[:limit]
+	}
+	return filtered, nil
+}
+
+// ListAll ordena por ID, como o repositório Mongo ordena por _id
+func (s *accountRepositoryStub) ListAll(ctx context.Context, afterID string, limit int64) ([]*entity.Account, error) {
+	var result []*entity.Account
+	for _, account := range s.storage {
+		if account.ID > afterID {
+			result = append(result, account)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	if limit > 0 && int64(len(result)) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (s *accountRepositoryStub) UpdateLoan(ctx context.Context, id string, userID string, expected entity.Loan, loan *entity.Loan) error {
+	account, ok := s.storage[id]
+	if !ok || account.Loan == nil || account.Loan.PaidInstallments != expected.PaidInstallments || account.Loan.Outstanding.Cmp(expected.Outstanding) != 0 {
+		return errors.ErrConflict
+	}
+	account.Loan = loan
+	return nil
+}
+
+func (s *accountRepositoryStub) AdvanceSavingsAccrual(ctx context.Context, id string, userID string, previous time.Time, next time.Time) error {
+	account, ok := s.storage[id]
+	if !ok || account.Savings == nil || !account.Savings.AccruedThrough.Equal(previous) {
+		return errors.ErrConflict
+	}
+	savings := *account.Savings
+	savings.AccruedThrough = next
+	account.Savings = &savings
+	return nil
+}
+
+func (s *accountRepositoryStub) AdjustBalance(ctx context.Context, id string, userID string, amount entity.Money) error {
 	s.adjustments = append(s.adjustments, amount)
 	if acc, ok := s.storage[id]; ok {
-		acc.Balance += amount
+		acc.Balance = acc.Balance.Add(amount)
 	}
 	return nil
 }
 
 type transactionRepositoryStub struct {
+	createErr    error
 	created      []*entity.Transaction
 	storage      map[string]*entity.Transaction
 	lastUpdated  *entity.Transaction
 	listResponse []*entity.Transaction
 	lastLimit    int64
 	lastOffset   int64
+	lastFilter   repository.TransactionFilter
 }
 
 func newTransactionRepositoryStub() *transactionRepositoryStub {
@@ -87,28 +170,61 @@ func newTransactionRepositoryStub() *transactionRepositoryStub {
 }
 
 func (s *transactionRepositoryStub) Create(ctx context.Context, transaction *entity.Transaction) error {
+	if s.createErr != nil {
+		return s.createErr
+	}
 	s.created = append(s.created, transaction)
 	s.storage[transaction.ID] = transaction
 	return nil
 }
 
+func (s *transactionRepositoryStub) CreateMany(ctx context.Context, transactions []*entity.Transaction) error {
+	for _, transaction := range transactions {
+		if err := s.Create(ctx, transaction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *transactionRepositoryStub) Update(ctx context.Context, transaction *entity.Transaction) error {
 	s.storage[transaction.ID] = transaction
 	s.lastUpdated = transaction
 	return nil
 }
 
+func (s *transactionRepositoryStub) Void(ctx context.Context, id string, userID string, reason string, voidedAt time.Time) error {
+	transaction, ok := s.storage[id]
+	if !ok {
+		return nil
+	}
+	transaction.Status = entity.TransactionStatusVoided
+	transaction.VoidedAt = &voidedAt
+	transaction.VoidReason = reason
+	return nil
+}
+
 func (s *transactionRepositoryStub) GetByID(ctx context.Context, id string, userID string) (*entity.Transaction, error) {
 	return s.storage[id], nil
 }
 
-func (s *transactionRepositoryStub) List(ctx context.Context, userID string, from time.Time, to time.Time, limit int64, offset int64) ([]*entity.Transaction, error) {
+func (s *transactionRepositoryStub) GetByExternalRef(ctx context.Context, userID string, externalRef string) (*entity.Transaction, error) {
+	for _, transaction := range s.storage {
+		if transaction.UserID == userID && transaction.ExternalRef == externalRef {
+			return transaction, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *transactionRepositoryStub) List(ctx context.Context, userID string, filter repository.TransactionFilter, limit int64, offset int64) ([]*entity.Transaction, error) {
+	s.lastFilter = filter
 	if s.listResponse != nil {
 		return s.listResponse, nil
 	}
 	var result []*entity.Transaction
 	for _, txn := range s.storage {
-		if txn.UserID == userID {
+		if txn.UserID == userID && (len(filter.AccountIDs) == 0 || slices.Contains(filter.AccountIDs, txn.AccountID)) {
 			result = append(result, txn)
 		}
 	}
@@ -127,15 +243,112 @@ func (s *transactionRepositoryStub) List(ctx context.Context, userID string, fro
 	return result[startIdx:endIdx], nil
 }
 
+func (s *transactionRepositoryStub) CountByAccount(ctx context.Context, userID string, accountID string) (int64, error) {
+	var count int64
+	for _, txn := range s.storage {
+		if txn.UserID == userID && txn.AccountID == accountID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *transactionRepositoryStub) DeleteByAccount(ctx context.Context, userID string, accountID string) error {
+	for id, txn := range s.storage {
+		if txn.UserID == userID && txn.AccountID == accountID {
+			delete(s.storage, id)
+		}
+	}
+	return nil
+}
+
 func (s *transactionRepositoryStub) ListByCategory(ctx context.Context, userID string, categoryID string, from time.Time, to time.Time) ([]*entity.Transaction, error) {
 	return nil, nil
 }
 
+func (s *transactionRepositoryStub) FindDuplicateCandidates(ctx context.Context, userID string, accountID string, amount entity.Money, from time.Time, to time.Time) ([]*entity.Transaction, error) {
+	var result []*entity.Transaction
+	for _, txn := range s.created {
+		if txn.UserID == userID && txn.AccountID == accountID && txn.Amount.Cmp(amount) == 0 &&
+			!txn.OccurredAt.Before(from) && !txn.OccurredAt.After(to) && txn.Status != entity.TransactionStatusVoided {
+			result = append(result, txn)
+		}
+	}
+	return result, nil
+}
+
+func (s *transactionRepositoryStub) ListDuplicates(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Transaction, error) {
+	var result []*entity.Transaction
+	for _, txn := range s.created {
+		if txn.UserID == userID && txn.DuplicateOf != "" && txn.Status != entity.TransactionStatusVoided {
+			result = append(result, txn)
+		}
+	}
+	return result, nil
+}
+
+func (s *transactionRepositoryStub) SetDuplicateOf(ctx context.Context, id string, userID string, duplicateOf string) error {
+	transaction, ok := s.storage[id]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	transaction.DuplicateOf = duplicateOf
+	return nil
+}
+
+func (s *transactionRepositoryStub) ListInstallments(ctx context.Context, userID string, groupID string) ([]*entity.Transaction, error) {
+	var result []*entity.Transaction
+	for _, txn := range s.created {
+		if txn.UserID == userID && txn.Installment != nil && txn.Installment.GroupID == groupID {
+			result = append(result, txn)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Installment.Number < result[j].Installment.Number
+	})
+	return result, nil
+}
+
+func (s *transactionRepositoryStub) ListUnreconciled(ctx context.Context, userID string, accountID string) ([]*entity.Transaction, error) {
+	var result []*entity.Transaction
+	for _, txn := range s.storage {
+		if txn.UserID == userID && txn.AccountID == accountID && txn.Status != entity.TransactionStatusVoided && !txn.IsReconciled() {
+			result = append(result, txn)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].OccurredAt.Before(result[j].OccurredAt)
+	})
+	return result, nil
+}
+
+func (s *transactionRepositoryStub) SetCleared(ctx context.Context, userID string, ids []string, cleared bool) error {
+	for _, id := range ids {
+		if txn, ok := s.storage[id]; ok && !txn.IsReconciled() {
+			txn.Cleared = cleared
+		}
+	}
+	return nil
+}
+
+func (s *transactionRepositoryStub) MarkReconciled(ctx context.Context, userID string, ids []string, reconciliationID string) error {
+	for _, id := range ids {
+		if txn, ok := s.storage[id]; ok {
+			txn.ReconciliationID = reconciliationID
+		}
+	}
+	return nil
+}
+
 type categoryRepositoryStub struct {
 	categories map[string]*entity.Category
 }
 
 func (s *categoryRepositoryStub) Create(ctx context.Context, category *entity.Category) error {
+	if s.categories == nil {
+		s.categories = map[string]*entity.Category{}
+	}
+	s.categories[category.ID] = category
 	return nil
 }
 
@@ -151,24 +364,123 @@ func (s *categoryRepositoryStub) GetByID(ctx context.Context, id string, userID
 	if s.categories == nil {
 		return nil, nil
 	}
-	return s.categories[id], nil
+	category := s.categories[id]
+	if category != nil && category.UserID != "" && category.UserID != userID {
+		return nil, nil
+	}
+	return category, nil
 }
 
 func (s *categoryRepositoryStub) List(ctx context.Context, userID string) ([]*entity.Category, error) {
-	return nil, nil
+	var result []*entity.Category
+	for _, category := range s.categories {
+		if category.UserID == "" || category.UserID == userID {
+			result = append(result, category)
+		}
+	}
+	return result, nil
 }
 
 type queuePublisherStub struct {
+	mu          sync.Mutex
 	lastMessage port.QueueMessage
+	messages    []port.QueueMessage
 	called      bool
+	err         error
 }
 
 func (s *queuePublisherStub) Publish(ctx context.Context, queueName string, message port.QueueMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.called = true
+	if s.err != nil {
+		return s.err
+	}
 	s.lastMessage = message
+	s.messages = append(s.messages, message)
+	return nil
+}
+
+func (s *queuePublisherStub) eventTypes() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	types := make([]string, 0, len(s.messages))
+	for _, message := range s.messages {
+		types = append(types, message.Attributes["eventType"])
+	}
+	return types
+}
+
+// outboxRepositoryStub guarda os eventos em memória e simula a reserva feita pelo relay
+type outboxRepositoryStub struct {
+	events     []*entity.OutboxEvent
+	claimCalls int
+}
+
+func newOutboxRepositoryStub() *outboxRepositoryStub {
+	return &outboxRepositoryStub{}
+}
+
+func (s *outboxRepositoryStub) Create(ctx context.Context, event *entity.OutboxEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *outboxRepositoryStub) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.OutboxEvent, error) {
+	s.claimCalls++
+	claimed := []*entity.OutboxEvent{}
+	for _, event := range s.events {
+		if len(claimed) == limit {
+			break
+		}
+		if event.Status == entity.OutboxStatusPending && !event.NextAttemptAt.After(now) {
+			claimed = append(claimed, event)
+		}
+	}
+	return claimed, nil
+}
+
+func (s *outboxRepositoryStub) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	event := s.find(id)
+	event.Status = entity.OutboxStatusSent
+	event.SentAt = &sentAt
+	event.Attempts++
+	return nil
+}
+
+func (s *outboxRepositoryStub) MarkRetry(ctx context.Context, id string, attempts int, nextAttemptAt time.Time, lastError string) error {
+	event := s.find(id)
+	event.Attempts = attempts
+	event.NextAttemptAt = nextAttemptAt
+	event.LastError = lastError
+	return nil
+}
+
+func (s *outboxRepositoryStub) MarkFailed(ctx context.Context, id string, attempts int, lastError string) error {
+	event := s.find(id)
+	event.Status = entity.OutboxStatusFailed
+	event.Attempts = attempts
+	event.LastError = lastError
 	return nil
 }
 
+func (s *outboxRepositoryStub) find(id string) *entity.OutboxEvent {
+	for _, event := range s.events {
+		if event.ID == id {
+			return event
+		}
+	}
+	return &entity.OutboxEvent{}
+}
+
+func (s *outboxRepositoryStub) eventTypes() []string {
+	types := make([]string, 0, len(s.events))
+	for _, event := range s.events {
+		types = append(types, event.EventType)
+	}
+	return types
+}
+
 type objectStorageStub struct {
 	objectKeys []string
 }
@@ -181,3 +493,582 @@ func (s *objectStorageStub) Upload(ctx context.Context, key string, body io.Read
 func (s *objectStorageStub) GetPresignedURL(ctx context.Context, key string) (string, error) {
 	return "https://example.com/" + key, nil
 }
+
+// unitOfWorkStub simula a transação restaurando saldos e transações quando fn falha
+type unitOfWorkStub struct {
+	accounts     *accountRepositoryStub
+	transactions *transactionRepositoryStub
+	calls        int
+	rollbacks    int
+}
+
+func newUnitOfWorkStub(accounts *accountRepositoryStub, transactions *transactionRepositoryStub) *unitOfWorkStub {
+	return &unitOfWorkStub{accounts: accounts, transactions: transactions}
+}
+
+func (s *unitOfWorkStub) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	s.calls++
+	balances := map[string]entity.Money{}
+	if s.accounts != nil {
+		for id, account := range s.accounts.storage {
+			balances[id] = account.Balance
+		}
+	}
+	transactions := map[string]entity.Transaction{}
+	if s.transactions != nil {
+		for id, transaction := range s.transactions.storage {
+			transactions[id] = *transaction
+		}
+	}
+
+	if err := fn(ctx); err != nil {
+		s.rollbacks++
+		if s.accounts != nil {
+			for id, balance := range balances {
+				s.accounts.storage[id].Balance = balance
+			}
+		}
+		if s.transactions != nil {
+			for id := range s.transactions.storage {
+				if snapshot, ok := transactions[id]; ok {
+					*s.transactions.storage[id] = snapshot
+				} else {
+					delete(s.transactions.storage, id)
+				}
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+type exchangeRateRepositoryStub struct {
+	rates []*entity.ExchangeRate
+}
+
+func (s *exchangeRateRepositoryStub) add(base entity.Currency, quote entity.Currency, rate float64, date time.Time) {
+	s.rates = append(s.rates, &entity.ExchangeRate{BaseCurrency: base, QuoteCurrency: quote, Rate: rate, Date: entity.ExchangeRateDate(date)})
+}
+
+func (s *exchangeRateRepositoryStub) Upsert(ctx context.Context, rate *entity.ExchangeRate) error {
+	for _, existing := range s.rates {
+		if existing.BaseCurrency == rate.BaseCurrency && existing.QuoteCurrency == rate.QuoteCurrency && existing.Date.Equal(rate.Date) {
+			existing.Rate = rate.Rate
+			return nil
+		}
+	}
+	copied := *rate
+	s.rates = append(s.rates, &copied)
+	return nil
+}
+
+func (s *exchangeRateRepositoryStub) FindOnOrBefore(ctx context.Context, base entity.Currency, quote entity.Currency, date time.Time) (*entity.ExchangeRate, error) {
+	var latest *entity.ExchangeRate
+	for _, rate := range s.rates {
+		if rate.BaseCurrency != base || rate.QuoteCurrency != quote || rate.Date.After(entity.ExchangeRateDate(date)) {
+			continue
+		}
+		if latest == nil || rate.Date.After(latest.Date) {
+			latest = rate
+		}
+	}
+	return latest, nil
+}
+
+type rateProviderStub struct {
+	rates []entity.ExchangeRate
+}
+
+func (s *rateProviderStub) FetchRates(ctx context.Context, from time.Time, to time.Time) ([]entity.ExchangeRate, error) {
+	return s.rates, nil
+}
+
+type reportRepositoryStub struct {
+	data *entity.SummaryData
+}
+
+func (s *reportRepositoryStub) LoadSummaryData(ctx context.Context, userID string, from time.Time, to time.Time) (*entity.SummaryData, error) {
+	return s.data, nil
+}
+
+type recurringRepositoryStub struct {
+	storage map[string]*entity.RecurringTransaction
+}
+
+func newRecurringRepositoryStub() *recurringRepositoryStub {
+	return &recurringRepositoryStub{storage: make(map[string]*entity.RecurringTransaction)}
+}
+
+func (s *recurringRepositoryStub) Create(ctx context.Context, recurring *entity.RecurringTransaction) error {
+	s.storage[recurring.ID] = recurring
+	return nil
+}
+
+func (s *recurringRepositoryStub) Update(ctx context.Context, recurring *entity.RecurringTransaction) error {
+	s.storage[recurring.ID] = recurring
+	return nil
+}
+
+func (s *recurringRepositoryStub) Delete(ctx context.Context, id string, userID string) error {
+	delete(s.storage, id)
+	return nil
+}
+
+func (s *recurringRepositoryStub) GetByID(ctx context.Context, id string, userID string) (*entity.RecurringTransaction, error) {
+	return s.storage[id], nil
+}
+
+func (s *recurringRepositoryStub) List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.RecurringTransaction, error) {
+	var result []*entity.RecurringTransaction
+	for _, recurring := range s.storage {
+		if recurring.UserID == userID {
+			result = append(result, recurring)
+		}
+	}
+	return result, nil
+}
+
+// ListDue ordena pela próxima ocorrência e respeita o limite, como o repositório Mongo
+func (s *recurringRepositoryStub) ListDue(ctx context.Context, now time.Time, limit int64) ([]*entity.RecurringTransaction, error) {
+	var result []*entity.RecurringTransaction
+	for _, recurring := range s.storage {
+		if recurring.Status == entity.RecurringStatusActive && !recurring.NextOccurrence.After(now) {
+			result = append(result, recurring)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].NextOccurrence.Before(result[j].NextOccurrence)
+	})
+	if limit > 0 && int64(len(result)) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (s *recurringRepositoryStub) DeleteByAccount(ctx context.Context, userID string, accountID string) error {
+	for id, item := range s.storage {
+		if item.UserID == userID && item.AccountID == accountID {
+			delete(s.storage, id)
+		}
+	}
+	return nil
+}
+
+type importProfileRepositoryStub struct {
+	storage map[string]*entity.ImportProfile
+}
+
+func (s *importProfileRepositoryStub) Create(ctx context.Context, profile *entity.ImportProfile) error {
+	s.storage[profile.ID] = profile
+	return nil
+}
+
+func (s *importProfileRepositoryStub) Delete(ctx context.Context, id string, userID string) error {
+	delete(s.storage, id)
+	return nil
+}
+
+func (s *importProfileRepositoryStub) GetByID(ctx context.Context, id string, userID string) (*entity.ImportProfile, error) {
+	return s.storage[id], nil
+}
+
+func (s *importProfileRepositoryStub) List(ctx context.Context, userID string) ([]*entity.ImportProfile, error) {
+	var result []*entity.ImportProfile
+	for _, profile := range s.storage {
+		result = append(result, profile)
+	}
+	return result, nil
+}
+
+type importBatchRepositoryStub struct {
+	storage map[string]*entity.ImportBatch
+}
+
+func (s *importBatchRepositoryStub) Create(ctx context.Context, batch *entity.ImportBatch) error {
+	s.storage[batch.ID] = batch
+	return nil
+}
+
+func (s *importBatchRepositoryStub) Update(ctx context.Context, batch *entity.ImportBatch) error {
+	s.storage[batch.ID] = batch
+	return nil
+}
+
+func (s *importBatchRepositoryStub) GetByID(ctx context.Context, id string, userID string) (*entity.ImportBatch, error) {
+	return s.storage[id], nil
+}
+
+func (s *importBatchRepositoryStub) DeleteByAccount(ctx context.Context, userID string, accountID string) error {
+	for id, item := range s.storage {
+		if item.UserID == userID && item.AccountID == accountID {
+			delete(s.storage, id)
+		}
+	}
+	return nil
+}
+
+type categorizationRuleRepositoryStub struct {
+	rules []*entity.CategorizationRule
+}
+
+func (s *categorizationRuleRepositoryStub) Create(ctx context.Context, rule *entity.CategorizationRule) error {
+	s.rules = append(s.rules, rule)
+	return nil
+}
+
+func (s *categorizationRuleRepositoryStub) Update(ctx context.Context, rule *entity.CategorizationRule) error {
+	return nil
+}
+
+func (s *categorizationRuleRepositoryStub) Delete(ctx context.Context, id string, userID string) error {
+	for i, rule := range s.rules {
+		if rule.ID == id {
+			s.rules = append(s.rules[:i], s.rules[i+1:]...)
+			return nil
+		}
+	}
+	return errors.ErrNotFound
+}
+
+func (s *categorizationRuleRepositoryStub) GetByID(ctx context.Context, id string, userID string) (*entity.CategorizationRule, error) {
+	for _, rule := range s.rules {
+		if rule.ID == id {
+			return rule, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *categorizationRuleRepositoryStub) List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.CategorizationRule, error) {
+	return s.rules, nil
+}
+
+func (s *categorizationRuleRepositoryStub) ListEnabled(ctx context.Context, userID string) ([]*entity.CategorizationRule, error) {
+	var result []*entity.CategorizationRule
+	for _, rule := range s.rules {
+		if rule.Enabled {
+			result = append(result, rule)
+		}
+	}
+	return result, nil
+}
+
+type reconciliationRepositoryStub struct {
+	storage map[string]*entity.Reconciliation
+}
+
+func newReconciliationRepositoryStub() *reconciliationRepositoryStub {
+	return &reconciliationRepositoryStub{storage: make(map[string]*entity.Reconciliation)}
+}
+
+func (s *reconciliationRepositoryStub) Create(ctx context.Context, reconciliation *entity.Reconciliation) error {
+	s.storage[reconciliation.ID] = reconciliation
+	return nil
+}
+
+func (s *reconciliationRepositoryStub) Update(ctx context.Context, reconciliation *entity.Reconciliation) error {
+	s.storage[reconciliation.ID] = reconciliation
+	return nil
+}
+
+func (s *reconciliationRepositoryStub) Delete(ctx context.Context, id string, userID string) error {
+	delete(s.storage, id)
+	return nil
+}
+
+func (s *reconciliationRepositoryStub) GetByID(ctx context.Context, id string, userID string) (*entity.Reconciliation, error) {
+	return s.storage[id], nil
+}
+
+func (s *reconciliationRepositoryStub) GetOpen(ctx context.Context, userID string, accountID string) (*entity.Reconciliation, error) {
+	for _, reconciliation := range s.storage {
+		if reconciliation.UserID == userID && reconciliation.AccountID == accountID && reconciliation.Status == entity.ReconciliationStatusOpen {
+			return reconciliation, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *reconciliationRepositoryStub) List(ctx context.Context, userID string, accountID string, limit int64, offset int64) ([]*entity.Reconciliation, error) {
+	var result []*entity.Reconciliation
+	for _, reconciliation := range s.storage {
+		if reconciliation.UserID == userID && reconciliation.AccountID == accountID {
+			result = append(result, reconciliation)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StatementDate.After(result[j].StatementDate)
+	})
+	if limit > 0 && int64(len(result)) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (s *reconciliationRepositoryStub) DeleteByAccount(ctx context.Context, userID string, accountID string) error {
+	for id, item := range s.storage {
+		if item.UserID == userID && item.AccountID == accountID {
+			delete(s.storage, id)
+		}
+	}
+	return nil
+}
+
+type balanceSnapshotRepositoryStub struct {
+	storage map[string]*entity.BalanceSnapshot
+}
+
+func newBalanceSnapshotRepositoryStub() *balanceSnapshotRepositoryStub {
+	return &balanceSnapshotRepositoryStub{storage: make(map[string]*entity.BalanceSnapshot)}
+}
+
+func (s *balanceSnapshotRepositoryStub) Upsert(ctx context.Context, snapshot *entity.BalanceSnapshot) error {
+	s.storage[snapshot.ID] = snapshot
+	return nil
+}
+
+func (s *balanceSnapshotRepositoryStub) FindFrom(ctx context.Context, userID string, accountID string, date time.Time) (*entity.BalanceSnapshot, error) {
+	var found *entity.BalanceSnapshot
+	for _, snapshot := range s.storage {
+		if snapshot.UserID != userID || snapshot.AccountID != accountID || snapshot.Date.Before(date) {
+			continue
+		}
+		if found == nil || snapshot.Date.Before(found.Date) {
+			found = snapshot
+		}
+	}
+	return found, nil
+}
+
+func (s *balanceSnapshotRepositoryStub) DeleteFrom(ctx context.Context, userID string, accountID string, date time.Time) error {
+	for id, snapshot := range s.storage {
+		if snapshot.UserID == userID && snapshot.AccountID == accountID && !snapshot.Date.Before(date) {
+			delete(s.storage, id)
+		}
+	}
+	return nil
+}
+
+type securityRepositoryStub struct {
+	storage map[string]*entity.Security
+}
+
+func newSecurityRepositoryStub() *securityRepositoryStub {
+	return &securityRepositoryStub{storage: make(map[string]*entity.Security)}
+}
+
+func (s *securityRepositoryStub) Create(ctx context.Context, security *entity.Security) error {
+	s.storage[security.ID] = security
+	return nil
+}
+
+func (s *securityRepositoryStub) Update(ctx context.Context, security *entity.Security) error {
+	s.storage[security.ID] = security
+	return nil
+}
+
+func (s *securityRepositoryStub) Delete(ctx context.Context, id string, userID string) error {
+	delete(s.storage, id)
+	return nil
+}
+
+func (s *securityRepositoryStub) GetByID(ctx context.Context, id string, userID string) (*entity.Security, error) {
+	return s.storage[id], nil
+}
+
+func (s *securityRepositoryStub) GetBySymbol(ctx context.Context, userID string, symbol string) (*entity.Security, error) {
+	for _, security := range s.storage {
+		if security.UserID == userID && security.Symbol == symbol {
+			return security, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *securityRepositoryStub) List(ctx context.Context, userID string, limit int64, offset int64) ([]*entity.Security, error) {
+	var result []*entity.Security
+	for _, security := range s.storage {
+		if security.UserID == userID {
+			result = append(result, security)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Symbol < result[j].Symbol })
+	return result, nil
+}
+
+type securityPriceRepositoryStub struct {
+	storage map[string]*entity.SecurityPrice
+}
+
+func newSecurityPriceRepositoryStub() *securityPriceRepositoryStub {
+	return &securityPriceRepositoryStub{storage: make(map[string]*entity.SecurityPrice)}
+}
+
+func (s *securityPriceRepositoryStub) Upsert(ctx context.Context, price *entity.SecurityPrice) error {
+	s.storage[price.ID] = price
+	return nil
+}
+
+func (s *securityPriceRepositoryStub) FindOnOrBefore(ctx context.Context, userID string, securityID string, date time.Time) (*entity.SecurityPrice, error) {
+	var found *entity.SecurityPrice
+	for _, price := range s.storage {
+		if price.UserID != userID || price.SecurityID != securityID || price.Date.After(date) {
+			continue
+		}
+		if found == nil || price.Date.After(found.Date) {
+			found = price
+		}
+	}
+	return found, nil
+}
+
+func (s *securityPriceRepositoryStub) List(ctx context.Context, userID string, securityID string, from time.Time, to time.Time) ([]*entity.SecurityPrice, error) {
+	var result []*entity.SecurityPrice
+	for _, price := range s.storage {
+		if price.UserID == userID && price.SecurityID == securityID && !price.Date.Before(from) && !price.Date.After(to) {
+			result = append(result, price)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Date.Before(result[j].Date) })
+	return result, nil
+}
+
+func (s *securityPriceRepositoryStub) DeleteBySecurity(ctx context.Context, userID string, securityID string) error {
+	for id, price := range s.storage {
+		if price.UserID == userID && price.SecurityID == securityID {
+			delete(s.storage, id)
+		}
+	}
+	return nil
+}
+
+type investmentTradeRepositoryStub struct {
+	storage map[string]*entity.InvestmentTrade
+}
+
+func newInvestmentTradeRepositoryStub() *investmentTradeRepositoryStub {
+	return &investmentTradeRepositoryStub{storage: make(map[string]*entity.InvestmentTrade)}
+}
+
+func (s *investmentTradeRepositoryStub) Create(ctx context.Context, trade *entity.InvestmentTrade) error {
+	s.storage[trade.ID] = trade
+	return nil
+}
+
+func (s *investmentTradeRepositoryStub) Delete(ctx context.Context, id string, userID string) error {
+	delete(s.storage, id)
+	return nil
+}
+
+func (s *investmentTradeRepositoryStub) GetByID(ctx context.Context, id string, userID string) (*entity.InvestmentTrade, error) {
+	return s.storage[id], nil
+}
+
+func (s *investmentTradeRepositoryStub) ListByAccount(ctx context.Context, userID string, accountID string) ([]*entity.InvestmentTrade, error) {
+	var result []*entity.InvestmentTrade
+	for _, trade := range s.storage {
+		if trade.UserID == userID && trade.AccountID == accountID {
+			result = append(result, trade)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].OccurredAt.Before(result[j].OccurredAt) })
+	return result, nil
+}
+
+func (s *investmentTradeRepositoryStub) CountBySecurity(ctx context.Context, userID string, securityID string) (int64, error) {
+	var count int64
+	for _, trade := range s.storage {
+		if trade.UserID == userID && trade.SecurityID == securityID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *investmentTradeRepositoryStub) DeleteByAccount(ctx context.Context, userID string, accountID string) error {
+	for id, trade := range s.storage {
+		if trade.UserID == userID && trade.AccountID == accountID {
+			delete(s.storage, id)
+		}
+	}
+	return nil
+}
+
+// transactionFixture monta os stubs e o TransactionUseCase que os testes dos casos de uso de conta usam; cada
+// teste cadastra só as contas, categorias e transações próprias
+type transactionFixture struct {
+	accountRepo  *accountRepositoryStub
+	txRepo       *transactionRepositoryStub
+	categoryRepo *categoryRepositoryStub
+	unitOfWork   *unitOfWorkStub
+	outbox       *outboxRepositoryStub
+	uc           *TransactionUseCase
+}
+
+func newTransactionFixture() *transactionFixture {
+	accountRepo := newAccountRepositoryStub()
+	txRepo := newTransactionRepositoryStub()
+	return &transactionFixture{
+		accountRepo:  accountRepo,
+		txRepo:       txRepo,
+		categoryRepo: &categoryRepositoryStub{categories: map[string]*entity.Category{}},
+		unitOfWork:   newUnitOfWorkStub(accountRepo, txRepo),
+	}
+}
+
+// withOutbox faz o caso de uso publicar os eventos de orçamento num outbox em memória
+func (f *transactionFixture) withOutbox() *transactionFixture {
+	f.outbox = newOutboxRepositoryStub()
+	return f
+}
+
+func (f *transactionFixture) withAccounts(accounts ...*entity.Account) *transactionFixture {
+	for _, account := range accounts {
+		f.accountRepo.Create(context.Background(), account)
+	}
+	return f
+}
+
+// withCategories cadastra as categorias do usuário "user"
+func (f *transactionFixture) withCategories(categories ...*entity.Category) *transactionFixture {
+	for _, category := range categories {
+		if category.UserID == "" {
+			category.UserID = "user"
+		}
+		f.categoryRepo.categories[category.ID] = category
+	}
+	return f
+}
+
+// withTransactions grava as transações na conta, como concluídas do usuário "user" e na moeda da conta quando
+// o teste não diz outra coisa
+func (f *transactionFixture) withTransactions(accountID string, transactions ...*entity.Transaction) *transactionFixture {
+	for _, transaction := range transactions {
+		transaction.AccountID = accountID
+		if transaction.UserID == "" {
+			transaction.UserID = "user"
+		}
+		if transaction.Currency == "" {
+			if account := f.accountRepo.storage[accountID]; account != nil {
+				transaction.Currency = account.Currency
+			}
+		}
+		if transaction.Status == "" {
+			transaction.Status = entity.TransactionStatusCompleted
+		}
+		f.txRepo.storage[transaction.ID] = transaction
+	}
+	return f
+}
+
+// transactions devolve o TransactionUseCase sobre os stubs, criado uma única vez por fixture
+func (f *transactionFixture) transactions() *TransactionUseCase {
+	if f.uc == nil {
+		if f.outbox != nil {
+			f.uc = NewTransactionUseCase(f.txRepo, f.accountRepo, f.categoryRepo, nil, f.unitOfWork, f.outbox, nil, nil, "queue", nil)
+		} else {
+			f.uc = NewTransactionUseCase(f.txRepo, f.accountRepo, f.categoryRepo, nil, f.unitOfWork, nil, nil, nil, "", nil)
+		}
+	}
+	return f.uc
+}
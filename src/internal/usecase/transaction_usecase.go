@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,7 +25,10 @@ type TransactionUseCase struct {
 	transactionRepo repository.TransactionRepository
 	accountRepo     repository.AccountRepository
 	categoryRepo    repository.CategoryRepository
-	queuePublisher  port.QueuePublisher
+	ruleRepo        repository.CategorizationRuleRepository
+	unitOfWork      repository.UnitOfWork
+	outboxRepo      repository.OutboxRepository
+	snapshotRepo    repository.BalanceSnapshotRepository
 	storage         port.ObjectStorage
 	eventQueueName  string
 	encryptionKey   []byte
@@ -31,12 +36,21 @@ type TransactionUseCase struct {
 
 const MaxReceiptSizeBytes int64 = 5 * 1024 * 1024
 const notesEncryptedMetadataKey = "notes_encrypted"
+const exchangeRateMetadataKey = "exchange_rate"
+
+const (
+	eventTypeTransactionRecorded = "TRANSACTION_RECORDED"
+	eventTypeTransactionVoided   = "TRANSACTION_VOIDED"
+)
 
 func NewTransactionUseCase(
 	transactionRepo repository.TransactionRepository,
 	accountRepo repository.AccountRepository,
 	categoryRepo repository.CategoryRepository,
-	queuePublisher port.QueuePublisher,
+	ruleRepo repository.CategorizationRuleRepository,
+	unitOfWork repository.UnitOfWork,
+	outboxRepo repository.OutboxRepository,
+	snapshotRepo repository.BalanceSnapshotRepository,
 	storage port.ObjectStorage,
 	eventQueueName string,
 	encryptionKey []byte,
@@ -45,7 +59,10 @@ func NewTransactionUseCase(
 		transactionRepo: transactionRepo,
 		accountRepo:     accountRepo,
 		categoryRepo:    categoryRepo,
-		queuePublisher:  queuePublisher,
+		ruleRepo:        ruleRepo,
+		unitOfWork:      unitOfWork,
+		outboxRepo:      outboxRepo,
+		snapshotRepo:    snapshotRepo,
 		storage:         storage,
 		eventQueueName:  eventQueueName,
 		encryptionKey:   encryptionKey,
@@ -99,15 +116,29 @@ func (uc *TransactionUseCase) decryptNotes(notes string, metadata map[string]str
 }
 
 func (uc *TransactionUseCase) RecordTransaction(ctx context.Context, userID string, request dto.CreateTransactionRequest) (*dto.TransactionResponse, error) {
-	if request.Amount <= 0 {
+	if !request.Amount.IsPositive() {
 		return nil, errors.ErrInvalidInput
 	}
-	category, err := uc.categoryRepo.GetByID(ctx, request.CategoryID, userID)
-	if err != nil {
-		return nil, err
+	if request.Installments > 1 {
+		if err := uc.validateInstallments(ctx, userID, request); err != nil {
+			return nil, err
+		}
 	}
-	if category == nil {
-		return nil, errors.ErrInvalidInput
+	if request.ExternalRef != "" {
+		existing, err := uc.transactionRepo.GetByExternalRef(ctx, userID, request.ExternalRef)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			notesValue, err := uc.decryptNotes(existing.Notes, existing.Metadata)
+			if err != nil {
+				return nil, err
+			}
+			return toTransactionResponse(existing, notesValue), nil
+		}
+	}
+	if err := uc.ensureAccountOpen(ctx, userID, request.AccountID); err != nil {
+		return nil, err
 	}
 
 	now := time.Now().UTC()
@@ -125,7 +156,44 @@ func (uc *TransactionUseCase) RecordTransaction(ctx context.Context, userID stri
 		Notes:       request.Notes,
 		CreatedAt:   now,
 		UpdatedAt:   now,
+		ExternalRef: request.ExternalRef,
 		Metadata:    map[string]string{},
+		Splits:      toTransactionSplits(request.Splits),
+	}
+	// Com rateio, a categoria principal é a da primeira linha quando não informada
+	if transaction.CategoryID == "" && len(transaction.Splits) > 0 {
+		transaction.CategoryID = transaction.Splits[0].CategoryID
+	}
+	if !request.SkipRules {
+		if err := uc.Categorize(ctx, userID, transaction); err != nil {
+			return nil, err
+		}
+	}
+	if transaction.CategoryID == "" {
+		return nil, errors.ErrInvalidInput
+	}
+	category, err := uc.categoryRepo.GetByID(ctx, transaction.CategoryID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, errors.ErrInvalidInput
+	}
+	transaction.Type = entity.TransactionTypeFromCategory(category.Type)
+	if err := uc.validateSplits(ctx, userID, transaction); err != nil {
+		return nil, err
+	}
+	if policy := entity.DuplicatePolicy(request.OnDuplicate); policy != entity.DuplicatePolicyAllow {
+		original, err := uc.FindSuspectedDuplicate(ctx, userID, transaction)
+		if err != nil {
+			return nil, err
+		}
+		if original != nil && policy == entity.DuplicatePolicyReject {
+			return nil, errors.ErrConflict
+		}
+		if original != nil {
+			transaction.DuplicateOf = original.ID
+		}
 	}
 	encryptedNotes, err := uc.encryptNotes(transaction.Notes, transaction.Metadata)
 	if err != nil {
@@ -133,62 +201,304 @@ func (uc *TransactionUseCase) RecordTransaction(ctx context.Context, userID stri
 	}
 	transaction.Notes = encryptedNotes
 
-	if category.Type == entity.CategoryTypeExpense {
-		if err := uc.accountRepo.AdjustBalance(ctx, request.AccountID, userID, -request.Amount); err != nil {
-			return nil, err
+	// Compra parcelada: o limite do cartão é consumido pelo total, mas cada parcela cai na sua fatura
+	transactions := []*entity.Transaction{transaction}
+	if request.Installments > 1 {
+		transactions = buildInstallments(transaction, request.Installments)
+		transaction = transactions[0]
+	}
+
+	err = uc.unitOfWork.Do(ctx, func(txCtx context.Context) error {
+		if err := uc.adjustBalance(txCtx, request.AccountID, userID, transaction.Type.BalanceEffect(request.Amount), transaction.OccurredAt); err != nil {
+			return err
 		}
-	} else {
-		if err := uc.accountRepo.AdjustBalance(ctx, request.AccountID, userID, request.Amount); err != nil {
-			return nil, err
+		if err := uc.transactionRepo.CreateMany(txCtx, transactions); err != nil {
+			return err
+		}
+		for _, created := range transactions {
+			if err := uc.enqueueTransactionEvent(txCtx, eventTypeTransactionRecorded, created); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	notesValue, err := uc.decryptNotes(transaction.Notes, transaction.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTransactionResponse(transaction, notesValue), nil
+}
+
+// validateInstallments só aceita parcelamento em contas de crédito, sem rateio entre categorias e com valor que
+// renda ao menos a menor unidade da moeda por parcela
+func (uc *TransactionUseCase) validateInstallments(ctx context.Context, userID string, request dto.CreateTransactionRequest) error {
+	if request.Installments > entity.MaxInstallments || len(request.Splits) > 0 {
+		return errors.ErrInvalidInput
+	}
+	if !entity.CanSplitInstallments(request.Amount, request.Installments, entity.Currency(request.Currency).MinorUnits()) {
+		return errors.ErrInvalidInput
+	}
+	account, err := uc.accountRepo.GetByID(ctx, request.AccountID, userID)
+	if err != nil {
+		return err
+	}
+	if account == nil || account.Type != entity.AccountTypeCredit {
+		return errors.ErrInvalidInput
+	}
+	return nil
+}
+
+// ensureAccountOpen recusa novos lançamentos em contas encerradas. Conta inexistente não é tratada aqui:
+// o ajuste de saldo devolve ErrNotFound
+func (uc *TransactionUseCase) ensureAccountOpen(ctx context.Context, userID string, accountID string) error {
+	account, err := uc.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return err
+	}
+	if account != nil && account.IsClosed() {
+		return errors.ErrAccountClosed
+	}
+	return nil
+}
+
+// buildInstallments divide a compra em parcelas mensais vinculadas pelo mesmo grupo. A primeira parcela mantém
+// o ID e a referência externa da compra, para que reenviar a requisição devolva a compra já gravada.
+func buildInstallments(purchase *entity.Transaction, count int) []*entity.Transaction {
+	groupID := uuid.NewString()
+	amounts := entity.SplitInstallments(purchase.Amount, count, purchase.Currency.MinorUnits())
+	installments := make([]*entity.Transaction, 0, count)
+	for i, amount := range amounts {
+		installment := *purchase
+		installment.Installment = &entity.Installment{GroupID: groupID, Number: i + 1, Count: count}
+		installment.Amount = amount
+		installment.OccurredAt = entity.InstallmentDate(purchase.OccurredAt, i+1)
+		installment.Tags = append([]string(nil), purchase.Tags...)
+		installment.Metadata = make(map[string]string, len(purchase.Metadata)+1)
+		for key, value := range purchase.Metadata {
+			installment.Metadata[key] = value
+		}
+		installment.Metadata[entity.InstallmentMetadataKey] = installment.Installment.Label()
+		if i > 0 {
+			installment.ID = uuid.NewString()
+			installment.DuplicateOf = ""
+			if purchase.ExternalRef != "" {
+				installment.ExternalRef = purchase.ExternalRef + "#" + strconv.Itoa(i+1)
+			}
 		}
+		installments = append(installments, &installment)
 	}
+	return installments
+}
 
-	if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+// CancelRemainingInstallments anula as parcelas ainda não lançadas (com data futura) da compra parcelada a que
+// a transação pertence, devolvendo o valor ao saldo do cartão; as parcelas já lançadas são mantidas.
+func (uc *TransactionUseCase) CancelRemainingInstallments(ctx context.Context, userID string, transactionID string, reason string) (*dto.CancelInstallmentsResponse, error) {
+	transaction, err := uc.transactionRepo.GetByID(ctx, transactionID, userID)
+	if err != nil {
 		return nil, err
 	}
-	notesValue, err := uc.decryptNotes(transaction.Notes, transaction.Metadata)
+	if transaction == nil {
+		return nil, errors.ErrNotFound
+	}
+	if transaction.Installment == nil {
+		return nil, errors.ErrInvalidInput
+	}
+
+	installments, err := uc.transactionRepo.ListInstallments(ctx, userID, transaction.Installment.GroupID)
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now().UTC()
+	var remaining []*entity.Transaction
+	for _, installment := range installments {
+		if installment.Status == entity.TransactionStatusVoided || installment.IsReconciled() || !installment.OccurredAt.After(now) {
+			continue
+		}
+		transactionType, typeErr := uc.resolveTransactionType(ctx, installment)
+		if typeErr != nil {
+			return nil, typeErr
+		}
+		installment.Type = transactionType
+		remaining = append(remaining, installment)
+	}
+	if len(remaining) == 0 {
+		return nil, errors.ErrConflict
+	}
 
-	if uc.queuePublisher != nil && uc.eventQueueName != "" {
-		// Publish to SQS asynchronously to avoid blocking HTTP response
-		go func() {
-			messagePayload := map[string]any{
-				"transactionId": transaction.ID,
-				"userId":        transaction.UserID,
-				"occurredAt":    transaction.OccurredAt,
-				"amount":        transaction.Amount,
-				"currency":      transaction.Currency.String(),
-				"categoryId":    transaction.CategoryID,
-				"accountId":     transaction.AccountID,
-				"type":          category.Type,
+	response := &dto.CancelInstallmentsResponse{
+		GroupID:                 transaction.Installment.GroupID,
+		CancelledTransactionIDs: make([]string, 0, len(remaining)),
+	}
+	err = uc.unitOfWork.Do(ctx, func(txCtx context.Context) error {
+		for _, installment := range remaining {
+			if err := uc.transactionRepo.Void(txCtx, installment.ID, userID, reason, now); err != nil {
+				return err
+			}
+			if err := uc.adjustBalance(txCtx, installment.AccountID, userID, installment.Type.BalanceEffect(installment.Amount).Neg(), installment.OccurredAt); err != nil {
+				return err
 			}
-			body, marshalErr := json.Marshal(messagePayload)
-			if marshalErr == nil {
-				_ = uc.queuePublisher.Publish(context.Background(), uc.eventQueueName, port.QueueMessage{
-					ID:         transaction.ID,
-					Payload:    body,
-					Attributes: map[string]string{"eventType": "TRANSACTION_RECORDED"},
-				})
+			if err := uc.enqueueTransactionEvent(txCtx, eventTypeTransactionVoided, installment); err != nil {
+				return err
 			}
-		}()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, installment := range remaining {
+		response.CancelledTransactionIDs = append(response.CancelledTransactionIDs, installment.ID)
+		response.Refunded = response.Refunded.Add(installment.Amount)
 	}
+	return response, nil
+}
 
-	return &dto.TransactionResponse{
-		ID:          transaction.ID,
-		AccountID:   transaction.AccountID,
-		CategoryID:  transaction.CategoryID,
-		Amount:      transaction.Amount,
-		Currency:    transaction.Currency.String(),
-		Description: transaction.Description,
-		OccurredAt:  transaction.OccurredAt,
-		Status:      string(transaction.Status),
-		Tags:        transaction.Tags,
-		Notes:       notesValue,
+// Categorize aplica as regras de categorização habilitadas do usuário; a categoria só é preenchida
+// nas transações que ainda não têm uma
+func (uc *TransactionUseCase) Categorize(ctx context.Context, userID string, transactions ...*entity.Transaction) error {
+	if uc.ruleRepo == nil {
+		return nil
+	}
+	rules, err := uc.ruleRepo.ListEnabled(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	for _, transaction := range transactions {
+		entity.ApplyCategorizationRules(rules, transaction)
+	}
+	return nil
+}
+
+// RecordTransfer debita a conta de origem e credita a de destino, gravando as duas pernas vinculadas.
+// Transferências não publicam eventos de orçamento e ficam fora dos totais de receitas/despesas.
+func (uc *TransactionUseCase) RecordTransfer(ctx context.Context, userID string, request dto.CreateTransferRequest) (*dto.TransferResponse, error) {
+	if !request.Amount.IsPositive() || request.FromAccountID == request.ToAccountID {
+		return nil, errors.ErrInvalidInput
+	}
+
+	fromAccount, err := uc.accountRepo.GetByID(ctx, request.FromAccountID, userID)
+	if err != nil {
+		return nil, err
+	}
+	toAccount, err := uc.accountRepo.GetByID(ctx, request.ToAccountID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if fromAccount == nil || toAccount == nil {
+		return nil, errors.ErrNotFound
+	}
+	if fromAccount.IsClosed() || toAccount.IsClosed() {
+		return nil, errors.ErrAccountClosed
+	}
+
+	destinationAmount, rate, err := resolveTransferAmount(fromAccount.Currency, toAccount.Currency, request)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	transferID := uuid.NewString()
+	outgoing := &entity.Transaction{
+		ID:          uuid.NewString(),
+		UserID:      userID,
+		AccountID:   fromAccount.ID,
+		Type:        entity.TransactionTypeTransferOut,
+		Amount:      request.Amount,
+		Currency:    fromAccount.Currency,
+		Description: request.Description,
+		OccurredAt:  request.OccurredAt,
+		Status:      entity.TransactionStatusCompleted,
+		Tags:        request.Tags,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Metadata:    map[string]string{},
+		TransferID:  transferID,
+	}
+	incoming := &entity.Transaction{
+		ID:          uuid.NewString(),
+		UserID:      userID,
+		AccountID:   toAccount.ID,
+		Type:        entity.TransactionTypeTransferIn,
+		Amount:      destinationAmount,
+		Currency:    toAccount.Currency,
+		Description: request.Description,
+		OccurredAt:  request.OccurredAt,
+		Status:      entity.TransactionStatusCompleted,
+		Tags:        request.Tags,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Metadata:    map[string]string{},
+		TransferID:  transferID,
+	}
+	outgoing.LinkedTransactionID = incoming.ID
+	incoming.LinkedTransactionID = outgoing.ID
+	if fromAccount.Currency != toAccount.Currency {
+		rateValue := strconv.FormatFloat(rate, 'f', -1, 64)
+		outgoing.Metadata[exchangeRateMetadataKey] = rateValue
+		incoming.Metadata[exchangeRateMetadataKey] = rateValue
+	}
+
+	for _, leg := range []*entity.Transaction{outgoing, incoming} {
+		encryptedNotes, encryptErr := uc.encryptNotes(request.Notes, leg.Metadata)
+		if encryptErr != nil {
+			return nil, encryptErr
+		}
+		leg.Notes = encryptedNotes
+	}
+
+	err = uc.unitOfWork.Do(ctx, func(txCtx context.Context) error {
+		if err := uc.adjustBalance(txCtx, fromAccount.ID, userID, outgoing.Amount.Neg(), outgoing.OccurredAt); err != nil {
+			return err
+		}
+		if err := uc.adjustBalance(txCtx, toAccount.ID, userID, incoming.Amount, incoming.OccurredAt); err != nil {
+			return err
+		}
+		return uc.transactionRepo.CreateMany(txCtx, []*entity.Transaction{outgoing, incoming})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outgoingNotes, err := uc.decryptNotes(outgoing.Notes, outgoing.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	incomingNotes, err := uc.decryptNotes(incoming.Notes, incoming.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.TransferResponse{
+		ID:           transferID,
+		ExchangeRate: rate,
+		From:         *toTransactionResponse(outgoing, outgoingNotes),
+		To:           *toTransactionResponse(incoming, incomingNotes),
 	}, nil
 }
 
+// resolveTransferAmount calcula o valor creditado no destino e a taxa de câmbio aplicada
+func resolveTransferAmount(fromCurrency entity.Currency, toCurrency entity.Currency, request dto.CreateTransferRequest) (entity.Money, float64, error) {
+	if fromCurrency == toCurrency {
+		return request.Amount, 1, nil
+	}
+	if request.DestinationAmount != nil && request.DestinationAmount.IsPositive() {
+		return *request.DestinationAmount, request.DestinationAmount.Ratio(request.Amount), nil
+	}
+	if request.ExchangeRate != nil && *request.ExchangeRate > 0 {
+		return request.Amount.Mul(*request.ExchangeRate).Round(toCurrency.MinorUnits()), *request.ExchangeRate, nil
+	}
+	return entity.ZeroMoney, 0, errors.ErrInvalidInput
+}
+
+// UpdateTransaction altera a transação; mudanças de valor, conta, categoria, data ou moeda
+// estornam o efeito anterior no saldo e nos orçamentos e aplicam o novo efeito.
 func (uc *TransactionUseCase) UpdateTransaction(ctx context.Context, userID string, transactionID string, request dto.UpdateTransactionRequest) (*dto.TransactionResponse, error) {
 	transaction, err := uc.transactionRepo.GetByID(ctx, transactionID, userID)
 	if err != nil {
@@ -197,9 +507,85 @@ func (uc *TransactionUseCase) UpdateTransaction(ctx context.Context, userID stri
 	if transaction == nil {
 		return nil, errors.ErrNotFound
 	}
+	if transaction.Status == entity.TransactionStatusVoided {
+		return nil, errors.ErrConflict
+	}
 
-	if request.CategoryID != nil {
-		transaction.CategoryID = *request.CategoryID
+	// Campos reenviados com o valor atual não contam como mudança, para que clientes que mandam o recurso inteiro
+	// possam editar a descrição de transações conciliadas, geradas ou de transferência
+	accountChanged := request.AccountID != nil && *request.AccountID != transaction.AccountID
+	currencyChanged := request.Currency != nil && entity.Currency(*request.Currency) != transaction.Currency
+	financialChange := accountChanged || currencyChanged ||
+		(request.CategoryID != nil && *request.CategoryID != transaction.CategoryID) ||
+		(request.Amount != nil && request.Amount.Cmp(transaction.Amount) != 0) ||
+		(request.OccurredAt != nil && !request.OccurredAt.Equal(transaction.OccurredAt)) ||
+		(request.Splits != nil && !sameSplits(transaction.Splits, toTransactionSplits(request.Splits)))
+	if financialChange && transaction.Type.IsTransfer() {
+		// Pernas de transferência devem ser anuladas e registradas novamente
+		return nil, errors.ErrInvalidInput
+	}
+	if financialChange && (transaction.IsReconciled() || transaction.IsGenerated()) {
+		// Transações conciliadas ou geradas por operações de investimento e pagamentos de empréstimo só aceitam mudanças de descrição, tags e notas
+		return nil, errors.ErrConflict
+	}
+
+	var previous entity.Transaction
+	if financialChange {
+		transactionType, typeErr := uc.resolveTransactionType(ctx, transaction)
+		if typeErr != nil {
+			return nil, typeErr
+		}
+		transaction.Type = transactionType
+		previous = *transaction
+	}
+
+	if request.CategoryID != nil && *request.CategoryID != transaction.CategoryID {
+		category, categoryErr := uc.categoryRepo.GetByID(ctx, *request.CategoryID, userID)
+		if categoryErr != nil {
+			return nil, categoryErr
+		}
+		if category == nil {
+			return nil, errors.ErrInvalidInput
+		}
+		transaction.CategoryID = category.ID
+		transaction.Type = entity.TransactionTypeFromCategory(category.Type)
+	}
+	if accountChanged || currencyChanged {
+		accountID := transaction.AccountID
+		if accountChanged {
+			accountID = *request.AccountID
+		}
+		account, accountErr := uc.accountRepo.GetByID(ctx, accountID, userID)
+		if accountErr != nil {
+			return nil, accountErr
+		}
+		if account == nil {
+			return nil, errors.ErrInvalidInput
+		}
+		if accountChanged && account.IsClosed() {
+			return nil, errors.ErrAccountClosed
+		}
+		// A moeda da transação, nova ou atual, precisa ser a da conta em que ela fica
+		currency := transaction.Currency
+		if currencyChanged {
+			currency = entity.Currency(*request.Currency)
+		}
+		if currency != account.Currency {
+			return nil, errors.ErrInvalidInput
+		}
+		transaction.AccountID = account.ID
+	}
+	if request.Amount != nil {
+		if !request.Amount.IsPositive() {
+			return nil, errors.ErrInvalidInput
+		}
+		transaction.Amount = *request.Amount
+	}
+	if request.Currency != nil {
+		transaction.Currency = entity.Currency(*request.Currency)
+	}
+	if request.OccurredAt != nil {
+		transaction.OccurredAt = *request.OccurredAt
 	}
 	if request.Description != nil {
 		transaction.Description = *request.Description
@@ -213,6 +599,14 @@ func (uc *TransactionUseCase) UpdateTransaction(ctx context.Context, userID stri
 	if request.Status != nil {
 		transaction.Status = entity.TransactionStatus(*request.Status)
 	}
+	if request.Splits != nil {
+		transaction.Splits = toTransactionSplits(request.Splits)
+	}
+	if financialChange {
+		if err := uc.validateSplits(ctx, userID, transaction); err != nil {
+			return nil, err
+		}
+	}
 	transaction.UpdatedAt = time.Now().UTC()
 	if request.Notes != nil {
 		if transaction.Metadata == nil {
@@ -225,34 +619,301 @@ func (uc *TransactionUseCase) UpdateTransaction(ctx context.Context, userID stri
 		transaction.Notes = encryptedNotes
 	}
 
-	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+	// Mudar só a data não altera o saldo atual, mas invalida os snapshots a partir da data mais antiga
+	rebalanced := financialChange && (previous.AccountID != transaction.AccountID ||
+		previous.Amount != transaction.Amount || previous.Type != transaction.Type ||
+		!previous.OccurredAt.Equal(transaction.OccurredAt))
+	budgetImpactChanged := financialChange && (previous.CategoryID != transaction.CategoryID ||
+		previous.Amount != transaction.Amount || previous.Type != transaction.Type ||
+		previous.Currency != transaction.Currency || !previous.OccurredAt.Equal(transaction.OccurredAt) ||
+		!sameSplits(previous.Splits, transaction.Splits))
+	err = uc.unitOfWork.Do(ctx, func(txCtx context.Context) error {
+		if rebalanced {
+			if err := uc.rebalance(txCtx, userID, &previous, transaction); err != nil {
+				return err
+			}
+		}
+		if err := uc.transactionRepo.Update(txCtx, transaction); err != nil {
+			return err
+		}
+		if !budgetImpactChanged {
+			return nil
+		}
+		if err := uc.enqueueTransactionEvent(txCtx, eventTypeTransactionVoided, &previous); err != nil {
+			return err
+		}
+		return uc.enqueueTransactionEvent(txCtx, eventTypeTransactionRecorded, transaction)
+	})
+	if err != nil {
 		return nil, err
 	}
+
 	notesValue, err := uc.decryptNotes(transaction.Notes, transaction.Metadata)
 	if err != nil {
 		return nil, err
 	}
 
-	return &dto.TransactionResponse{
-		ID:          transaction.ID,
-		AccountID:   transaction.AccountID,
-		CategoryID:  transaction.CategoryID,
-		Amount:      transaction.Amount,
-		Currency:    transaction.Currency.String(),
-		Description: transaction.Description,
-		OccurredAt:  transaction.OccurredAt,
-		Status:      string(transaction.Status),
-		Tags:        transaction.Tags,
-		Notes:       notesValue,
-	}, nil
+	return toTransactionResponse(transaction, notesValue), nil
+}
+
+// validateSplits confere se o rateio soma o valor da transação e se todas as categorias existem e geram
+// o mesmo tipo da transação, já que ela tem um único efeito sobre o saldo
+func (uc *TransactionUseCase) validateSplits(ctx context.Context, userID string, transaction *entity.Transaction) error {
+	if !transaction.SplitsValid() {
+		return errors.ErrInvalidInput
+	}
+	for _, categoryID := range transaction.SplitCategoryIDs() {
+		category, err := uc.categoryRepo.GetByID(ctx, categoryID, userID)
+		if err != nil {
+			return err
+		}
+		if category == nil || entity.TransactionTypeFromCategory(category.Type) != transaction.Type {
+			return errors.ErrInvalidInput
+		}
+	}
+	return nil
+}
+
+func sameSplits(a []entity.TransactionSplit, b []entity.TransactionSplit) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].CategoryID != b[i].CategoryID || a[i].Amount.Cmp(b[i].Amount) != 0 {
+			return false
+		}
+	}
+	return true
 }
 
-func (uc *TransactionUseCase) ListTransactions(ctx context.Context, userID string, from, to time.Time, limit int64, offset int64) ([]*dto.TransactionResponse, error) {
-	transactions, err := uc.transactionRepo.List(ctx, userID, from, to, limit, offset)
+func toTransactionSplits(splits []dto.TransactionSplit) []entity.TransactionSplit {
+	if len(splits) == 0 {
+		return nil
+	}
+	result := make([]entity.TransactionSplit, 0, len(splits))
+	for _, split := range splits {
+		result = append(result, entity.TransactionSplit{
+			CategoryID: split.CategoryID,
+			Amount:     split.Amount,
+			Note:       strings.TrimSpace(split.Note),
+		})
+	}
+	return result
+}
+
+// rebalance desfaz o efeito de previous no saldo e aplica o efeito de current
+func (uc *TransactionUseCase) rebalance(ctx context.Context, userID string, previous *entity.Transaction, current *entity.Transaction) error {
+	previousEffect := previous.Type.BalanceEffect(previous.Amount)
+	currentEffect := current.Type.BalanceEffect(current.Amount)
+
+	if previous.AccountID == current.AccountID {
+		since := current.OccurredAt
+		if previous.OccurredAt.Before(since) {
+			since = previous.OccurredAt
+		}
+		return uc.adjustBalance(ctx, current.AccountID, userID, currentEffect.Sub(previousEffect), since)
+	}
+
+	if err := uc.adjustBalance(ctx, previous.AccountID, userID, previousEffect.Neg(), previous.OccurredAt); err != nil {
+		return err
+	}
+	return uc.adjustBalance(ctx, current.AccountID, userID, currentEffect, current.OccurredAt)
+}
+
+// adjustBalance altera o saldo da conta e descarta os snapshots de saldo a partir do dia do lançamento,
+// que deixam de valer; o histórico desses dias volta a ser calculado a partir das transações
+func (uc *TransactionUseCase) adjustBalance(ctx context.Context, accountID string, userID string, amount entity.Money, occurredAt time.Time) error {
+	if err := uc.accountRepo.AdjustBalance(ctx, accountID, userID, amount); err != nil {
+		return err
+	}
+	if uc.snapshotRepo == nil {
+		return nil
+	}
+	return uc.snapshotRepo.DeleteFrom(ctx, userID, accountID, entity.StartOfDay(occurredAt))
+}
+
+// VoidTransaction anula a transação mantendo o registro para auditoria, estorna o saldo da conta
+// e publica um evento compensatório para que o processador de orçamentos desfaça o gasto.
+// Anular uma perna de transferência anula também a perna vinculada. Transações conciliadas não podem ser anuladas.
+func (uc *TransactionUseCase) VoidTransaction(ctx context.Context, userID string, transactionID string, reason string) error {
+	transaction, err := uc.transactionRepo.GetByID(ctx, transactionID, userID)
+	if err != nil {
+		return err
+	}
+	if transaction == nil {
+		return errors.ErrNotFound
+	}
+	// Lançamentos de operações de investimento são anulados pela remoção da operação; os de pagamento de empréstimo
+	// fazem parte do cronograma e não são anulados
+	if transaction.Status == entity.TransactionStatusVoided || transaction.IsReconciled() || transaction.IsGenerated() {
+		return errors.ErrConflict
+	}
+
+	legs := []*entity.Transaction{transaction}
+	if transaction.Type.IsTransfer() && transaction.LinkedTransactionID != "" {
+		linked, linkedErr := uc.transactionRepo.GetByID(ctx, transaction.LinkedTransactionID, userID)
+		if linkedErr != nil {
+			return linkedErr
+		}
+		if linked != nil && linked.IsReconciled() {
+			return errors.ErrConflict
+		}
+		if linked != nil && linked.Status != entity.TransactionStatusVoided {
+			legs = append(legs, linked)
+		}
+	}
+
+	for _, leg := range legs {
+		transactionType, typeErr := uc.resolveTransactionType(ctx, leg)
+		if typeErr != nil {
+			return typeErr
+		}
+		leg.Type = transactionType
+	}
+
+	now := time.Now().UTC()
+	err = uc.unitOfWork.Do(ctx, func(txCtx context.Context) error {
+		for _, leg := range legs {
+			if err := uc.transactionRepo.Void(txCtx, leg.ID, userID, reason, now); err != nil {
+				return err
+			}
+			if err := uc.adjustBalance(txCtx, leg.AccountID, userID, leg.Type.BalanceEffect(leg.Amount).Neg(), leg.OccurredAt); err != nil {
+				return err
+			}
+			if err := uc.enqueueTransactionEvent(txCtx, eventTypeTransactionVoided, leg); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// recordWith grava transações já montadas, com o ajuste de saldo e o evento de cada uma, na mesma unidade de
+// trabalho de also. Usado por casos de uso que mantêm um documento próprio ligado às transações
+func (uc *TransactionUseCase) recordWith(ctx context.Context, transactions []*entity.Transaction, also func(txCtx context.Context) error) error {
+	return uc.unitOfWork.Do(ctx, func(txCtx context.Context) error {
+		for _, transaction := range transactions {
+			if err := uc.adjustBalance(txCtx, transaction.AccountID, transaction.UserID, transaction.Type.BalanceEffect(transaction.Amount), transaction.OccurredAt); err != nil {
+				return err
+			}
+		}
+		if err := uc.transactionRepo.CreateMany(txCtx, transactions); err != nil {
+			return err
+		}
+		for _, transaction := range transactions {
+			if err := uc.enqueueTransactionEvent(txCtx, eventTypeTransactionRecorded, transaction); err != nil {
+				return err
+			}
+		}
+		return also(txCtx)
+	})
+}
+
+// voidWith anula a transação estornando o saldo, na mesma unidade de trabalho de also
+func (uc *TransactionUseCase) voidWith(ctx context.Context, transaction *entity.Transaction, reason string, also func(txCtx context.Context) error) error {
+	return uc.voidAllWith(ctx, []*entity.Transaction{transaction}, reason, also)
+}
+
+// voidAllWith anula as transações ainda válidas estornando o saldo de cada uma, na mesma unidade de trabalho de
+// also. Uma transação conciliada recusa a operação inteira com ErrConflict
+func (uc *TransactionUseCase) voidAllWith(ctx context.Context, transactions []*entity.Transaction, reason string, also func(txCtx context.Context) error) error {
+	pending := make([]*entity.Transaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		if transaction.Status == entity.TransactionStatusVoided {
+			continue
+		}
+		if transaction.IsReconciled() {
+			return errors.ErrConflict
+		}
+		pending = append(pending, transaction)
+	}
+	if len(pending) == 0 {
+		return also(ctx)
+	}
+	now := time.Now().UTC()
+	return uc.unitOfWork.Do(ctx, func(txCtx context.Context) error {
+		for _, transaction := range pending {
+			if err := uc.transactionRepo.Void(txCtx, transaction.ID, transaction.UserID, reason, now); err != nil {
+				return err
+			}
+			if err := uc.adjustBalance(txCtx, transaction.AccountID, transaction.UserID, transaction.Type.BalanceEffect(transaction.Amount).Neg(), transaction.OccurredAt); err != nil {
+				return err
+			}
+			if err := uc.enqueueTransactionEvent(txCtx, eventTypeTransactionVoided, transaction); err != nil {
+				return err
+			}
+		}
+		return also(txCtx)
+	})
+}
+
+func (uc *TransactionUseCase) ListTransactions(ctx context.Context, userID string, request dto.TransactionFilter, limit int64, offset int64) ([]*dto.TransactionResponse, error) {
+	filter, err := uc.buildTransactionFilter(ctx, userID, request)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := uc.transactionRepo.List(ctx, userID, filter, limit, offset)
 	if err != nil {
 		return nil, err
 	}
+	return uc.toListedTransactionResponses(ctx, transactions)
+}
 
+// ListTransactionsPage lista por chave (campo de ordenação, _id); cursor vazio devolve a primeira página.
+// O cursor só vale para a mesma ordenação em que foi gerado.
+func (uc *TransactionUseCase) ListTransactionsPage(ctx context.Context, userID string, request dto.TransactionFilter, cursor string, limit int64) (*dto.TransactionPage, error) {
+	filter, err := uc.buildTransactionFilter(ctx, userID, request)
+	if err != nil {
+		return nil, err
+	}
+	sort := transactionCursorSort(filter)
+	filter.After, err = decodePageCursor(cursor, sort)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := uc.transactionRepo.List(ctx, userID, filter, limit+1, 0)
+	if err != nil {
+		return nil, err
+	}
+	transactions, hasMore := splitPage(transactions, limit)
+
+	items, err := uc.toListedTransactionResponses(ctx, transactions)
+	if err != nil {
+		return nil, err
+	}
+	page := &dto.TransactionPage{Items: items}
+	if hasMore {
+		page.NextCursor = encodePageCursor(transactionPageCursor(filter.SortBy, sort, transactions[len(transactions)-1]))
+	}
+	return page, nil
+}
+
+// transactionCursorSort devolve a ordenação efetiva da listagem no formato do parâmetro sort
+func transactionCursorSort(filter repository.TransactionFilter) string {
+	if filter.SortBy == "" {
+		return "-" + string(repository.TransactionSortOccurredAt)
+	}
+	if filter.SortDescending {
+		return "-" + string(filter.SortBy)
+	}
+	return string(filter.SortBy)
+}
+
+func transactionPageCursor(field repository.TransactionSortField, sort string, last *entity.Transaction) repository.PageCursor {
+	switch field {
+	case repository.TransactionSortAmount:
+		return repository.PageCursor{Sort: sort, Value: last.Amount.String(), ID: last.ID}
+	case repository.TransactionSortDescription:
+		return repository.PageCursor{Sort: sort, Value: last.Description, ID: last.ID}
+	case repository.TransactionSortCreatedAt:
+		return repository.NewTimeCursor(sort, last.CreatedAt, last.ID)
+	default:
+		return repository.NewTimeCursor(sort, last.OccurredAt, last.ID)
+	}
+}
+
+func (uc *TransactionUseCase) toListedTransactionResponses(ctx context.Context, transactions []*entity.Transaction) ([]*dto.TransactionResponse, error) {
 	response := make([]*dto.TransactionResponse, 0, len(transactions))
 	for _, transaction := range transactions {
 		var receiptURL *string
@@ -268,24 +929,97 @@ func (uc *TransactionUseCase) ListTransactions(ctx context.Context, userID strin
 			return nil, notesErr
 		}
 
-		response = append(response, &dto.TransactionResponse{
-			ID:          transaction.ID,
-			AccountID:   transaction.AccountID,
-			CategoryID:  transaction.CategoryID,
-			Amount:      transaction.Amount,
-			Currency:    transaction.Currency.String(),
-			Description: transaction.Description,
-			OccurredAt:  transaction.OccurredAt,
-			Status:      string(transaction.Status),
-			Tags:        transaction.Tags,
-			Notes:       notesValue,
-			ReceiptURL:  receiptURL,
-		})
+		item := toTransactionResponse(transaction, notesValue)
+		item.ReceiptURL = receiptURL
+		response = append(response, item)
 	}
 
 	return response, nil
 }
 
+// buildTransactionFilter valida os filtros da listagem e expande as categorias para as subcategorias quando pedido
+func (uc *TransactionUseCase) buildTransactionFilter(ctx context.Context, userID string, request dto.TransactionFilter) (repository.TransactionFilter, error) {
+	filter := repository.TransactionFilter{
+		From:          request.From,
+		To:            request.To,
+		IncludeVoided: request.IncludeVoided,
+		AccountIDs:    request.AccountIDs,
+		CategoryIDs:   request.CategoryIDs,
+		Tags:          request.Tags,
+		MatchAllTags:  request.MatchAllTags,
+		MinAmount:     request.MinAmount,
+		MaxAmount:     request.MaxAmount,
+		Search:        strings.TrimSpace(request.Search),
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.From.After(filter.To) {
+		return filter, errors.ErrInvalidInput
+	}
+	if filter.MinAmount != nil && filter.MaxAmount != nil && filter.MinAmount.Cmp(*filter.MaxAmount) > 0 {
+		return filter, errors.ErrInvalidInput
+	}
+
+	for _, status := range request.Statuses {
+		switch entity.TransactionStatus(status) {
+		case entity.TransactionStatusPending, entity.TransactionStatusCompleted, entity.TransactionStatusFailed, entity.TransactionStatusVoided:
+			filter.Statuses = append(filter.Statuses, entity.TransactionStatus(status))
+		default:
+			return filter, errors.ErrInvalidInput
+		}
+	}
+	for _, code := range request.Currencies {
+		code = strings.ToUpper(code)
+		if !entity.IsISOCurrency(code) {
+			return filter, errors.ErrInvalidInput
+		}
+		filter.Currencies = append(filter.Currencies, entity.Currency(code))
+	}
+
+	if request.Sort != "" {
+		field := strings.TrimPrefix(request.Sort, "-")
+		switch repository.TransactionSortField(field) {
+		case repository.TransactionSortOccurredAt, repository.TransactionSortAmount, repository.TransactionSortCreatedAt, repository.TransactionSortDescription:
+			filter.SortBy = repository.TransactionSortField(field)
+			filter.SortDescending = strings.HasPrefix(request.Sort, "-")
+		default:
+			return filter, errors.ErrInvalidInput
+		}
+	}
+
+	if request.IncludeSubcategories && len(filter.CategoryIDs) > 0 {
+		categories, err := uc.categoryRepo.List(ctx, userID)
+		if err != nil {
+			return filter, err
+		}
+		filter.CategoryIDs = expandSubcategories(categories, filter.CategoryIDs)
+	}
+	return filter, nil
+}
+
+// expandSubcategories devolve as categorias pedidas e todos os seus descendentes via ParentID
+func expandSubcategories(categories []*entity.Category, categoryIDs []string) []string {
+	children := map[string][]string{}
+	for _, category := range categories {
+		if category.ParentID != nil && *category.ParentID != "" {
+			children[*category.ParentID] = append(children[*category.ParentID], category.ID)
+		}
+	}
+
+	seen := map[string]bool{}
+	result := make([]string, 0, len(categoryIDs))
+	queue := append([]string(nil), categoryIDs...)
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if seen[current] {
+			continue
+		}
+		seen[current] = true
+		result = append(result, current)
+		queue = append(queue, children[current]...)
+	}
+	return result
+}
+
 func (uc *TransactionUseCase) AttachReceipt(ctx context.Context, userID string, transactionID string, filename string, contentType string, data io.Reader) (*dto.TransactionResponse, error) {
 	transaction, err := uc.transactionRepo.GetByID(ctx, transactionID, userID)
 	if err != nil {
@@ -344,19 +1078,288 @@ func (uc *TransactionUseCase) AttachReceipt(ctx context.Context, userID string,
 		return nil, err
 	}
 
-	response := &dto.TransactionResponse{
-		ID:          transaction.ID,
-		AccountID:   transaction.AccountID,
-		CategoryID:  transaction.CategoryID,
-		Amount:      transaction.Amount,
-		Currency:    transaction.Currency.String(),
-		Description: transaction.Description,
-		OccurredAt:  transaction.OccurredAt,
-		Status:      string(transaction.Status),
-		Tags:        transaction.Tags,
-		Notes:       notesValue,
-	}
+	response := toTransactionResponse(transaction, notesValue)
 	response.ReceiptURL = &url
 
 	return response, nil
 }
+
+// FindSuspectedDuplicate procura uma transação já gravada que pareça ser a mesma movimentação
+// (mesma conta, tipo e valor, data próxima e mesma descrição normalizada)
+func (uc *TransactionUseCase) FindSuspectedDuplicate(ctx context.Context, userID string, transaction *entity.Transaction) (*entity.Transaction, error) {
+	candidates, err := uc.transactionRepo.FindDuplicateCandidates(ctx, userID, transaction.AccountID, transaction.Amount,
+		transaction.OccurredAt.Add(-entity.DuplicateDateWindow), transaction.OccurredAt.Add(entity.DuplicateDateWindow))
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range candidates {
+		candidateType, err := uc.resolveTransactionType(ctx, candidate)
+		if err != nil {
+			return nil, err
+		}
+		candidate.Type = candidateType
+		if transaction.IsSuspectedDuplicateOf(candidate) {
+			return candidate, nil
+		}
+	}
+	return nil, nil
+}
+
+// ListDuplicates lista as possíveis duplicatas pendentes de revisão junto da transação original
+func (uc *TransactionUseCase) ListDuplicates(ctx context.Context, userID string, limit int64, offset int64) ([]*dto.DuplicateTransactionResponse, error) {
+	duplicates, err := uc.transactionRepo.ListDuplicates(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.DuplicateTransactionResponse, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		item := &dto.DuplicateTransactionResponse{}
+		if item.Transaction, err = uc.toResponse(duplicate); err != nil {
+			return nil, err
+		}
+		original, err := uc.transactionRepo.GetByID(ctx, duplicate.DuplicateOf, userID)
+		if err != nil {
+			return nil, err
+		}
+		if original != nil {
+			if item.Original, err = uc.toResponse(original); err != nil {
+				return nil, err
+			}
+		}
+		response = append(response, item)
+	}
+	return response, nil
+}
+
+// MergeDuplicate confirma a duplicidade: tags, notas e recibo que faltam na original são copiados
+// e a duplicata é anulada, estornando saldo e orçamentos
+func (uc *TransactionUseCase) MergeDuplicate(ctx context.Context, userID string, transactionID string) (*dto.TransactionResponse, error) {
+	duplicate, err := uc.getFlaggedDuplicate(ctx, userID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	// Anular uma duplicata conciliada mudaria o saldo já conferido com o banco; lançamentos gerados por outras
+	// operações só são anulados por elas. A verificação vem antes de qualquer alteração na original
+	if duplicate.IsReconciled() || duplicate.IsGenerated() {
+		return nil, errors.ErrConflict
+	}
+	if duplicate.Type, err = uc.resolveTransactionType(ctx, duplicate); err != nil {
+		return nil, err
+	}
+	original, err := uc.transactionRepo.GetByID(ctx, duplicate.DuplicateOf, userID)
+	if err != nil {
+		return nil, err
+	}
+	if original == nil || original.Status == entity.TransactionStatusVoided {
+		return nil, errors.ErrConflict
+	}
+
+	original.Tags = mergeTags(original.Tags, duplicate.Tags)
+	if original.Notes == "" && duplicate.Notes != "" {
+		if original.Metadata == nil {
+			original.Metadata = map[string]string{}
+		}
+		original.Notes = duplicate.Notes
+		if duplicate.Metadata[notesEncryptedMetadataKey] == "true" {
+			original.Metadata[notesEncryptedMetadataKey] = "true"
+		}
+	}
+	if original.ReceiptObject == nil {
+		original.ReceiptObject = duplicate.ReceiptObject
+	}
+	original.UpdatedAt = time.Now().UTC()
+
+	// A cópia para a original e a anulação da duplicata acontecem juntas ou não acontecem
+	err = uc.voidWith(ctx, duplicate, "merged into "+original.ID, func(txCtx context.Context) error {
+		return uc.transactionRepo.Update(txCtx, original)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.toResponse(original)
+}
+
+// DismissDuplicate descarta a suspeita e mantém as duas transações
+func (uc *TransactionUseCase) DismissDuplicate(ctx context.Context, userID string, transactionID string) (*dto.TransactionResponse, error) {
+	duplicate, err := uc.getFlaggedDuplicate(ctx, userID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.transactionRepo.SetDuplicateOf(ctx, duplicate.ID, userID, ""); err != nil {
+		return nil, err
+	}
+	duplicate.DuplicateOf = ""
+	return uc.toResponse(duplicate)
+}
+
+func (uc *TransactionUseCase) getFlaggedDuplicate(ctx context.Context, userID string, transactionID string) (*entity.Transaction, error) {
+	transaction, err := uc.transactionRepo.GetByID(ctx, transactionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if transaction == nil {
+		return nil, errors.ErrNotFound
+	}
+	if transaction.DuplicateOf == "" || transaction.Status == entity.TransactionStatusVoided {
+		return nil, errors.ErrConflict
+	}
+	return transaction, nil
+}
+
+func (uc *TransactionUseCase) toResponse(transaction *entity.Transaction) (*dto.TransactionResponse, error) {
+	notesValue, err := uc.decryptNotes(transaction.Notes, transaction.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return toTransactionResponse(transaction, notesValue), nil
+}
+
+func mergeTags(current []string, extra []string) []string {
+	seen := make(map[string]bool, len(current))
+	for _, tag := range current {
+		seen[tag] = true
+	}
+	for _, tag := range extra {
+		if !seen[tag] {
+			seen[tag] = true
+			current = append(current, tag)
+		}
+	}
+	return current
+}
+
+// resolveTransactionType devolve o tipo gravado ou, para registros antigos sem tipo, deriva-o da categoria
+func (uc *TransactionUseCase) resolveTransactionType(ctx context.Context, transaction *entity.Transaction) (entity.TransactionType, error) {
+	if transaction.Type != "" {
+		return transaction.Type, nil
+	}
+	category, err := uc.categoryRepo.GetByID(ctx, transaction.CategoryID, transaction.UserID)
+	if err != nil {
+		return "", err
+	}
+	if category == nil {
+		return entity.TransactionTypeExpense, nil
+	}
+	return entity.TransactionTypeFromCategory(category.Type), nil
+}
+
+// resolveTransactionTypes preenche, como resolveTransactionType, o tipo dos registros antigos da lista a partir da
+// categoria, lendo as categorias uma vez por usuário. Quem soma ou desfaz efeitos no saldo chama antes de calcular
+func resolveTransactionTypes(ctx context.Context, categoryRepo repository.CategoryRepository, transactions []*entity.Transaction) error {
+	categoryTypes := map[string]map[string]entity.CategoryType{}
+	for _, transaction := range transactions {
+		if transaction.Type != "" {
+			continue
+		}
+		types, ok := categoryTypes[transaction.UserID]
+		if !ok {
+			categories, err := categoryRepo.List(ctx, transaction.UserID)
+			if err != nil {
+				return err
+			}
+			types = make(map[string]entity.CategoryType, len(categories))
+			for _, category := range categories {
+				types[category.ID] = category.Type
+			}
+			categoryTypes[transaction.UserID] = types
+		}
+		transaction.Type = entity.TransactionTypeExpense
+		if categoryType, ok := types[transaction.CategoryID]; ok {
+			transaction.Type = entity.TransactionTypeFromCategory(categoryType)
+		}
+	}
+	return nil
+}
+
+// enqueueTransactionEvent grava o evento de orçamento no outbox dentro da mesma unidade de trabalho
+// da transação; o OutboxRelay publica na fila depois. Transferências não geram eventos.
+// Cada evento recebe um identificador próprio usado pelo processador para garantir idempotência.
+// Registros antigos sem tipo saem com o tipo da categoria, já que o processador descarta eventos sem tipo.
+func (uc *TransactionUseCase) enqueueTransactionEvent(ctx context.Context, eventType string, transaction *entity.Transaction) error {
+	if uc.outboxRepo == nil || uc.eventQueueName == "" || transaction.Type.IsTransfer() {
+		return nil
+	}
+	transactionType, err := uc.resolveTransactionType(ctx, transaction)
+	if err != nil {
+		return err
+	}
+
+	eventID := uuid.NewString()
+	payload := map[string]any{
+		"eventId":       eventID,
+		"eventType":     eventType,
+		"transactionId": transaction.ID,
+		"userId":        transaction.UserID,
+		"occurredAt":    transaction.OccurredAt,
+		"amount":        transaction.Amount,
+		"currency":      transaction.Currency.String(),
+		"categoryId":    transaction.CategoryID,
+		"accountId":     transaction.AccountID,
+		"type":          transactionType,
+	}
+	// Com rateio, o processador de orçamentos atribui cada linha à sua categoria
+	if len(transaction.Splits) > 0 {
+		payload["splits"] = toSplitResponses(transaction.Splits)
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	return uc.outboxRepo.Create(ctx, &entity.OutboxEvent{
+		ID:            eventID,
+		EventType:     eventType,
+		QueueName:     uc.eventQueueName,
+		AggregateID:   transaction.ID,
+		Payload:       body,
+		Attributes:    map[string]string{"eventType": eventType},
+		Status:        entity.OutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	})
+}
+
+func toTransactionResponse(transaction *entity.Transaction, notes string) *dto.TransactionResponse {
+	return &dto.TransactionResponse{
+		ID:                  transaction.ID,
+		AccountID:           transaction.AccountID,
+		CategoryID:          transaction.CategoryID,
+		Type:                string(transaction.Type),
+		Amount:              transaction.Amount,
+		Currency:            transaction.Currency.String(),
+		Description:         transaction.Description,
+		OccurredAt:          transaction.OccurredAt,
+		Status:              string(transaction.Status),
+		Tags:                transaction.Tags,
+		Notes:               notes,
+		TransferID:          transaction.TransferID,
+		LinkedTransactionID: transaction.LinkedTransactionID,
+		VoidedAt:            transaction.VoidedAt,
+		VoidReason:          transaction.VoidReason,
+		DuplicateOf:         transaction.DuplicateOf,
+		Splits:              toSplitResponses(transaction.Splits),
+		Installment:         toInstallmentResponse(transaction.Installment),
+		Cleared:             transaction.Cleared,
+		ReconciliationID:    transaction.ReconciliationID,
+	}
+}
+
+func toInstallmentResponse(installment *entity.Installment) *dto.Installment {
+	if installment == nil {
+		return nil
+	}
+	return &dto.Installment{GroupID: installment.GroupID, Number: installment.Number, Count: installment.Count}
+}
+
+func toSplitResponses(splits []entity.TransactionSplit) []dto.TransactionSplit {
+	if len(splits) == 0 {
+		return nil
+	}
+	result := make([]dto.TransactionSplit, 0, len(splits))
+	for _, split := range splits {
+		result = append(result, dto.TransactionSplit{CategoryID: split.CategoryID, Amount: split.Amount, Note: split.Note})
+	}
+	return result
+}
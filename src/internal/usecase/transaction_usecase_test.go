@@ -3,6 +3,7 @@ package usecase
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/vasconcellos/financial-control/src/internal/domain/dto"
 	"github.com/vasconcellos/financial-control/src/internal/domain/entity"
 	domainerrors "github.com/vasconcellos/financial-control/src/internal/domain/errors"
+	"github.com/vasconcellos/financial-control/src/internal/domain/repository"
 )
 
 // TestTransactionUseCaseRecordTransactionValorInvalido garante que valores não positivos geram erro de validação
@@ -20,12 +22,12 @@ func TestTransactionUseCaseRecordTransactionValorInvalido(t *testing.T) {
 		"cat": {ID: "cat", Type: entity.CategoryTypeExpense},
 	}}
 
-	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, nil, "queue", nil)
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, nil, "queue", nil)
 
 	_, err := uc.RecordTransaction(context.Background(), "user", dto.CreateTransactionRequest{
 		AccountID:  "acc",
 		CategoryID: "cat",
-		Amount:     0,
+		Amount:     entity.MoneyFromInt(0),
 		Currency:   "USD",
 	})
 	if !errors.Is(err, domainerrors.ErrInvalidInput) {
@@ -33,36 +35,33 @@ func TestTransactionUseCaseRecordTransactionValorInvalido(t *testing.T) {
 	}
 }
 
-// TestTransactionUseCaseRecordTransactionDespesa garante ajuste negativo no saldo e envio ao SQS
+// TestTransactionUseCaseRecordTransactionDespesa garante ajuste negativo no saldo e evento no outbox
 func TestTransactionUseCaseRecordTransactionDespesa(t *testing.T) {
 	txRepo := newTransactionRepositoryStub()
 	accountRepo := newAccountRepositoryStub()
 	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
 		"cat": {ID: "cat", Type: entity.CategoryTypeExpense},
 	}}
-	queue := &queuePublisherStub{}
+	outbox := newOutboxRepositoryStub()
 
-	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, queue, nil, "financial-queue", nil)
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), outbox, nil, nil, "financial-queue", nil)
 
 	_, err := uc.RecordTransaction(context.Background(), "user", dto.CreateTransactionRequest{
 		AccountID:  "acc",
 		CategoryID: "cat",
-		Amount:     100,
+		Amount:     entity.MoneyFromInt(100),
 		Currency:   "USD",
 		OccurredAt: time.Now(),
 	})
 	if err != nil {
 		t.Fatalf("não esperava erro: %v", err)
 	}
-	if len(accountRepo.adjustments) != 1 || accountRepo.adjustments[0] != -100 {
+	if len(accountRepo.adjustments) != 1 || accountRepo.adjustments[0] != entity.MoneyFromInt(-100) {
 		t.Fatalf("ajuste de saldo inadequado: %#v", accountRepo.adjustments)
 	}
 
-	// Wait a bit for async goroutine to execute
-	time.Sleep(50 * time.Millisecond)
-
-	if !queue.called {
-		t.Fatalf("publicação no SQS deveria ocorrer")
+	if len(outbox.events) != 1 {
+		t.Fatalf("evento deveria ser gravado no outbox")
 	}
 	if txRepo.created == nil || len(txRepo.created) != 1 {
 		t.Fatalf("transação não persistida corretamente")
@@ -76,10 +75,10 @@ func TestTransactionUseCaseAttachReceipt(t *testing.T) {
 	txRepo.storage["txn"] = transaction
 	accountRepo := newAccountRepositoryStub()
 	categoryRepo := &categoryRepositoryStub{}
-	queue := &queuePublisherStub{}
+	outbox := newOutboxRepositoryStub()
 	storage := &objectStorageStub{}
 
-	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, queue, storage, "queue", nil)
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), outbox, nil, storage, "queue", nil)
 
 	resp, err := uc.AttachReceipt(context.Background(), "user", "txn", "receipt.pdf", "application/pdf", bytes.NewReader([]byte("filedata")))
 	if err != nil {
@@ -100,7 +99,7 @@ func TestTransactionUseCaseAttachReceiptTooLarge(t *testing.T) {
 	categoryRepo := &categoryRepositoryStub{}
 	storage := &objectStorageStub{}
 
-	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, storage, "queue", nil)
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, storage, "queue", nil)
 
 	tooLarge := bytes.Repeat([]byte("a"), int(MaxReceiptSizeBytes)+1)
 	_, err := uc.AttachReceipt(context.Background(), "user", "txn", "huge.pdf", "application/pdf", bytes.NewReader(tooLarge))
@@ -121,15 +120,15 @@ func TestTransactionUseCaseRecordTransactionEncryptsNotes(t *testing.T) {
 	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
 		"cat": {ID: "cat", Type: entity.CategoryTypeExpense},
 	}}
-	queue := &queuePublisherStub{}
+	outbox := newOutboxRepositoryStub()
 	encryptionKey := bytes.Repeat([]byte{1}, 32)
 
-	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, queue, nil, "financial-queue", encryptionKey)
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), outbox, nil, nil, "financial-queue", encryptionKey)
 
 	resp, err := uc.RecordTransaction(context.Background(), "user", dto.CreateTransactionRequest{
 		AccountID:  "acc",
 		CategoryID: "cat",
-		Amount:     50,
+		Amount:     entity.MoneyFromInt(50),
 		Currency:   "USD",
 		OccurredAt: time.Now(),
 		Notes:      "conteúdo sensível",
@@ -152,7 +151,7 @@ func TestTransactionUseCaseRecordTransactionEncryptsNotes(t *testing.T) {
 	}
 
 	txRepo.listResponse = []*entity.Transaction{stored}
-	list, err := uc.ListTransactions(context.Background(), "user", time.Now().Add(-time.Hour), time.Now().Add(time.Hour), 10, 0)
+	list, err := uc.ListTransactions(context.Background(), "user", dto.TransactionFilter{From: time.Now().Add(-time.Hour), To: time.Now().Add(time.Hour)}, 10, 0)
 	if err != nil {
 		t.Fatalf("não esperava erro ao listar: %v", err)
 	}
@@ -176,7 +175,7 @@ func TestTransactionUseCaseUpdateTransactionEncryptsNotes(t *testing.T) {
 	categoryRepo := &categoryRepositoryStub{}
 	encryptionKey := bytes.Repeat([]byte{2}, 32)
 
-	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, nil, "queue", encryptionKey)
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, nil, "queue", encryptionKey)
 
 	newNotes := "nota atualizada"
 	resp, err := uc.UpdateTransaction(context.Background(), "user", "txn", dto.UpdateTransactionRequest{
@@ -198,3 +197,467 @@ func TestTransactionUseCaseUpdateTransactionEncryptsNotes(t *testing.T) {
 		t.Fatalf("flag de notas criptografadas deveria ser atualizada")
 	}
 }
+
+// TestTransactionUseCaseRecordTransfer garante débito na origem, crédito no destino e pernas vinculadas
+func TestTransactionUseCaseRecordTransfer(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["checking"] = &entity.Account{ID: "checking", UserID: "user", Currency: entity.CurrencyUSD, Balance: entity.MoneyFromInt(500)}
+	accountRepo.storage["savings"] = &entity.Account{ID: "savings", UserID: "user", Currency: entity.CurrencyUSD, Balance: entity.MoneyFromInt(0)}
+	outbox := newOutboxRepositoryStub()
+
+	uc := NewTransactionUseCase(txRepo, accountRepo, &categoryRepositoryStub{}, nil, newUnitOfWorkStub(accountRepo, txRepo), outbox, nil, nil, "queue", nil)
+
+	resp, err := uc.RecordTransfer(context.Background(), "user", dto.CreateTransferRequest{
+		FromAccountID: "checking",
+		ToAccountID:   "savings",
+		Amount:        entity.MoneyFromInt(200),
+		OccurredAt:    time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if accountRepo.storage["checking"].Balance != entity.MoneyFromInt(300) || accountRepo.storage["savings"].Balance != entity.MoneyFromInt(200) {
+		t.Fatalf("saldos inesperados: origem=%v destino=%v", accountRepo.storage["checking"].Balance, accountRepo.storage["savings"].Balance)
+	}
+	if len(txRepo.created) != 2 {
+		t.Fatalf("esperava 2 pernas gravadas, obteve %d", len(txRepo.created))
+	}
+	if resp.From.LinkedTransactionID != resp.To.ID || resp.To.LinkedTransactionID != resp.From.ID {
+		t.Fatalf("pernas da transferência deveriam estar vinculadas")
+	}
+	if resp.From.Type != string(entity.TransactionTypeTransferOut) || resp.To.Type != string(entity.TransactionTypeTransferIn) {
+		t.Fatalf("tipos inesperados: %s/%s", resp.From.Type, resp.To.Type)
+	}
+
+	if len(outbox.events) > 0 {
+		t.Fatalf("transferências não deveriam publicar eventos de orçamento")
+	}
+}
+
+// TestTransactionUseCaseRecordTransferCambio garante conversão pela taxa informada e erro sem taxa
+func TestTransactionUseCaseRecordTransferCambio(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["usd"] = &entity.Account{ID: "usd", UserID: "user", Currency: entity.CurrencyUSD, Balance: entity.MoneyFromInt(100)}
+	accountRepo.storage["brl"] = &entity.Account{ID: "brl", UserID: "user", Currency: entity.CurrencyBRL, Balance: entity.MoneyFromInt(0)}
+
+	uc := NewTransactionUseCase(txRepo, accountRepo, &categoryRepositoryStub{}, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, nil, "queue", nil)
+
+	_, err := uc.RecordTransfer(context.Background(), "user", dto.CreateTransferRequest{
+		FromAccountID: "usd",
+		ToAccountID:   "brl",
+		Amount:        entity.MoneyFromInt(10),
+		OccurredAt:    time.Now(),
+	})
+	if !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("esperava ErrInvalidInput sem taxa de câmbio, obteve %v", err)
+	}
+
+	rate := 5.25
+	resp, err := uc.RecordTransfer(context.Background(), "user", dto.CreateTransferRequest{
+		FromAccountID: "usd",
+		ToAccountID:   "brl",
+		Amount:        entity.MoneyFromInt(10),
+		ExchangeRate:  &rate,
+		OccurredAt:    time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if resp.To.Amount != entity.MustParseMoney("52.5") || resp.To.Currency != "BRL" {
+		t.Fatalf("valor convertido inesperado: %v %s", resp.To.Amount, resp.To.Currency)
+	}
+	if accountRepo.storage["brl"].Balance != entity.MustParseMoney("52.5") || accountRepo.storage["usd"].Balance != entity.MoneyFromInt(90) {
+		t.Fatalf("saldos inesperados após câmbio")
+	}
+}
+
+// TestTransactionUseCaseVoidTransaction garante estorno do saldo, evento compensatório e registro preservado
+func TestTransactionUseCaseVoidTransaction(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["acc"] = &entity.Account{ID: "acc", UserID: "user", Balance: entity.MoneyFromInt(1000)}
+	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
+		"cat": {ID: "cat", Type: entity.CategoryTypeExpense},
+	}}
+	outbox := newOutboxRepositoryStub()
+
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), outbox, nil, nil, "financial-queue", nil)
+
+	resp, err := uc.RecordTransaction(context.Background(), "user", dto.CreateTransactionRequest{
+		AccountID:  "acc",
+		CategoryID: "cat",
+		Amount:     entity.MoneyFromInt(120),
+		Currency:   "USD",
+		OccurredAt: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	if err := uc.VoidTransaction(context.Background(), "user", resp.ID, "lançamento duplicado"); err != nil {
+		t.Fatalf("não esperava erro ao anular: %v", err)
+	}
+	if accountRepo.storage["acc"].Balance != entity.MoneyFromInt(1000) {
+		t.Fatalf("saldo deveria ser restaurado, obteve %v", accountRepo.storage["acc"].Balance)
+	}
+	stored := txRepo.storage[resp.ID]
+	if stored == nil || stored.Status != entity.TransactionStatusVoided || stored.VoidReason != "lançamento duplicado" {
+		t.Fatalf("transação anulada deveria permanecer registrada para auditoria")
+	}
+
+	types := outbox.eventTypes()
+	if len(types) != 2 || types[1] != "TRANSACTION_VOIDED" {
+		t.Fatalf("esperava evento compensatório, obteve %v", types)
+	}
+
+	if err := uc.VoidTransaction(context.Background(), "user", resp.ID, ""); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("esperava ErrConflict ao anular novamente, obteve %v", err)
+	}
+}
+
+// TestTransactionUseCaseVoidRegistroAntigo garante que anular um registro antigo sem tipo publica o evento com o
+// tipo da categoria, para que o processador de orçamentos não o descarte
+func TestTransactionUseCaseVoidRegistroAntigo(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["acc"] = &entity.Account{ID: "acc", UserID: "user", Balance: entity.MoneyFromInt(1050)}
+	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
+		"salary": {ID: "salary", Type: entity.CategoryTypeIncome},
+	}}
+	txRepo.storage["legacy"] = &entity.Transaction{ID: "legacy", UserID: "user", AccountID: "acc", CategoryID: "salary", Amount: entity.MoneyFromInt(50), Status: entity.TransactionStatusCompleted}
+	outbox := newOutboxRepositoryStub()
+
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), outbox, nil, nil, "financial-queue", nil)
+	if err := uc.VoidTransaction(context.Background(), "user", "legacy", ""); err != nil {
+		t.Fatalf("não esperava erro ao anular: %v", err)
+	}
+	if accountRepo.storage["acc"].Balance != entity.MoneyFromInt(1000) {
+		t.Fatalf("saldo deveria descontar a receita anulada, obteve %v", accountRepo.storage["acc"].Balance)
+	}
+
+	if len(outbox.events) != 1 {
+		t.Fatalf("esperava um evento de anulação, obteve %d", len(outbox.events))
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(outbox.events[0].Payload, &payload); err != nil {
+		t.Fatalf("payload inválido: %v", err)
+	}
+	if payload["type"] != string(entity.TransactionTypeIncome) {
+		t.Fatalf("esperava o tipo da categoria no evento, obteve %v", payload["type"])
+	}
+}
+
+// TestTransactionUseCaseVoidTransfer garante que anular uma perna anula e estorna a transferência inteira
+func TestTransactionUseCaseVoidTransfer(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["checking"] = &entity.Account{ID: "checking", UserID: "user", Currency: entity.CurrencyUSD, Balance: entity.MoneyFromInt(500)}
+	accountRepo.storage["savings"] = &entity.Account{ID: "savings", UserID: "user", Currency: entity.CurrencyUSD}
+
+	uc := NewTransactionUseCase(txRepo, accountRepo, &categoryRepositoryStub{}, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, nil, "queue", nil)
+
+	resp, err := uc.RecordTransfer(context.Background(), "user", dto.CreateTransferRequest{
+		FromAccountID: "checking",
+		ToAccountID:   "savings",
+		Amount:        entity.MoneyFromInt(200),
+		OccurredAt:    time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	if err := uc.VoidTransaction(context.Background(), "user", resp.To.ID, ""); err != nil {
+		t.Fatalf("não esperava erro ao anular: %v", err)
+	}
+	if accountRepo.storage["checking"].Balance != entity.MoneyFromInt(500) || accountRepo.storage["savings"].Balance != entity.MoneyFromInt(0) {
+		t.Fatalf("saldos deveriam ser restaurados")
+	}
+	if txRepo.storage[resp.From.ID].Status != entity.TransactionStatusVoided {
+		t.Fatalf("perna vinculada deveria ser anulada")
+	}
+}
+
+// TestTransactionUseCaseUpdateTransactionRebalanceia garante estorno do efeito antigo e aplicação do novo
+func TestTransactionUseCaseUpdateTransactionRebalanceia(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["acc"] = &entity.Account{ID: "acc", UserID: "user", Currency: entity.CurrencyUSD, Balance: entity.MoneyFromInt(1000)}
+	accountRepo.storage["other"] = &entity.Account{ID: "other", UserID: "user", Currency: entity.CurrencyUSD, Balance: entity.MoneyFromInt(0)}
+	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
+		"food":   {ID: "food", Type: entity.CategoryTypeExpense},
+		"salary": {ID: "salary", Type: entity.CategoryTypeIncome},
+	}}
+	outbox := newOutboxRepositoryStub()
+
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), outbox, nil, nil, "financial-queue", nil)
+
+	resp, err := uc.RecordTransaction(context.Background(), "user", dto.CreateTransactionRequest{
+		AccountID:  "acc",
+		CategoryID: "food",
+		Amount:     entity.MoneyFromInt(100),
+		Currency:   "USD",
+		OccurredAt: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+
+	amount := entity.MoneyFromInt(10)
+	if _, err := uc.UpdateTransaction(context.Background(), "user", resp.ID, dto.UpdateTransactionRequest{Amount: &amount}); err != nil {
+		t.Fatalf("não esperava erro ao corrigir valor: %v", err)
+	}
+	if accountRepo.storage["acc"].Balance != entity.MoneyFromInt(990) {
+		t.Fatalf("saldo deveria refletir o valor corrigido, obteve %v", accountRepo.storage["acc"].Balance)
+	}
+
+	otherAccount := "other"
+	salary := "salary"
+	updated, err := uc.UpdateTransaction(context.Background(), "user", resp.ID, dto.UpdateTransactionRequest{
+		AccountID:  &otherAccount,
+		CategoryID: &salary,
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro ao mover transação: %v", err)
+	}
+	if accountRepo.storage["acc"].Balance != entity.MoneyFromInt(1000) || accountRepo.storage["other"].Balance != entity.MoneyFromInt(10) {
+		t.Fatalf("saldos inesperados após mover: acc=%v other=%v", accountRepo.storage["acc"].Balance, accountRepo.storage["other"].Balance)
+	}
+	if updated.Type != string(entity.TransactionTypeIncome) || txRepo.lastUpdated.AccountID != "other" {
+		t.Fatalf("transação deveria ser persistida como receita na nova conta")
+	}
+
+	if len(outbox.eventTypes()) != 5 {
+		t.Fatalf("esperava evento original mais estorno/reaplicação por edição, obteve %v", outbox.eventTypes())
+	}
+}
+
+// TestTransactionUseCaseUpdateTransferRejeitaAlteracaoFinanceira garante que pernas de transferência não mudam de valor
+func TestTransactionUseCaseUpdateTransferRejeitaAlteracaoFinanceira(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	txRepo.storage["leg"] = &entity.Transaction{ID: "leg", UserID: "user", AccountID: "acc", Type: entity.TransactionTypeTransferOut, Amount: entity.MoneyFromInt(10)}
+
+	uc := NewTransactionUseCase(txRepo, newAccountRepositoryStub(), &categoryRepositoryStub{}, nil, &unitOfWorkStub{}, nil, nil, nil, "queue", nil)
+
+	amount := entity.MoneyFromInt(20)
+	_, err := uc.UpdateTransaction(context.Background(), "user", "leg", dto.UpdateTransactionRequest{Amount: &amount})
+	if !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("esperava ErrInvalidInput, obteve %v", err)
+	}
+}
+
+// TestTransactionUseCaseUpdateReenviaValoresAtuais garante que reenviar conta, valor, moeda e data atuais não conta
+// como alteração financeira em perna de transferência, transação conciliada ou gerada
+func TestTransactionUseCaseUpdateReenviaValoresAtuais(t *testing.T) {
+	occurredAt := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	txRepo := newTransactionRepositoryStub()
+	txRepo.storage["leg"] = &entity.Transaction{ID: "leg", UserID: "user", AccountID: "acc", Type: entity.TransactionTypeTransferOut, Amount: entity.MoneyFromInt(10), Currency: entity.CurrencyBRL, OccurredAt: occurredAt}
+	txRepo.storage["reconciled"] = &entity.Transaction{ID: "reconciled", UserID: "user", AccountID: "acc", CategoryID: "cat", Type: entity.TransactionTypeExpense, Amount: entity.MoneyFromInt(10), Currency: entity.CurrencyBRL, OccurredAt: occurredAt, ReconciliationID: "rec"}
+	txRepo.storage["generated"] = &entity.Transaction{ID: "generated", UserID: "user", AccountID: "acc", CategoryID: "cat", Type: entity.TransactionTypeExpense, Amount: entity.MoneyFromInt(10), Currency: entity.CurrencyBRL, OccurredAt: occurredAt, LoanPaymentID: "payment"}
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["acc"] = &entity.Account{ID: "acc", UserID: "user", Currency: entity.CurrencyBRL}
+
+	uc := NewTransactionUseCase(txRepo, accountRepo, &categoryRepositoryStub{}, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, nil, "queue", nil)
+
+	accountID, currency, description := "acc", "BRL", "descrição nova"
+	amount := entity.MustParseMoney("10.00")
+	for _, id := range []string{"leg", "reconciled", "generated"} {
+		response, err := uc.UpdateTransaction(context.Background(), "user", id, dto.UpdateTransactionRequest{
+			AccountID:   &accountID,
+			Amount:      &amount,
+			Currency:    &currency,
+			OccurredAt:  &occurredAt,
+			Description: &description,
+		})
+		if err != nil {
+			t.Fatalf("%s: reenviar os valores atuais não deveria falhar: %v", id, err)
+		}
+		if response.Description != description {
+			t.Fatalf("%s: descrição deveria ser atualizada, obteve %q", id, response.Description)
+		}
+	}
+
+	changed := entity.MoneyFromInt(20)
+	if _, err := uc.UpdateTransaction(context.Background(), "user", "reconciled", dto.UpdateTransactionRequest{Amount: &changed}); !errors.Is(err, domainerrors.ErrConflict) {
+		t.Fatalf("valor diferente em transação conciliada deveria dar ErrConflict, obteve %v", err)
+	}
+	if accountRepo.storage["acc"].Balance.Cmp(entity.ZeroMoney) != 0 {
+		t.Fatalf("saldo não deveria mudar, obteve %s", accountRepo.storage["acc"].Balance)
+	}
+}
+
+// TestTransactionUseCaseUpdateMoedaDaConta garante que a moeda só muda para a moeda da conta da transação
+func TestTransactionUseCaseUpdateMoedaDaConta(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	txRepo.storage["txn"] = &entity.Transaction{ID: "txn", UserID: "user", AccountID: "acc", CategoryID: "cat", Type: entity.TransactionTypeExpense, Amount: entity.MoneyFromInt(10), Currency: entity.CurrencyUSD}
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["acc"] = &entity.Account{ID: "acc", UserID: "user", Currency: entity.CurrencyBRL}
+	accountRepo.storage["usd"] = &entity.Account{ID: "usd", UserID: "user", Currency: entity.CurrencyUSD}
+
+	uc := NewTransactionUseCase(txRepo, accountRepo, &categoryRepositoryStub{}, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, nil, "queue", nil)
+
+	euro := "EUR"
+	if _, err := uc.UpdateTransaction(context.Background(), "user", "txn", dto.UpdateTransactionRequest{Currency: &euro}); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("moeda diferente da conta deveria dar ErrInvalidInput, obteve %v", err)
+	}
+	brl := "BRL"
+	response, err := uc.UpdateTransaction(context.Background(), "user", "txn", dto.UpdateTransactionRequest{Currency: &brl})
+	if err != nil {
+		t.Fatalf("não esperava erro ao corrigir a moeda para a da conta: %v", err)
+	}
+	if response.Currency != "BRL" {
+		t.Fatalf("moeda deveria ser BRL, obteve %s", response.Currency)
+	}
+
+	usdAccount, dollar := "usd", "USD"
+	if _, err := uc.UpdateTransaction(context.Background(), "user", "txn", dto.UpdateTransactionRequest{AccountID: &usdAccount}); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("mudar para conta em outra moeda sem mudar a moeda deveria dar ErrInvalidInput, obteve %v", err)
+	}
+	if stored := txRepo.storage["txn"]; stored.AccountID != "acc" || !accountRepo.storage["usd"].Balance.IsZero() {
+		t.Fatalf("alteração recusada não deveria mover a transação: %+v", stored)
+	}
+	if _, err := uc.UpdateTransaction(context.Background(), "user", "txn", dto.UpdateTransactionRequest{AccountID: &usdAccount, Currency: &dollar}); err != nil {
+		t.Fatalf("não esperava erro ao mudar conta e moeda juntas: %v", err)
+	}
+	if stored := txRepo.storage["txn"]; stored.AccountID != "usd" || stored.Currency != entity.CurrencyUSD {
+		t.Fatalf("transação deveria ir para a conta em dólar: %+v", stored)
+	}
+}
+
+// TestTransactionUseCaseRecordTransactionRollback garante que falha ao gravar a transação desfaz o ajuste de saldo
+func TestTransactionUseCaseRecordTransactionRollback(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	txRepo.createErr = errors.New("insert failed")
+	accountRepo := newAccountRepositoryStub()
+	accountRepo.storage["acc"] = &entity.Account{ID: "acc", UserID: "user", Balance: entity.MoneyFromInt(100)}
+	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
+		"cat": {ID: "cat", Type: entity.CategoryTypeExpense},
+	}}
+	uow := newUnitOfWorkStub(accountRepo, txRepo)
+	outbox := newOutboxRepositoryStub()
+
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, uow, outbox, nil, nil, "financial-queue", nil)
+
+	_, err := uc.RecordTransaction(context.Background(), "user", dto.CreateTransactionRequest{
+		AccountID:  "acc",
+		CategoryID: "cat",
+		Amount:     entity.MoneyFromInt(40),
+		Currency:   "USD",
+		OccurredAt: time.Now(),
+	})
+	if err == nil {
+		t.Fatalf("esperava erro na gravação")
+	}
+	if uow.calls != 1 || uow.rollbacks != 1 {
+		t.Fatalf("escritas deveriam ocorrer em uma unidade de trabalho desfeita, calls=%d rollbacks=%d", uow.calls, uow.rollbacks)
+	}
+	if accountRepo.storage["acc"].Balance != entity.MoneyFromInt(100) {
+		t.Fatalf("saldo não deveria ser alterado, obteve %v", accountRepo.storage["acc"].Balance)
+	}
+
+	if len(outbox.events) > 0 {
+		t.Fatalf("nenhum evento deveria ser publicado quando a gravação falha")
+	}
+}
+
+// TestTransactionUseCaseListTransactionsFiltros garante validação dos filtros e expansão das subcategorias
+func TestTransactionUseCaseListTransactionsFiltros(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	food, restaurants, delivery := "food", "restaurants", "delivery"
+	categoryRepo := &categoryRepositoryStub{categories: map[string]*entity.Category{
+		food:        {ID: food, Type: entity.CategoryTypeExpense},
+		restaurants: {ID: restaurants, Type: entity.CategoryTypeExpense, ParentID: &food},
+		delivery:    {ID: delivery, Type: entity.CategoryTypeExpense, ParentID: &restaurants},
+		"salary":    {ID: "salary", Type: entity.CategoryTypeIncome},
+	}}
+	uc := NewTransactionUseCase(txRepo, accountRepo, categoryRepo, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, nil, "queue", nil)
+	ctx := context.Background()
+	minimum := entity.MoneyFromInt(10)
+
+	_, err := uc.ListTransactions(ctx, "user", dto.TransactionFilter{
+		CategoryIDs:          []string{food},
+		IncludeSubcategories: true,
+		Tags:                 []string{"casa", "fixo"},
+		MatchAllTags:         true,
+		MinAmount:            &minimum,
+		Statuses:             []string{"completed", "voided"},
+		Currencies:           []string{"brl"},
+		Search:               "  padaria ",
+		Sort:                 "-amount",
+	}, 20, 0)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	filter := txRepo.lastFilter
+	if len(filter.CategoryIDs) != 3 || filter.CategoryIDs[0] != food || filter.CategoryIDs[2] != delivery {
+		t.Fatalf("subcategorias deveriam ser incluídas em qualquer nível: %v", filter.CategoryIDs)
+	}
+	if filter.SortBy != repository.TransactionSortAmount || !filter.SortDescending || filter.Search != "padaria" {
+		t.Fatalf("ordenação ou busca inesperadas: %+v", filter)
+	}
+	if len(filter.Currencies) != 1 || filter.Currencies[0] != entity.CurrencyBRL || len(filter.Statuses) != 2 || !filter.MatchAllTags {
+		t.Fatalf("filtros inesperados: %+v", filter)
+	}
+
+	maximum := entity.MoneyFromInt(5)
+	invalid := []dto.TransactionFilter{
+		{Sort: "category"},
+		{Statuses: []string{"archived"}},
+		{Currencies: []string{"XXZ"}},
+		{MinAmount: &minimum, MaxAmount: &maximum},
+		{From: time.Now(), To: time.Now().Add(-time.Hour)},
+	}
+	for _, request := range invalid {
+		if _, err := uc.ListTransactions(ctx, "user", request, 20, 0); !errors.Is(err, domainerrors.ErrInvalidInput) {
+			t.Fatalf("filtro %+v: esperava ErrInvalidInput, obteve %v", request, err)
+		}
+	}
+}
+
+// TestTransactionUseCaseListTransactionsPage garante que o cursor carrega a ordenação e o último item da página
+func TestTransactionUseCaseListTransactionsPage(t *testing.T) {
+	txRepo := newTransactionRepositoryStub()
+	accountRepo := newAccountRepositoryStub()
+	uc := NewTransactionUseCase(txRepo, accountRepo, &categoryRepositoryStub{}, nil, newUnitOfWorkStub(accountRepo, txRepo), nil, nil, nil, "queue", nil)
+	ctx := context.Background()
+	txRepo.listResponse = []*entity.Transaction{
+		{ID: "t3", UserID: "user", Amount: entity.MoneyFromInt(30)},
+		{ID: "t2", UserID: "user", Amount: entity.MoneyFromInt(20)},
+		{ID: "t1", UserID: "user", Amount: entity.MoneyFromInt(10)},
+	}
+
+	page, err := uc.ListTransactionsPage(ctx, "user", dto.TransactionFilter{Sort: "-amount"}, "", 2)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	if len(page.Items) != 2 || page.NextCursor == "" {
+		t.Fatalf("esperava 2 itens e próximo cursor, obtido %+v", page)
+	}
+	if txRepo.lastFilter.After != nil {
+		t.Fatalf("primeira página não deveria usar cursor: %+v", txRepo.lastFilter.After)
+	}
+
+	txRepo.listResponse = txRepo.listResponse[2:]
+	page, err = uc.ListTransactionsPage(ctx, "user", dto.TransactionFilter{Sort: "-amount"}, page.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("não esperava erro: %v", err)
+	}
+	after := txRepo.lastFilter.After
+	if after == nil || after.ID != "t2" || after.Value != entity.MoneyFromInt(20).String() {
+		t.Fatalf("cursor deveria apontar para o último item da página anterior: %+v", after)
+	}
+	if len(page.Items) != 1 || page.NextCursor != "" {
+		t.Fatalf("última página não deveria ter próximo cursor: %+v", page)
+	}
+
+	txRepo.listResponse = []*entity.Transaction{{ID: "t1", UserID: "user"}, {ID: "t0", UserID: "user"}}
+	first, err := uc.ListTransactionsPage(ctx, "user", dto.TransactionFilter{}, "", 1)
+	if err != nil || first.NextCursor == "" {
+		t.Fatalf("esperava próximo cursor, obtido %+v (%v)", first, err)
+	}
+	if _, err := uc.ListTransactionsPage(ctx, "user", dto.TransactionFilter{Sort: "amount"}, first.NextCursor, 1); !errors.Is(err, domainerrors.ErrInvalidInput) {
+		t.Fatalf("cursor gerado em outra ordenação deveria ser rejeitado, obtido %v", err)
+	}
+}